@@ -0,0 +1,113 @@
+package backends
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// simulatedCoinbase is the coinbase of the simulated blocks, blocks with an
+// empty coinbase are verified as wormholes empty blocks.
+var simulatedCoinbase = common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+// wormholesFaker is a fake ethash engine paying the official rewards of the
+// wormholes engines. The rewarded validators and exchangers are read from the
+// istanbul extra-data of the header, blocks without it are only paid the
+// ethash block reward.
+type wormholesFaker struct {
+	*ethash.Ethash
+}
+
+func newWormholesFaker() *wormholesFaker {
+	return &wormholesFaker{Ethash: ethash.NewFullFaker()}
+}
+
+// Finalize implements consensus.Engine, paying the official rewards before
+// the ethash block rewards.
+func (w *wormholesFaker) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header) {
+	if extra, err := types.ExtractIstanbulExtra(header); err == nil {
+		if len(extra.ValidatorAddr) > 0 || len(extra.ExchangerAddr) > 0 {
+			state.CreateNFTByOfficial16(extra.ValidatorAddr, extra.ExchangerAddr, header.Number, chain.Config().IsOfficialNFTProposal(header.Number))
+		}
+	}
+	w.Ethash.Finalize(chain, header, state, txs, uncles)
+}
+
+// FinalizeAndAssemble implements consensus.Engine, paying the official and
+// block rewards and assembling the block.
+func (w *wormholesFaker) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
+	w.Finalize(chain, header, state, txs, uncles)
+	return types.NewBlock(header, txs, uncles, receipts, trie.NewStackTrie(nil)), nil
+}
+
+// CommitWithOfficialRewards imports all the pending transactions as a single
+// block, in which the validators are rewarded with ERB and each exchanger is
+// rewarded with the next official SNFT, and starts a fresh new state.
+func (b *SimulatedBackend) CommitWithOfficialRewards(validators, exchangers []common.Address) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	extra, err := rlp.EncodeToBytes(&types.IstanbulExtra{
+		ValidatorAddr: validators,
+		ExchangerAddr: exchangers,
+	})
+	if err != nil {
+		panic(err)
+	}
+	parent := b.blockchain.GetBlockByHash(b.pendingBlock.ParentHash())
+	txs := b.pendingBlock.Transactions()
+	offset := int64(b.pendingBlock.Time()) - int64(parent.Time()+10)
+	b.generate(parent, func(block *core.BlockGen) {
+		block.SetExtra(append(make([]byte, types.IstanbulExtraVanity), extra...))
+		if offset != 0 {
+			block.OffsetTime(offset)
+		}
+		for _, tx := range txs {
+			block.AddTxWithChain(b.blockchain, tx)
+		}
+	})
+	b.commit()
+}
+
+// NFTOwnerAt returns the owner of the nft or snft at nft in the state of the
+// blockchain at blockNumber, the zero address is returned if it doesn't exist.
+func (b *SimulatedBackend) NFTOwnerAt(ctx context.Context, nft common.Address, blockNumber *big.Int) (common.Address, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stateDB, err := b.stateByBlockNumber(ctx, blockNumber)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return stateDB.GetNFTOwner16(nft), nil
+}
+
+// PendingNFTOwner returns the owner of the nft or snft at nft in the pending
+// state, the zero address is returned if it doesn't exist.
+func (b *SimulatedBackend) PendingNFTOwner(ctx context.Context, nft common.Address) (common.Address, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.pendingState.GetNFTOwner16(nft), nil
+}
+
+// MintDeepAt returns the addresses of the next user minted nft and the next
+// official snft after the block at blockNumber.
+func (b *SimulatedBackend) MintDeepAt(ctx context.Context, blockNumber *big.Int) (*types.MintDeep, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stateDB, err := b.stateByBlockNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return stateDB.MintDeep, nil
+}
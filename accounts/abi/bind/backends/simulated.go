@@ -32,7 +32,6 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
-	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -64,6 +63,7 @@ var (
 type SimulatedBackend struct {
 	database   ethdb.Database   // In memory database to store our testing data
 	blockchain *core.BlockChain // Ethereum blockchain to handle the consensus
+	engine     *wormholesFaker  // Consensus engine paying the official rewards
 
 	mu           sync.Mutex
 	pendingBlock *types.Block   // Currently pending block that will be imported on request
@@ -102,11 +102,13 @@ func NewSimulatedBackendWithDatabase(database ethdb.Database, alloc core.Genesis
 		genesis.Alloc[k] = v
 	}
 	genesis.MustCommit(database)
-	blockchain, _ := core.NewBlockChain(database, nil, genesis.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+	engine := newWormholesFaker()
+	blockchain, _ := core.NewBlockChain(database, nil, genesis.Config, engine, vm.Config{}, nil, nil)
 
 	backend := &SimulatedBackend{
 		database:   database,
 		blockchain: blockchain,
+		engine:     engine,
 		config:     genesis.Config,
 		events:     filters.NewEventSystem(&filterBackend{database, blockchain}, false),
 	}
@@ -133,6 +135,10 @@ func (b *SimulatedBackend) Commit() {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	b.commit()
+}
+
+func (b *SimulatedBackend) commit() {
 	if _, err := b.blockchain.InsertChain([]*types.Block{b.pendingBlock}); err != nil {
 		panic(err) // This cannot happen unless the simulator is wrong, fail in that case
 	}
@@ -150,10 +156,22 @@ func (b *SimulatedBackend) Rollback() {
 }
 
 func (b *SimulatedBackend) rollback(parent *types.Block) {
-	blocks, _ := core.GenerateChain(b.config, parent, ethash.NewFaker(), b.database, 1, func(int, *core.BlockGen) {})
+	b.generate(parent, nil)
+}
 
+// generate replaces the pending block with a new child of parent built by gen,
+// the state of the block is backed by the wormholes pools of parent.
+func (b *SimulatedBackend) generate(parent *types.Block, gen func(*core.BlockGen)) {
+	blocks, _ := core.GenerateChain(b.config, parent, b.engine, b.database, 1, func(number int, block *core.BlockGen) {
+		block.SetCoinbase(simulatedCoinbase)
+		block.LoadWormholesPools(b.blockchain)
+		if gen != nil {
+			gen(block)
+		}
+	})
 	b.pendingBlock = blocks[0]
 	b.pendingState, _ = state.New(b.pendingBlock.Root(), b.blockchain.StateCache(), nil)
+	b.blockchain.LoadWormholesPools(b.pendingState, parent.Header())
 }
 
 // Fork creates a side-chain that can be used to simulate reorgs.
@@ -185,14 +203,27 @@ func (b *SimulatedBackend) Fork(ctx context.Context, parent common.Hash) error {
 
 // stateByBlockNumber retrieves a state by a given blocknumber.
 func (b *SimulatedBackend) stateByBlockNumber(ctx context.Context, blockNumber *big.Int) (*state.StateDB, error) {
-	if blockNumber == nil || blockNumber.Cmp(b.blockchain.CurrentBlock().Number()) == 0 {
-		return b.blockchain.State()
+	block := b.blockchain.CurrentBlock()
+	if blockNumber != nil && blockNumber.Cmp(block.Number()) != 0 {
+		var err error
+		if block, err = b.blockByNumber(ctx, blockNumber); err != nil {
+			return nil, err
+		}
 	}
-	block, err := b.blockByNumber(ctx, blockNumber)
+	return b.stateAt(block)
+}
+
+// stateAt retrieves the state after block, backed by the wormholes pools of
+// block so that wormholes transactions can be executed on it.
+func (b *SimulatedBackend) stateAt(block *types.Block) (*state.StateDB, error) {
+	stateDB, err := b.blockchain.StateAt(block.Root())
 	if err != nil {
 		return nil, err
 	}
-	return b.blockchain.StateAt(block.Root())
+	if _, err := b.blockchain.LoadWormholesPools(stateDB, block.Header()); err != nil {
+		return nil, err
+	}
+	return stateDB, nil
 }
 
 // CodeAt returns the code associated with a certain account in the blockchain.
@@ -442,7 +473,7 @@ func (b *SimulatedBackend) CallContract(ctx context.Context, call ethereum.CallM
 	if blockNumber != nil && blockNumber.Cmp(b.blockchain.CurrentBlock().Number()) != 0 {
 		return nil, errBlockNumberUnsupported
 	}
-	stateDB, err := b.blockchain.State()
+	stateDB, err := b.stateAt(b.blockchain.CurrentBlock())
 	if err != nil {
 		return nil, err
 	}
@@ -678,16 +709,12 @@ func (b *SimulatedBackend) SendTransaction(ctx context.Context, tx *types.Transa
 		panic(fmt.Errorf("invalid transaction nonce: got %d, want %d", tx.Nonce(), nonce))
 	}
 	// Include tx in chain
-	blocks, _ := core.GenerateChain(b.config, block, ethash.NewFaker(), b.database, 1, func(number int, block *core.BlockGen) {
-		for _, tx := range b.pendingBlock.Transactions() {
+	txs := append(b.pendingBlock.Transactions(), tx)
+	b.generate(block, func(block *core.BlockGen) {
+		for _, tx := range txs {
 			block.AddTxWithChain(b.blockchain, tx)
 		}
-		block.AddTxWithChain(b.blockchain, tx)
 	})
-	stateDB, _ := b.blockchain.State()
-
-	b.pendingBlock = blocks[0]
-	b.pendingState, _ = state.New(b.pendingBlock.Root(), stateDB.Database(), nil)
 	return nil
 }
 
@@ -796,13 +823,9 @@ func (b *SimulatedBackend) AdjustTime(adjustment time.Duration) error {
 		return errors.New("Could not adjust time on non-empty block")
 	}
 
-	blocks, _ := core.GenerateChain(b.config, b.blockchain.CurrentBlock(), ethash.NewFaker(), b.database, 1, func(number int, block *core.BlockGen) {
+	b.generate(b.blockchain.CurrentBlock(), func(block *core.BlockGen) {
 		block.OffsetTime(int64(adjustment.Seconds()))
 	})
-	stateDB, _ := b.blockchain.State()
-
-	b.pendingBlock = blocks[0]
-	b.pendingState, _ = state.New(b.pendingBlock.Root(), stateDB.Database(), nil)
 
 	return nil
 }
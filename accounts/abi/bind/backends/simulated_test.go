@@ -19,6 +19,7 @@ package backends
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"math/big"
 	"math/rand"
@@ -1336,3 +1337,70 @@ func TestForkResendTx(t *testing.T) {
 		t.Errorf("TX included in wrong block: %d", h)
 	}
 }
+
+func TestWormholesTransactions(t *testing.T) {
+	testAddr := crypto.PubkeyToAddress(testKey.PublicKey)
+	sim := simTestBackend(testAddr)
+	defer sim.Close()
+	bgCtx := context.Background()
+
+	head, _ := sim.HeaderByNumber(bgCtx, nil)
+	gasPrice := new(big.Int).Add(head.BaseFee, big.NewInt(1))
+	sendWormholes := func(nonce uint64, to common.Address, w types.Wormholes) {
+		data, err := json.Marshal(w)
+		if err != nil {
+			t.Fatalf("could not encode wormholes: %v", err)
+		}
+		tx := types.NewTransaction(nonce, to, new(big.Int), 1000000, gasPrice, append([]byte("wormholes:"), data...))
+		signedTx, err := types.SignTx(tx, types.HomesteadSigner{}, testKey)
+		if err != nil {
+			t.Fatalf("could not sign tx: %v", err)
+		}
+		if err := sim.SendTransaction(bgCtx, signedTx); err != nil {
+			t.Fatalf("could not add tx to pending block: %v", err)
+		}
+	}
+
+	// Mint an nft to the sender and transfer it in the next block
+	mintDeep, err := sim.MintDeepAt(bgCtx, nil)
+	if err != nil {
+		t.Fatalf("could not get mint deep: %v", err)
+	}
+	nft := common.BytesToAddress(mintDeep.UserMint.Bytes())
+	sendWormholes(0, testAddr, types.Wormholes{Type: 0, Royalty: 100, MetaURL: "/ipfs/test", Version: types.WormholesVersion})
+	if owner, _ := sim.PendingNFTOwner(bgCtx, nft); owner != testAddr {
+		t.Fatalf("pending owner mismatch: have %x, want %x", owner, testAddr)
+	}
+	sim.Commit()
+	if owner, _ := sim.NFTOwnerAt(bgCtx, nft, nil); owner != testAddr {
+		t.Fatalf("owner mismatch after mint: have %x, want %x", owner, testAddr)
+	}
+
+	receiver := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	sendWormholes(1, receiver, types.Wormholes{Type: 1, NFTAddress: nft.Hex(), Version: types.WormholesVersion})
+	sim.Commit()
+	if owner, _ := sim.NFTOwnerAt(bgCtx, nft, nil); owner != receiver {
+		t.Fatalf("owner mismatch after transfer: have %x, want %x", owner, receiver)
+	}
+	if owner, _ := sim.NFTOwnerAt(bgCtx, nft, big.NewInt(1)); owner != testAddr {
+		t.Fatalf("owner mismatch at block 1: have %x, want %x", owner, testAddr)
+	}
+
+	// Reward an official snft to the exchanger and erb to the validator
+	mintDeep, _ = sim.MintDeepAt(bgCtx, nil)
+	snft := common.BytesToAddress(mintDeep.OfficialMint.Bytes())
+	validator := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	exchanger := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	sim.CommitWithOfficialRewards([]common.Address{validator}, []common.Address{exchanger})
+
+	if owner, _ := sim.NFTOwnerAt(bgCtx, snft, nil); owner != exchanger {
+		t.Fatalf("snft owner mismatch: have %x, want %x", owner, exchanger)
+	}
+	if balance, _ := sim.BalanceAt(bgCtx, validator, nil); balance.Sign() <= 0 {
+		t.Fatalf("validator not rewarded: balance %v", balance)
+	}
+	next, _ := sim.MintDeepAt(bgCtx, nil)
+	if want := new(big.Int).Add(mintDeep.OfficialMint, big.NewInt(1)); next.OfficialMint.Cmp(want) != 0 {
+		t.Fatalf("official mint mismatch: have %x, want %x", next.OfficialMint, want)
+	}
+}
@@ -149,6 +149,13 @@ type Handler interface {
 	SetBroadcaster(Broadcaster)
 }
 
+// ParticipationReader is implemented by the consensus engines that attribute
+// the activity in a block to the validators.
+type ParticipationReader interface {
+	// Participation returns the participation of the validators in the block.
+	Participation(chain ChainHeaderReader, header *types.Header) ([]*types.ValidatorStats, error)
+}
+
 // PoW is a consensus engine based on proof-of-work.
 type PoW interface {
 	Engine
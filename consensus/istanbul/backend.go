@@ -60,6 +60,13 @@ type Backend interface {
 	// the given validator
 	CheckSignature(data []byte, addr common.Address, sig []byte) error
 
+	// SignBLS signs input data with the backend's bls key
+	SignBLS([]byte) []byte
+
+	// BLSPublicKey returns the bls public key registered by the given validator,
+	// nil if it has none
+	BLSPublicKey(addr common.Address) []byte
+
 	// LastProposal retrieves latest committed proposal and the address of proposer
 	LastProposal() (Proposal, common.Address)
 
@@ -18,9 +18,13 @@ package backend
 
 import (
 	"errors"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -50,6 +54,128 @@ func (api *API) NodeAddress() common.Address {
 	return api.backend.Address()
 }
 
+// BLSKey is the bls public key of the node and its proof of possession, the
+// validator registers them with a type 41 wormholes transaction
+type BLSKey struct {
+	PublicKey hexutil.Bytes `json:"publicKey"`
+	Proof     hexutil.Bytes `json:"proof"`
+}
+
+// NodeBLSKey returns the bls public key the node aggregates its seals with
+func (api *API) NodeBLSKey() *BLSKey {
+	return &BLSKey{
+		PublicKey: api.backend.blsKey.PublicKey(),
+		Proof:     api.backend.blsKey.ProvePossession(),
+	}
+}
+
+// Evidence proves that Offender voted for two proposers of the empty block
+// at the same height, round and index
+type Evidence struct {
+	Hash     common.Hash    `json:"hash"`
+	Offender common.Address `json:"offender"`
+	Height   uint64         `json:"height"`
+	Round    uint64         `json:"round"`
+	Index    uint64         `json:"index"`
+	First    hexutil.Bytes  `json:"first"`
+	Second   hexutil.Bytes  `json:"second"`
+}
+
+// IstanbulAPI is the part of the API served under the upstream istanbul
+// namespace
+type IstanbulAPI struct {
+	backend *Backend
+}
+
+// GetEvidence returns the evidence of equivocating empty block voters the node
+// recorded for the votes at the given height, or all if none is specified
+func (api *IstanbulAPI) GetEvidence(height *hexutil.Uint64) []*Evidence {
+	var filter *uint64
+	if height != nil {
+		h := uint64(*height)
+		filter = &h
+	}
+	list := make([]*Evidence, 0)
+	for _, ev := range api.backend.evidence.list(filter) {
+		list = append(list, &Evidence{
+			Hash:     ev.Hash(),
+			Offender: ev.Offender,
+			Height:   ev.Height.Uint64(),
+			Round:    ev.Round,
+			Index:    ev.Index,
+			First:    ev.First,
+			Second:   ev.Second,
+		})
+	}
+	return list
+}
+
+// Latencies is the request timeout of the current sequence and the message
+// latencies of the validators it is derived from in adaptive mode
+type Latencies struct {
+	Adaptive       bool                         `json:"adaptive"`
+	RequestTimeout uint64                       `json:"requestTimeout"` // In milliseconds
+	Validators     []*istanbul.ValidatorLatency `json:"validators"`
+}
+
+// GetLatencies returns the message latencies of the validators observed by the
+// node and the request timeout of the current sequence
+func (api *API) GetLatencies() *Latencies {
+	timeout, latencies := api.backend.Latencies()
+	if latencies == nil {
+		latencies = make([]*istanbul.ValidatorLatency, 0)
+	}
+	return &Latencies{
+		Adaptive:       api.backend.config.AdaptiveTimeout,
+		RequestTimeout: uint64(timeout / time.Millisecond),
+		Validators:     latencies,
+	}
+}
+
+// OnlineValidators is the attestation of the validators the node saw online at
+// a height
+type OnlineValidators struct {
+	Height     hexutil.Uint64   `json:"height"`
+	Validators []common.Address `json:"validators"`         // Senders of the consensus messages
+	Proposer   *common.Address  `json:"proposer,omitempty"` // Empty block proposer the votes are for
+	Voters     []common.Address `json:"voters"`             // Reward addresses of the empty block voters
+	Weight     *hexutil.Big     `json:"weight"`             // Coefficient weighted stake of the voters
+	Messages   []hexutil.Bytes  `json:"messages"`           // Signed empty block votes
+}
+
+// GetOnlineValidators returns the validators the node saw online at the given
+// height, the senders of the consensus messages and the voters of the empty
+// block it proposed, if any
+func (api *IstanbulAPI) GetOnlineValidators(height hexutil.Uint64) (*OnlineValidators, error) {
+	attestation := api.backend.attestations.get(uint64(height))
+	if attestation == nil {
+		// Not persisted, the consensus core may still know the senders
+		validators := api.backend.OnlineValidators(uint64(height))
+		if len(validators) == 0 {
+			return nil, errNoAttestation
+		}
+		attestation = &types.OnlineAttestation{Height: uint64(height), Validators: validators}
+	}
+	online := &OnlineValidators{
+		Height:     height,
+		Validators: append([]common.Address{}, attestation.Validators...),
+		Voters:     append([]common.Address{}, attestation.Voters...),
+		Weight:     (*hexutil.Big)(new(big.Int)),
+		Messages:   make([]hexutil.Bytes, 0, len(attestation.Messages)),
+	}
+	if attestation.Proposer != (common.Address{}) {
+		proposer := attestation.Proposer
+		online.Proposer = &proposer
+	}
+	if attestation.Weight != nil {
+		online.Weight = (*hexutil.Big)(attestation.Weight)
+	}
+	for _, msg := range attestation.Messages {
+		online.Messages = append(online.Messages, msg)
+	}
+	return online, nil
+}
+
 // GetSignersFromBlock returns the signers and minter for a given block number, or the
 // latest block available if none is specified
 func (api *API) GetSignersFromBlock(number *rpc.BlockNumber) (*BlockSigners, error) {
@@ -0,0 +1,110 @@
+package backend
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// errNoAttestation is returned if the node has no attestation of a height.
+var errNoAttestation = errors.New("no online validators attested at height")
+
+// AttestationRecorder is implemented by the consensus engines that persist the
+// empty block votes collected by the node
+type AttestationRecorder interface {
+	RecordEmptyBlockVotes(height *big.Int, proposer common.Address, voters []common.Address, weight *big.Int, messages [][]byte)
+}
+
+// attestationStore persists the online validator attestations the node
+// collects, so they survive a restart. The attestations older than the
+// retention are pruned as new heights are recorded.
+type attestationStore struct {
+	mu        sync.Mutex
+	db        ethdb.Database
+	retention uint64
+	pruned    uint64 // Heights below are pruned
+}
+
+func newAttestationStore(db ethdb.Database, retention uint64) *attestationStore {
+	store := &attestationStore{db: db, retention: retention}
+	if store.enabled() {
+		store.pruned = rawdb.ReadOnlineAttestationTail(db)
+	}
+	return store
+}
+
+// enabled reports whether the attestations are persisted.
+func (s *attestationStore) enabled() bool {
+	return s.db != nil && s.retention > 0
+}
+
+// update applies fn to the attestation of the height and stores it.
+func (s *attestationStore) update(height uint64, fn func(*types.OnlineAttestation)) {
+	if !s.enabled() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attestation := rawdb.ReadOnlineAttestation(s.db, height)
+	if attestation == nil {
+		attestation = &types.OnlineAttestation{Height: height, Weight: new(big.Int)}
+	}
+	fn(attestation)
+	rawdb.WriteOnlineAttestation(s.db, attestation)
+
+	if height > s.retention && height-s.retention > s.pruned {
+		rawdb.DeleteOnlineAttestations(s.db, s.pruned, height-s.retention)
+		s.pruned = height - s.retention
+		rawdb.WriteOnlineAttestationTail(s.db, s.pruned)
+	}
+}
+
+// recordValidators records the senders of the consensus messages of the height.
+func (s *attestationStore) recordValidators(height uint64, validators []common.Address) {
+	if len(validators) == 0 {
+		return
+	}
+	s.update(height, func(attestation *types.OnlineAttestation) {
+		for _, addr := range validators {
+			if !containsAddress(attestation.Validators, addr) {
+				attestation.Validators = append(attestation.Validators, addr)
+			}
+		}
+	})
+}
+
+// recordVotes records the votes collected for the empty block of the height,
+// the last collection supersedes the previous ones.
+func (s *attestationStore) recordVotes(height uint64, proposer common.Address, voters []common.Address, weight *big.Int, messages [][]byte) {
+	s.update(height, func(attestation *types.OnlineAttestation) {
+		attestation.Proposer = proposer
+		attestation.Voters = append([]common.Address{}, voters...)
+		if weight != nil {
+			attestation.Weight = new(big.Int).Set(weight)
+		}
+		attestation.Messages = append([][]byte{}, messages...)
+	})
+}
+
+// get returns the attestation of the height, nil if none is stored.
+func (s *attestationStore) get(height uint64) *types.OnlineAttestation {
+	if !s.enabled() {
+		return nil
+	}
+	return rawdb.ReadOnlineAttestation(s.db, height)
+}
+
+func containsAddress(list []common.Address, addr common.Address) bool {
+	for _, a := range list {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
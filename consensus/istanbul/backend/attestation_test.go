@@ -0,0 +1,98 @@
+package backend
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+)
+
+func TestAttestationStore(t *testing.T) {
+	var (
+		db    = rawdb.NewMemoryDatabase()
+		store = newAttestationStore(db, 3)
+		a     = common.HexToAddress("0x01")
+		b     = common.HexToAddress("0x02")
+	)
+	// The senders are merged, the last votes supersede the previous ones
+	store.recordValidators(1, []common.Address{a})
+	store.recordValidators(1, []common.Address{a, b})
+	store.recordVotes(1, a, []common.Address{a}, big.NewInt(1), [][]byte{{0x01}})
+	store.recordVotes(1, b, []common.Address{a, b}, big.NewInt(2), [][]byte{{0x01}, {0x02}})
+
+	attestation := store.get(1)
+	if attestation == nil {
+		t.Fatal("attestation not stored")
+	}
+	if !reflect.DeepEqual(attestation.Validators, []common.Address{a, b}) {
+		t.Errorf("validators mismatch: have %v, want %v", attestation.Validators, []common.Address{a, b})
+	}
+	if attestation.Proposer != b || !reflect.DeepEqual(attestation.Voters, []common.Address{a, b}) {
+		t.Errorf("votes mismatch: have %x %v, want %x %v", attestation.Proposer, attestation.Voters, b, []common.Address{a, b})
+	}
+	if attestation.Weight.Cmp(big.NewInt(2)) != 0 || len(attestation.Messages) != 2 {
+		t.Errorf("votes mismatch: have weight %v and %d messages, want 2 and 2", attestation.Weight, len(attestation.Messages))
+	}
+
+	// The heights out of the retention are pruned as new ones are recorded
+	for height := uint64(2); height <= 5; height++ {
+		store.recordValidators(height, []common.Address{a})
+	}
+	for height := uint64(1); height <= 5; height++ {
+		if have, want := store.get(height) != nil, height > 1; have != want {
+			t.Errorf("height %d: stored mismatch: have %v, want %v", height, have, want)
+		}
+	}
+
+	// The pruned heights survive a restart, the heights below are left alone
+	store = newAttestationStore(db, 3)
+	if store.pruned != 2 {
+		t.Fatalf("pruned mismatch: have %d, want 2", store.pruned)
+	}
+	store.recordValidators(1, []common.Address{a})
+	store.recordValidators(6, []common.Address{a})
+	for height := uint64(1); height <= 6; height++ {
+		if have, want := store.get(height) != nil, height != 2; have != want {
+			t.Errorf("height %d: stored mismatch after restart: have %v, want %v", height, have, want)
+		}
+	}
+
+	// Nothing is stored without a retention
+	store = newAttestationStore(rawdb.NewMemoryDatabase(), 0)
+	store.recordValidators(1, []common.Address{a})
+	if store.get(1) != nil {
+		t.Error("attestation stored without retention")
+	}
+}
+
+func TestGetOnlineValidators(t *testing.T) {
+	var (
+		a       = common.HexToAddress("0x01")
+		b       = common.HexToAddress("0x02")
+		backend = &Backend{attestations: newAttestationStore(rawdb.NewMemoryDatabase(), 10)}
+		api     = &IstanbulAPI{backend: backend}
+	)
+	backend.attestations.recordValidators(1, []common.Address{a, b})
+	backend.attestations.recordVotes(1, b, []common.Address{b}, big.NewInt(5), [][]byte{{0x01}})
+
+	online, err := api.GetOnlineValidators(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if online.Height != 1 || !reflect.DeepEqual(online.Validators, []common.Address{a, b}) {
+		t.Errorf("validators mismatch: have %d %v, want 1 %v", online.Height, online.Validators, []common.Address{a, b})
+	}
+	if online.Proposer == nil || *online.Proposer != b || !reflect.DeepEqual(online.Voters, []common.Address{b}) {
+		t.Errorf("votes mismatch: have %v %v, want %x %v", online.Proposer, online.Voters, b, []common.Address{b})
+	}
+	if online.Weight.ToInt().Cmp(big.NewInt(5)) != 0 || !reflect.DeepEqual(online.Messages, []hexutil.Bytes{{0x01}}) {
+		t.Errorf("votes mismatch: have weight %v and messages %v", online.Weight, online.Messages)
+	}
+
+	if _, err := api.GetOnlineValidators(2); err != errNoAttestation {
+		t.Errorf("error mismatch: have %v, want %v", err, errNoAttestation)
+	}
+}
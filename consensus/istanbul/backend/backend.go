@@ -37,6 +37,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
@@ -60,6 +61,7 @@ func New(config *istanbul.Config, privateKey *ecdsa.PrivateKey, db ethdb.Databas
 		config:           config,
 		istanbulEventMux: new(event.TypeMux),
 		privateKey:       privateKey,
+		blsKey:           bls.SecretKeyFromECDSA(privateKey),
 		address:          crypto.PubkeyToAddress(privateKey.PublicKey),
 		logger:           log.New(),
 		db:               db,
@@ -70,6 +72,8 @@ func New(config *istanbul.Config, privateKey *ecdsa.PrivateKey, db ethdb.Databas
 		recentMessages:   recentMessages,
 		knownMessages:    knownMessages,
 		notifyBlockCh:    make(chan *types.OnlineValidatorList, 1),
+		evidence:         newEvidencePool(),
+		attestations:     newAttestationStore(db, config.AttestationRetention),
 	}
 
 	sb.qbftEngine = qbftengine.NewEngine(sb.config, sb.address, sb.Sign)
@@ -84,6 +88,7 @@ type Backend struct {
 	config *istanbul.Config
 
 	privateKey *ecdsa.PrivateKey
+	blsKey     *bls.SecretKey
 	address    common.Address
 
 	core istanbul.Core
@@ -124,6 +129,9 @@ type Backend struct {
 	qbftConsensusEnabled bool // qbft consensus
 
 	notifyBlockCh chan *types.OnlineValidatorList // Notify worker modules to produce blocks
+
+	evidence     *evidencePool     // Evidence of equivocating empty block voters
+	attestations *attestationStore // Online validators attested per height
 }
 
 func (sb *Backend) Engine() istanbul.Engine {
@@ -333,6 +341,24 @@ func (sb *Backend) SignWithoutHashing(data []byte) ([]byte, error) {
 	return crypto.Sign(data, sb.privateKey)
 }
 
+// SignBLS implements istanbul.Backend.SignBLS and signs input data with the backend's bls key
+func (sb *Backend) SignBLS(data []byte) []byte {
+	return sb.blsKey.Sign(data)
+}
+
+// BLSPublicKey implements istanbul.Backend.BLSPublicKey
+func (sb *Backend) BLSPublicKey(addr common.Address) []byte {
+	chain, ok := sb.chain.(*core.BlockChain)
+	if !ok {
+		return nil
+	}
+	all, err := chain.ReadValidatorPool(chain.CurrentHeader())
+	if err != nil {
+		return nil
+	}
+	return all.BLSPubKey(addr)
+}
+
 // CheckSignature implements istanbul.Backend.CheckSignature
 func (sb *Backend) CheckSignature(data []byte, address common.Address, sig []byte) error {
 	signer, err := istanbul.GetSignatureAddress(data, sig)
@@ -32,6 +32,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -56,6 +57,12 @@ func (sb *Backend) Signers(header *types.Header) ([]common.Address, error) {
 	return sb.EngineForBlockNumber(header.Number).Signers(header)
 }
 
+// RewardSigners implements core.RewardSigner, returning the committers of
+// preHeader proven by the reward seals of header
+func (sb *Backend) RewardSigners(preHeader, header *types.Header) ([]common.Address, error) {
+	return sb.EngineForBlockNumber(header.Number).RewardSigners(preHeader, header)
+}
+
 // VerifyHeader checks whether a header conforms to the consensus rules of a
 // given engine. Verifying the seal may be done optionally here, or explicitly
 // via the VerifySeal method.
@@ -72,7 +79,7 @@ func (sb *Backend) verifyHeader(chain consensus.ChainHeaderReader, header *types
 		}
 	} else {
 		// Get the validatorset for this round
-		istanbulExtra, err := types.ExtractIstanbulExtra(header)
+		istanbulExtra, err := types.ExtractWormholesExtra(header)
 		if err != nil {
 			return istanbulcommon.ErrInvalidExtraDataFormat
 		}
@@ -152,6 +159,16 @@ func (sb *Backend) VerifyUncles(chain consensus.ChainReader, block *types.Block)
 	return sb.EngineForBlockNumber(block.Header().Number).VerifyUncles(chain, block)
 }
 
+// VerifyAggregatedSeals checks the aggregated bls committed seal of the header
+// against the bls keys of the validator list of its parent.
+func (sb *Backend) VerifyAggregatedSeals(header *types.Header, validatorList *types.ValidatorList) error {
+	// The committed seals of qbft blocks are never aggregated
+	if sb.IsQBFTConsensusAt(header.Number) {
+		return nil
+	}
+	return sb.ibftEngine.VerifyAggregatedSeals(header, validatorList)
+}
+
 // VerifySeal checks whether the crypto seal on a header is valid according to
 // the consensus rules of the given engine.
 func (sb *Backend) VerifySeal(chain consensus.ChainHeaderReader, header *types.Header) error {
@@ -235,14 +252,53 @@ func (sb *Backend) Prepare(chain consensus.ChainHeaderReader, header *types.Head
 		valSet = validator.NewSet(validatorList.ConvertToAddress(), sb.config.ProposerPolicy)
 	}
 
-	err := sb.EngineForBlockNumber(header.Number).Prepare(chain, header, valSet)
+	engine := sb.EngineForBlockNumber(header.Number)
+	err := engine.Prepare(chain, header, valSet)
 	if err != nil {
 		return err
 	}
 
+	// After the evidence fork the equivocations at the parent height are
+	// penalized in the block
+	if sb.config.IsEvidence(header.Number) {
+		parentHeight := header.Number.Uint64() - 1
+		if evidence := sb.evidence.list(&parentHeight); len(evidence) > 0 {
+			if err := engine.WriteEvidence(header, evidence); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
+// Participation implements consensus.ParticipationReader
+func (sb *Backend) Participation(chain consensus.ChainHeaderReader, header *types.Header) ([]*types.ValidatorStats, error) {
+	if sb.IsQBFTConsensusAt(header.Number) {
+		return sb.qbftEngine.Participation(chain, header)
+	}
+	return sb.ibftEngine.Participation(chain, header)
+}
+
+// ReportEvidence adds the evidence of an equivocating empty block voter, it
+// is included in the next block proposed by the node.
+func (sb *Backend) ReportEvidence(evidence *types.EmptyBlockEvidence) error {
+	if err := evidence.Verify(); err != nil {
+		return err
+	}
+	if sb.evidence.add(evidence) {
+		log.Info("Backend.ReportEvidence", "offender", evidence.Offender, "height", evidence.Height, "hash", evidence.Hash())
+	}
+	return nil
+}
+
+// RecordEmptyBlockVotes persists the votes the node collected for the empty
+// block it proposes at height, the reward addresses of the voters and their
+// signed messages start with the ones of the proposer.
+func (sb *Backend) RecordEmptyBlockVotes(height *big.Int, proposer common.Address, voters []common.Address, weight *big.Int, messages [][]byte) {
+	sb.attestations.recordVotes(height.Uint64(), proposer, voters, weight, messages)
+}
+
 // Finalize runs any post-transaction state modifications (e.g. block rewards)
 // and assembles the final block.
 //
@@ -255,8 +311,36 @@ func (sb *Backend) Finalize(chain consensus.ChainHeaderReader, header *types.Hea
 // FinalizeAndAssemble implements consensus.Engine, ensuring no uncles are set,
 // nor block rewards given, and returns the final block.
 func (sb *Backend) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
-	return sb.EngineForBlockNumber(header.Number).FinalizeAndAssemble(chain, header, state, txs, uncles, receipts)
+	engine := sb.EngineForBlockNumber(header.Number)
+	block, err := engine.FinalizeAndAssemble(chain, header, state, txs, uncles, receipts)
+	if err != nil || !sb.config.IsCommittee(header.Number) {
+		return block, err
+	}
 
+	// After the committee fork the header commits to the validator pool left
+	// by the block, which the committee of the next block is drawn from
+	c, ok := chain.(*core.BlockChain)
+	if !ok {
+		return block, nil
+	}
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	checkpoint, err := c.NextValidatorCheckpoint(parent, state)
+	if err != nil {
+		return nil, err
+	}
+	hdr := block.Header()
+	if header.Number.Uint64()%params.ValidatorCheckpointInterval == 0 {
+		err = engine.WriteValidatorCommitment(hdr, checkpoint.Hash(), checkpoint)
+	} else {
+		err = engine.WriteValidatorCommitment(hdr, checkpoint.Hash(), nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return block.WithSeal(hdr), nil
 }
 
 // SealforEmptyBlock generates a new block for the given input block with the local miner's
@@ -305,9 +389,9 @@ func (sb *Backend) Seal(chain consensus.ChainHeaderReader, block *types.Block, r
 	}
 
 	//Get the validatorset for this round
-	istanbulExtra, err1 := types.ExtractIstanbulExtra(header)
+	istanbulExtra, err1 := types.ExtractWormholesExtra(header)
 	if err1 != nil {
-		log.Error("Seal : ExtractIstanbulExtra", "err", err1)
+		log.Error("Seal : ExtractWormholesExtra", "err", err1)
 		return err1
 	}
 
@@ -369,6 +453,11 @@ func (sb *Backend) APIs(chain consensus.ChainHeaderReader) []rpc.API {
 		Version:   "1.0",
 		Service:   &API{chain: chain, backend: sb},
 		Public:    true,
+	}, {
+		Namespace: "istanbul",
+		Version:   "1.0",
+		Service:   &IstanbulAPI{backend: sb},
+		Public:    true,
 	}}
 }
 
@@ -691,3 +780,13 @@ func (sb *Backend) OnlineValidators(height uint64) []common.Address {
 	}
 	return nil
 }
+
+// Latencies returns the request timeout of the current sequence and the
+// message latencies of the validators observed by the consensus core
+func (sb *Backend) Latencies() (time.Duration, []*istanbul.ValidatorLatency) {
+	c := sb.GetCore()
+	if c == nil {
+		return time.Duration(sb.config.RequestTimeout) * time.Millisecond, nil
+	}
+	return c.RequestTimeout(), c.Latencies()
+}
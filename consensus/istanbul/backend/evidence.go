@@ -0,0 +1,72 @@
+package backend
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	maxEvidence = 1024 // Number of evidences kept in memory
+)
+
+// evidencePool keeps the evidence of equivocating empty block voters reported
+// by the miner, the oldest evidence is dropped once the pool is full.
+type evidencePool struct {
+	mu       sync.RWMutex
+	evidence map[common.Hash]*types.EmptyBlockEvidence
+}
+
+func newEvidencePool() *evidencePool {
+	return &evidencePool{evidence: make(map[common.Hash]*types.EmptyBlockEvidence)}
+}
+
+// add adds the evidence to the pool, it returns false if it is known.
+func (p *evidencePool) add(evidence *types.EmptyBlockEvidence) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	hash := evidence.Hash()
+	if _, ok := p.evidence[hash]; ok {
+		return false
+	}
+	if len(p.evidence) >= maxEvidence {
+		var (
+			oldestHash     common.Hash
+			oldestEvidence *types.EmptyBlockEvidence
+		)
+		for h, ev := range p.evidence {
+			if oldestEvidence == nil || ev.Height.Cmp(oldestEvidence.Height) < 0 {
+				oldestHash, oldestEvidence = h, ev
+			}
+		}
+		delete(p.evidence, oldestHash)
+	}
+	p.evidence[hash] = evidence
+	return true
+}
+
+// list returns the evidence of the votes at height, or all evidence if height
+// is nil, ordered by height and hash.
+func (p *evidencePool) list(height *uint64) []*types.EmptyBlockEvidence {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	list := make([]*types.EmptyBlockEvidence, 0)
+	for _, ev := range p.evidence {
+		if height == nil || ev.Height.Uint64() == *height {
+			list = append(list, ev)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if c := list[i].Height.Cmp(list[j].Height); c != 0 {
+			return c < 0
+		}
+		hi, hj := list[i].Hash(), list[j].Hash()
+		return bytes.Compare(hi[:], hj[:]) < 0
+	})
+	return list
+}
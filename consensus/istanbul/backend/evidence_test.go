@@ -0,0 +1,75 @@
+package backend
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func newTestEvidence(t *testing.T, key *ecdsa.PrivateKey, height int64) *types.EmptyBlockEvidence {
+	var payloads [][]byte
+	for _, vote := range []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")} {
+		enc, _ := rlp.EncodeToBytes(&types.SignatureData{Vote: vote, Height: big.NewInt(height), Round: 1})
+		msg := &types.EmptyMsg{Code: 1, Msg: enc, Address: crypto.PubkeyToAddress(key.PublicKey)}
+		unsigned, _ := msg.PayloadNoSig()
+		msg.Signature, _ = crypto.Sign(crypto.Keccak256(unsigned), key)
+		payload, _ := msg.Payload()
+		payloads = append(payloads, payload)
+	}
+	evidence, err := types.NewEmptyBlockEvidence(payloads[0], payloads[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return evidence
+}
+
+func TestReportEvidence(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	backend := &Backend{evidence: newEvidencePool()}
+
+	second := newTestEvidence(t, key, 2)
+	first := newTestEvidence(t, other, 1)
+	for _, evidence := range []*types.EmptyBlockEvidence{second, first, second} {
+		if err := backend.ReportEvidence(evidence); err != nil {
+			t.Fatalf("valid evidence rejected: %v", err)
+		}
+	}
+	invalid := newTestEvidence(t, key, 3)
+	invalid.Offender = crypto.PubkeyToAddress(other.PublicKey)
+	if err := backend.ReportEvidence(invalid); err != types.ErrEvidenceSigner {
+		t.Fatalf("error mismatch: have %v, want %v", err, types.ErrEvidenceSigner)
+	}
+
+	// The evidence is listed once, ordered by height
+	list := backend.evidence.list(nil)
+	if len(list) != 2 || list[0].Hash() != first.Hash() || list[1].Hash() != second.Hash() {
+		t.Fatalf("evidence mismatch: have %v", list)
+	}
+	height := uint64(2)
+	if list := backend.evidence.list(&height); len(list) != 1 || list[0].Hash() != second.Hash() {
+		t.Fatalf("evidence at height %d mismatch: have %v", height, list)
+	}
+}
+
+func TestEvidencePoolLimit(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	pool := newEvidencePool()
+	for i := 0; i <= maxEvidence; i++ {
+		if !pool.add(newTestEvidence(t, key, int64(i))) {
+			t.Fatalf("evidence %d not added", i)
+		}
+	}
+	list := pool.list(nil)
+	if len(list) != maxEvidence {
+		t.Fatalf("pool size mismatch: have %d, want %d", len(list), maxEvidence)
+	}
+	if list[0].Height.Uint64() != 1 {
+		t.Fatalf("oldest evidence not dropped: lowest height %d", list[0].Height)
+	}
+}
@@ -149,6 +149,10 @@ func (sb *Backend) SetBroadcaster(broadcaster consensus.Broadcaster) {
 
 func (sb *Backend) NewChainHead() error {
 	log.Info("Backend : NewChainHead", "no", sb.chain.CurrentHeader().Number.Uint64()+1)
+	// The consensus messages of the new head are all in, persist their senders
+	head := sb.chain.CurrentHeader().Number.Uint64()
+	sb.attestations.recordValidators(head, sb.OnlineValidators(head))
+
 	sb.coreMu.RLock()
 	defer sb.coreMu.RUnlock()
 	if !sb.coreStarted {
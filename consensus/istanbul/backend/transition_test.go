@@ -0,0 +1,254 @@
+package backend
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	ibftengine "github.com/ethereum/go-ethereum/consensus/istanbul/ibft/engine"
+	qbftengine "github.com/ethereum/go-ethereum/consensus/istanbul/qbft/engine"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/testutils"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// newTransitionChain creates a blockchain of a full committee of validators
+// and four open exchangers switching to qbft at qbftBlock. The first key is
+// the local one.
+func newTransitionChain(t *testing.T, qbftBlock int64) (*core.BlockChain, *Backend, []*ecdsa.PrivateKey, []common.Address) {
+	keys := make([]*ecdsa.PrivateKey, types.IstanbulCommitteeSize)
+	keys[0], _ = crypto.HexToECDSA("f616c4d20311a2e73c67ef334630f834b7fb42304a1d4448fb2058e9940ecc0a")
+	for i := 1; i < len(keys); i++ {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	validators := make([]common.Address, len(keys))
+	for i, key := range keys {
+		validators[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+
+	genesis := testutils.Genesis(validators, false)
+	chainConfig := *genesis.Config
+	chainConfig.Istanbul = &params.IstanbulConfig{TestQBFTBlock: big.NewInt(qbftBlock)}
+	genesis.Config = &chainConfig
+
+	pledge := new(big.Int).Mul(big.NewInt(70000), big.NewInt(params.Ether))
+	genesis.Validator = make(core.GenesisAlloc)
+	for _, addr := range validators {
+		genesis.Alloc[addr] = core.GenesisAccount{Balance: pledge}
+		genesis.Validator[addr] = core.GenesisAccount{Balance: pledge}
+	}
+	exchangers := make([]common.Address, 4)
+	genesis.Stake = make(core.GenesisAlloc)
+	for i := range exchangers {
+		exchangers[i] = common.BigToAddress(big.NewInt(int64(0xe0 + i)))
+		genesis.Alloc[exchangers[i]] = core.GenesisAccount{Balance: pledge}
+		genesis.Stake[exchangers[i]] = core.GenesisAccount{Balance: pledge, FeeRate: 100}
+	}
+	genesis.Dir = "/ipfs/snft"
+	genesis.InjectNumber = 4096
+	genesis.Royalty = 100
+	genesis.Creator = "0x0000000000000000000000000000000000000001"
+
+	cfg := copyConfig(istanbul.DefaultConfig)
+	cfg.BlockPeriod = 0
+	cfg.TestQBFTBlock = big.NewInt(qbftBlock)
+	cfg.ChainConfig = &chainConfig
+	chain, engine := newBlockchainFromConfig(genesis, keys, cfg)
+	return chain, engine, keys, validators
+}
+
+// newTransitionState returns the state of parent with the wormholes pools
+// the child of parent is processed with.
+func newTransitionState(t *testing.T, chain *core.BlockChain, parent *types.Block) *state.StateDB {
+	statedb, err := chain.StateAt(parent.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chain.LoadWormholesPools(statedb, parent.Header()); err != nil {
+		t.Fatal(err)
+	}
+	return statedb
+}
+
+// makeTransitionBlock builds, seals and commits the normal child of parent,
+// the committed seals are signed by the committers.
+func makeTransitionBlock(t *testing.T, chain *core.BlockChain, engine *Backend, parent *types.Block, committers []*ecdsa.PrivateKey) *types.Block {
+	header := makeHeader(parent, engine.config)
+	header.Coinbase = engine.Address()
+	if err := engine.Prepare(chain, header); err != nil {
+		t.Fatalf("block %d: prepare: %v", header.Number, err)
+	}
+	block, err := engine.FinalizeAndAssemble(chain, header, newTransitionState(t, chain, parent), nil, nil, nil)
+	if err != nil {
+		t.Fatalf("block %d: finalize: %v", header.Number, err)
+	}
+	extra, err := types.ExtractWormholesExtra(block.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err = engine.EngineForBlockNumber(header.Number).Seal(chain, block, validator.NewSet(extra.Validators, engine.config.ProposerPolicy))
+	if err != nil {
+		t.Fatalf("block %d: seal: %v", header.Number, err)
+	}
+
+	header = block.Header()
+	var seals [][]byte
+	for _, key := range committers {
+		var hash []byte
+		if engine.IsQBFTConsensusAt(header.Number) {
+			hash = qbftengine.PrepareCommittedSeal(header, 0)
+		} else {
+			hash = crypto.Keccak256(ibftengine.PrepareCommittedSeal(block.Hash()))
+		}
+		seal, err := crypto.Sign(hash, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seals = append(seals, seal)
+	}
+	if err := engine.EngineForBlockNumber(header.Number).CommitHeader(header, seals, big.NewInt(0)); err != nil {
+		t.Fatalf("block %d: commit: %v", header.Number, err)
+	}
+	return block.WithSeal(header)
+}
+
+// makeTransitionEmptyBlock builds and seals the empty child of parent voted
+// by the voters, the first one proposes it.
+func makeTransitionEmptyBlock(t *testing.T, chain *core.BlockChain, engine *Backend, parent *types.Block, voters []*ecdsa.PrivateKey) *types.Block {
+	var (
+		addrs    []common.Address
+		messages [][]byte
+	)
+	height := new(big.Int).Add(parent.Number(), common.Big1)
+	for _, key := range voters {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		enc, _ := rlp.EncodeToBytes(&types.SignatureData{Vote: addr, Height: height})
+		msg := &types.EmptyMsg{Code: 1, Msg: enc, Address: addr}
+		unsigned, _ := msg.PayloadNoSig()
+		msg.Signature, _ = crypto.Sign(crypto.Keccak256(unsigned), key)
+		payload, _ := msg.Payload()
+		addrs = append(addrs, addr)
+		messages = append(messages, payload)
+	}
+
+	header := makeHeader(parent, engine.config)
+	if err := engine.PrepareForEmptyBlock(chain, header, addrs, messages); err != nil {
+		t.Fatalf("block %d: prepare: %v", header.Number, err)
+	}
+	block, err := engine.FinalizeAndAssemble(chain, header, newTransitionState(t, chain, parent), nil, nil, nil)
+	if err != nil {
+		t.Fatalf("block %d: finalize: %v", header.Number, err)
+	}
+	if block, err = engine.SealforEmptyBlock(chain, block, addrs); err != nil {
+		t.Fatalf("block %d: seal: %v", header.Number, err)
+	}
+	return block
+}
+
+// TestIBFTToQBFTTransitionChain inserts the last ibft block, an empty qbft
+// block and the first normal qbft blocks into a blockchain, and checks the
+// rewards, the coefficient adjustments and the snfts minted by Finalize
+// across the switch.
+func TestIBFTToQBFTTransitionChain(t *testing.T) {
+	chain, engine, keys, validators := newTransitionChain(t, 2)
+	defer engine.Stop()
+	defer chain.Stop()
+
+	// Block 1 is the last ibft block, the local validator doesn't commit it
+	ibftBlock := makeTransitionBlock(t, chain, engine, chain.Genesis(), keys[1:])
+	if _, err := chain.InsertChain(types.Blocks{ibftBlock}); err != nil {
+		t.Fatalf("failed to insert ibft block: %v", err)
+	}
+
+	// Block 2 is an empty qbft block, it penalizes the committee and rewards
+	// the voters except its proposer
+	emptyBlock := makeTransitionEmptyBlock(t, chain, engine, ibftBlock, keys)
+	if _, err := chain.InsertChain(types.Blocks{emptyBlock}); err != nil {
+		t.Fatalf("failed to insert empty block: %v", err)
+	}
+	statedb, err := chain.State()
+	if err != nil {
+		t.Fatal(err)
+	}
+	penalized := state.VALIDATOR_COEFFICIENT - uint8(params.DefaultEmptyBlockConfig.GetPenalty())
+	if have := statedb.GetValidatorCoefficient(validators[0]); have != penalized {
+		t.Errorf("coefficient of the empty block proposer mismatch: have %d, want %d", have, penalized)
+	}
+	for _, addr := range validators[1:] {
+		if have := statedb.GetValidatorCoefficient(addr); have != state.VALIDATOR_COEFFICIENT {
+			t.Errorf("coefficient of voter %x mismatch: have %d, want %d", addr, have, state.VALIDATOR_COEFFICIENT)
+		}
+	}
+
+	// Block 3 is the first normal qbft block, it rewards the committers of
+	// block 1 across the empty block
+	qbftBlock := makeTransitionBlock(t, chain, engine, emptyBlock, keys)
+	if _, err := chain.InsertChain(types.Blocks{qbftBlock}); err != nil {
+		t.Fatalf("failed to insert qbft block: %v", err)
+	}
+	extra, err := types.ExtractWormholesExtra(qbftBlock.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(extra.ValidatorAddr) != ibftengine.QuorumSize(len(validators)) {
+		t.Fatalf("rewarded validators mismatch: have %d, want %d", len(extra.ValidatorAddr), ibftengine.QuorumSize(len(validators)))
+	}
+	parentState, err := chain.StateAt(emptyBlock.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	statedb, err = chain.State()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reward := state.GetRewardAmount(qbftBlock.NumberU64(), state.DREBlockReward)
+	for _, addr := range extra.ValidatorAddr {
+		if addr == validators[0] {
+			t.Fatalf("validator %x rewarded without committing block 1", addr)
+		}
+		want := new(big.Int).Add(parentState.GetBalance(addr), reward)
+		if have := statedb.GetBalance(addr); have.Cmp(want) != 0 {
+			t.Errorf("balance of %x mismatch: have %v, want %v", addr, have, want)
+		}
+	}
+	if have := statedb.GetValidatorCoefficient(validators[0]); have != penalized {
+		t.Errorf("coefficient of %x mismatch: have %d, want %d", validators[0], have, penalized)
+	}
+	if len(extra.ExchangerAddr) == 0 {
+		t.Fatal("no exchangers rewarded")
+	}
+	mintDeep, err := chain.ReadMintDeep(qbftBlock.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, addr := range extra.ExchangerAddr {
+		snft := common.BigToAddress(new(big.Int).Add(mintDeep.OfficialMint, big.NewInt(int64(i-len(extra.ExchangerAddr)))))
+		if have := statedb.GetNFTOwner16(snft); have != addr {
+			t.Errorf("owner of snft %x mismatch: have %x, want %x", snft, have, addr)
+		}
+	}
+
+	// Block 4 rewards the committers of block 3, whose seals are signed over
+	// the qbft hash
+	nextBlock := makeTransitionBlock(t, chain, engine, qbftBlock, keys[:ibftengine.QuorumSize(len(keys))])
+	if _, err := chain.InsertChain(types.Blocks{nextBlock}); err != nil {
+		t.Fatalf("failed to insert second qbft block: %v", err)
+	}
+	if head := chain.CurrentBlock(); head.Hash() != nextBlock.Hash() {
+		t.Fatalf("head mismatch: have %d, want %d", head.NumberU64(), nextBlock.NumberU64())
+	}
+	statedb, err = chain.State()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have := statedb.GetValidatorCoefficient(validators[0]); have != state.VALIDATOR_COEFFICIENT {
+		t.Errorf("coefficient of %x mismatch: have %d, want %d", validators[0], have, state.VALIDATOR_COEFFICIENT)
+	}
+}
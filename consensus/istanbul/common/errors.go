@@ -51,6 +51,10 @@ var (
 	// ErrInvalidCommittedSeals is returned if the committed seal is not signed by any of parent validators.
 	ErrInvalidCommittedSeals = errors.New("invalid committed seals")
 
+	// ErrUnknownValidatorPool is returned if the validator pool the aggregated
+	// committed seal of a header is verified against is unknown.
+	ErrUnknownValidatorPool = errors.New("unknown validator pool")
+
 	// ErrEmptyCommittedSeals is returned if the field of committed seals is zero.
 	ErrEmptyCommittedSeals = errors.New("zero committed seals")
 
@@ -97,4 +101,8 @@ var (
 	ErrInvalidSigner = errors.New("message not signed by the sender")
 
 	ErrInvalidBenifitedAddr = errors.New("invalid benifited Address ")
+
+	// ErrUnexpectedEvidence is returned if a block contains equivocation evidence
+	// before the evidence fork or evidence not for the parent height.
+	ErrUnexpectedEvidence = errors.New("unexpected equivocation evidence")
 )
@@ -20,6 +20,7 @@ import (
 	"math/big"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/naoina/toml"
 )
 
@@ -107,21 +108,32 @@ func (p *ProposerPolicy) ClearRegistry() {
 
 type Config struct {
 	RequestTimeout         uint64          `toml:",omitempty"` // The timeout for each Istanbul round in milliseconds.
+	AdaptiveTimeout        bool            `toml:",omitempty"` // Derive the round timeout from the observed message latencies of the validators
+	MinRequestTimeout      uint64          `toml:",omitempty"` // Lower bound of the adaptive round timeout in milliseconds
+	MaxRequestTimeout      uint64          `toml:",omitempty"` // Upper bound of the adaptive round timeout in milliseconds
 	BlockPeriod            uint64          `toml:",omitempty"` // Default minimum difference between two consecutive block's timestamps in second
 	ProposerPolicy         *ProposerPolicy `toml:",omitempty"` // The policy for proposer selection
 	Epoch                  uint64          `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
 	Ceil2Nby3Block         *big.Int        `toml:",omitempty"` // Number of confirmations required to move from one state to next [2F + 1 to Ceil(2N/3)]
 	AllowedFutureBlockTime uint64          `toml:",omitempty"` // Max time (in seconds) from current time allowed for blocks, before they're considered future blocks
+	AttestationRetention   uint64          `toml:",omitempty"` // Number of heights the online validator attestations are kept for, zero disables them
 	TestQBFTBlock          *big.Int        `toml:",omitempty"` // Fork block at which block confirmations are done using qbft consensus instead of ibft
+
+	// ChainConfig is the configuration of the chain, the wormholes forks of
+	// the engines are read from it so that they have a single source
+	ChainConfig *params.ChainConfig `toml:"-"`
 }
 
 var DefaultConfig = &Config{
 	RequestTimeout:         10000,
+	MinRequestTimeout:      2000,
+	MaxRequestTimeout:      30000,
 	BlockPeriod:            5,
 	ProposerPolicy:         NewRoundRobinProposerPolicy(),
 	Epoch:                  30000,
 	Ceil2Nby3Block:         big.NewInt(0),
 	AllowedFutureBlockTime: 0,
+	AttestationRetention:   100000,
 	TestQBFTBlock:          big.NewInt(0),
 }
 
@@ -149,3 +161,51 @@ func (c *Config) IsQBFTConsensusAt(blockNumber *big.Int) bool {
 	}
 	return false
 }
+
+// IsBLS checks if the seals of the block identified by the given number are
+// aggregated bls signatures
+func (c *Config) IsBLS(blockNumber *big.Int) bool {
+	return c.ChainConfig != nil && c.ChainConfig.IsBLS(blockNumber)
+}
+
+// IsEvidence checks if the equivocation evidence of empty block votes is
+// penalized in the block identified by the given number
+func (c *Config) IsEvidence(blockNumber *big.Int) bool {
+	return c.ChainConfig != nil && c.ChainConfig.IsEvidence(blockNumber)
+}
+
+// IsCommittee checks if the header of the block identified by the given number
+// commits to the validators the committee of the next block is drawn from
+func (c *Config) IsCommittee(blockNumber *big.Int) bool {
+	return c.ChainConfig != nil && c.ChainConfig.IsCommittee(blockNumber)
+}
+
+// IsLagging checks if the committee members missing the commit quorum of the
+// block identified by the given number are drawn with a lower weight
+func (c *Config) IsLagging(blockNumber *big.Int) bool {
+	return c.ChainConfig != nil && c.ChainConfig.IsLagging(blockNumber)
+}
+
+// IsExtraVersion checks if the extra-data of the header of the block
+// identified by the given number is versioned and size limited
+func (c *Config) IsExtraVersion(blockNumber *big.Int) bool {
+	return c.ChainConfig != nil && c.ChainConfig.IsExtraVersion(blockNumber)
+}
+
+// GetEmptyBlock returns the empty block configuration of the chain, falling
+// back to the defaults if none is configured
+func (c *Config) GetEmptyBlock() *params.EmptyBlockConfig {
+	if c.ChainConfig == nil {
+		return params.DefaultEmptyBlockConfig
+	}
+	return c.ChainConfig.Istanbul.GetEmptyBlock()
+}
+
+// EmptyBlockAt returns the empty block consensus parameters of the block
+// identified by the given number
+func (c *Config) EmptyBlockAt(blockNumber *big.Int) *params.EmptyBlockConfig {
+	if c.ChainConfig == nil {
+		return params.DefaultEmptyBlockConfig
+	}
+	return c.ChainConfig.Istanbul.EmptyBlockAt(blockNumber)
+}
@@ -1,6 +1,8 @@
 package istanbul
 
 import (
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -22,4 +24,20 @@ type Core interface {
 	ConsensusInfo() chan map[string]interface{}
 
 	OnlineValidators(height uint64) []common.Address
+
+	// RequestTimeout returns the timeout of the first round of a sequence
+	RequestTimeout() time.Duration
+
+	// Latencies returns the message latencies of the validators observed by the node
+	Latencies() []*ValidatorLatency
+}
+
+// ValidatorLatency is the latency of the consensus messages of a validator
+// observed by the node, measured from the start of the round until the first
+// message of the validator for it is accepted
+type ValidatorLatency struct {
+	Address  common.Address `json:"address"`
+	Latency  uint64         `json:"latency"`  // Moving average of the latency in milliseconds
+	Samples  uint64         `json:"samples"`  // Number of rounds the latency was observed in
+	LastSeen uint64         `json:"lastSeen"` // Unix time of the last observation
 }
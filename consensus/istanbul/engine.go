@@ -15,6 +15,7 @@ type Engine interface {
 	Author(header *types.Header) (common.Address, error)
 	Validators(header *types.Header) ([]common.Address, error)
 	Signers(header *types.Header) ([]common.Address, error)
+	RewardSigners(preHeader, header *types.Header) ([]common.Address, error)
 	CommitHeader(header *types.Header, seals [][]byte, round *big.Int) error
 	VerifyBlockProposal(chain consensus.ChainHeaderReader, block *types.Block, validators ValidatorSet) (time.Duration, error)
 	VerifyHeader(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header, validators ValidatorSet) error
@@ -29,4 +30,6 @@ type Engine interface {
 	CalcDifficulty(chain consensus.ChainHeaderReader, time uint64, parent *types.Header) *big.Int
 	WriteVote(header *types.Header, candidate common.Address, authorize bool) error
 	ReadVote(header *types.Header) (candidate common.Address, authorize bool, err error)
+	WriteValidatorCommitment(header *types.Header, hash common.Hash, checkpoint *types.ValidatorCheckpoint) error
+	WriteEvidence(header *types.Header, evidence []*types.EmptyBlockEvidence) error
 }
@@ -24,6 +24,8 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
 	ibfttypes "github.com/ethereum/go-ethereum/consensus/istanbul/ibft/types"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -161,6 +163,7 @@ func (c *core) handleCommit(msg *ibfttypes.Message, src istanbul.Validator) erro
 		return err
 	}
 
+	c.verifyBLSCommittedSeal(msg, src)
 	c.acceptCommit(msg, src)
 	log.Info("ibftConsensus: handleCommit baseinfo", "no", commit.View.Sequence.Uint64(), "round", commit.View.Round, "from", src.Address().Hex(), "hash", commit.Digest.Hex(), "self", c.address.Hex())
 	// Commit the proposal once we have enough COMMIT messages and we are not in the Committed state.
@@ -197,6 +200,22 @@ func (c *core) verifyCommit(commit *istanbul.Subject, src istanbul.Validator) er
 	return nil
 }
 
+// verifyBLSCommittedSeal checks the bls part of the committed seal of a COMMIT
+// message, an invalid bls seal is dropped so the block falls back to the ecdsa
+// committed seals instead of carrying an aggregated seal that doesn't verify.
+func (c *core) verifyBLSCommittedSeal(msg *ibfttypes.Message, src istanbul.Validator) {
+	if len(msg.CommittedSeal) <= types.IstanbulExtraSeal || c.current.Proposal() == nil {
+		return
+	}
+	blsSeal := msg.CommittedSeal[types.IstanbulExtraSeal:]
+	pubKey := c.backend.BLSPublicKey(src.Address())
+	if pubKey == nil || !bls.Verify(pubKey, PrepareCommittedSeal(c.current.Proposal().Hash()), blsSeal) {
+		log.Warn("ibftConsensus: handleCommit invalid bls committed seal", "from", src.Address().Hex(),
+			"no", c.currentView().Sequence, "round", c.currentView().Round)
+		msg.CommittedSeal = msg.CommittedSeal[:types.IstanbulExtraSeal]
+	}
+}
+
 func (c *core) acceptCommit(msg *ibfttypes.Message, src istanbul.Validator) error {
 	logger := c.logger.New("from", src, "state", c.state)
 
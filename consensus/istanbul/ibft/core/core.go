@@ -28,6 +28,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	ibfttypes "github.com/ethereum/go-ethereum/consensus/istanbul/ibft/types"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	metrics "github.com/ethereum/go-ethereum/metrics"
@@ -56,6 +57,7 @@ func New(backend istanbul.Backend, config *istanbul.Config) *core {
 		pendingRequests:    prque.New(),
 		pendingRequestsMu:  new(sync.Mutex),
 		consensusTimestamp: time.Time{},
+		latencies:          newLatencyTracker(),
 	}
 
 	c.validateFn = c.checkValidatorSignature
@@ -79,6 +81,7 @@ func NewCore(backend istanbul.Backend, config *istanbul.Config, vExistFn func(co
 		commitHeight:       0,
 		onlineValidator:    make(map[uint64][]common.Address),
 		ovMu:               new(sync.Mutex),
+		latencies:          newLatencyTracker(),
 	}
 
 	c.validateFn = c.checkValidatorSignature
@@ -122,6 +125,8 @@ type core struct {
 
 	onlineValidator map[uint64][]common.Address // Online list of recorded altitudes
 	ovMu            *sync.Mutex
+
+	latencies *latencyTracker // Message latencies of the validators, the adaptive request timeout is derived from
 }
 
 type ConsensusData struct {
@@ -165,6 +170,13 @@ func (c *core) finalizeMessage(msg *ibfttypes.Message) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
+		// After the bls fork the bls signature of the seal follows the ecdsa one,
+		// the seals of the committers are aggregated into the block header
+		if c.config.IsBLS(c.current.Sequence()) {
+			if blsSeal := c.backend.SignBLS(seal); len(blsSeal) == bls.SignatureLength {
+				msg.CommittedSeal = append(msg.CommittedSeal, blsSeal...)
+			}
+		}
 	}
 
 	// Sign message
@@ -238,6 +250,9 @@ func (c *core) commit() {
 		for i, v := range c.current.Commits.Values() {
 			committedSeals[i] = make([]byte, types.IstanbulExtraSeal)
 			copy(committedSeals[i][:], v.CommittedSeal[:])
+			if len(v.CommittedSeal) == types.IstanbulExtraSeal+bls.SignatureLength {
+				committedSeals[i] = append(committedSeals[i], v.CommittedSeal[types.IstanbulExtraSeal:]...)
+			}
 		}
 		log.Info("ibftConsensus: commit baseInfo", "no", c.currentView().Sequence, "round", c.currentView().Round)
 
@@ -444,6 +459,7 @@ func (c *core) updateRoundState(view *istanbul.View, validatorSet istanbul.Valid
 		log.Info("ibftConsensus: updateRoundState|3", "no", view.Sequence, "round", view.Round, "author", c.address.Hex())
 		c.current = newRoundState(view, validatorSet, common.Hash{}, nil, nil, c.backend.HasBadProposal)
 	}
+	c.latencies.startRound(time.Now())
 }
 
 func (c *core) setState(state ibfttypes.State) {
@@ -477,7 +493,8 @@ func (c *core) newRoundChangeTimer() {
 	c.stopTimer()
 
 	// set timeout based on the round number
-	timeout := time.Duration(c.config.RequestTimeout) * time.Millisecond
+	timeout := c.requestTimeout()
+	c.latencies.setTimeout(timeout)
 	round := c.current.Round().Uint64()
 	if round > 0 {
 		timeout += time.Duration(math.Pow(2, float64(round))) * time.Second
@@ -17,6 +17,8 @@
 package core
 
 import (
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
@@ -222,16 +224,25 @@ func (c *core) handleCheckedMsg(msg *ibfttypes.Message, src istanbul.Validator)
 		return err
 	}
 
+	// Record the latency of the validators whose messages are accepted
+	// in the current round
+	observe := func(err error) error {
+		if err == nil {
+			c.latencies.observe(src.Address(), time.Now())
+		}
+		return testBacklog(err)
+	}
+
 	switch msg.Code {
 	case ibfttypes.MsgPreprepare:
 		err := c.handlePreprepare(msg, src)
-		return testBacklog(err)
+		return observe(err)
 	case ibfttypes.MsgPrepare:
 		err := c.handlePrepare(msg, src)
-		return testBacklog(err)
+		return observe(err)
 	case ibfttypes.MsgCommit:
 		err := c.handleCommit(msg, src)
-		return testBacklog(err)
+		return observe(err)
 	case ibfttypes.MsgRoundChange:
 		err := c.handleRoundChange(msg, src)
 		return testBacklog(err)
@@ -0,0 +1,167 @@
+package core
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+const (
+	// latencySmoothing is the weight of a new observation in the moving
+	// average of the latency of a validator
+	latencySmoothing = 0.2
+
+	// latencyTimeoutFactor is the round timeout in multiples of the latency
+	// the quorum of the committee answers with
+	latencyTimeoutFactor = 2
+)
+
+type validatorLatency struct {
+	average  float64 // Moving average in milliseconds
+	samples  uint64
+	lastSeen time.Time
+}
+
+// latencyTracker tracks the latency of the first message every validator sends
+// in a round. The committees are drawn every block, the latencies are kept
+// across them.
+type latencyTracker struct {
+	latencies map[common.Address]*validatorLatency
+	seen      map[common.Address]bool // Validators observed in the current round
+	start     time.Time               // Start of the current round
+	timeout   time.Duration           // Request timeout of the current sequence
+	mu        sync.RWMutex
+}
+
+func newLatencyTracker() *latencyTracker {
+	return &latencyTracker{
+		latencies: make(map[common.Address]*validatorLatency),
+		seen:      make(map[common.Address]bool),
+	}
+}
+
+// startRound starts measuring the latencies of a new round
+func (t *latencyTracker) startRound(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.start = now
+	t.seen = make(map[common.Address]bool)
+}
+
+func (t *latencyTracker) setTimeout(timeout time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.timeout = timeout
+}
+
+func (t *latencyTracker) requestTimeout() time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.timeout
+}
+
+// observe records the latency of addr if it is its first message of the round
+func (t *latencyTracker) observe(addr common.Address, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.start.IsZero() || t.seen[addr] {
+		return
+	}
+	t.seen[addr] = true
+
+	sample := float64(now.Sub(t.start)) / float64(time.Millisecond)
+	l, ok := t.latencies[addr]
+	if !ok {
+		t.latencies[addr] = &validatorLatency{average: sample, samples: 1, lastSeen: now}
+		return
+	}
+	l.average += latencySmoothing * (sample - l.average)
+	l.samples++
+	l.lastSeen = now
+}
+
+// quorumLatency returns the latency the quorum of the validators answers with,
+// it fails if fewer than quorum validators were observed
+func (t *latencyTracker) quorumLatency(validators []common.Address, quorum int) (time.Duration, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var averages []float64
+	for _, addr := range validators {
+		if l, ok := t.latencies[addr]; ok {
+			averages = append(averages, l.average)
+		}
+	}
+	if quorum <= 0 || len(averages) < quorum {
+		return 0, false
+	}
+	sort.Float64s(averages)
+	return time.Duration(averages[quorum-1] * float64(time.Millisecond)), true
+}
+
+// list returns the latencies of all observed validators sorted by address
+func (t *latencyTracker) list() []*istanbul.ValidatorLatency {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	list := make([]*istanbul.ValidatorLatency, 0, len(t.latencies))
+	for addr, l := range t.latencies {
+		list = append(list, &istanbul.ValidatorLatency{
+			Address:  addr,
+			Latency:  uint64(l.average),
+			Samples:  l.samples,
+			LastSeen: uint64(l.lastSeen.Unix()),
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return bytes.Compare(list[i].Address[:], list[j].Address[:]) < 0
+	})
+	return list
+}
+
+// requestTimeout returns the timeout of the first round of a sequence. In
+// adaptive mode it follows the latency the quorum of the committee answers
+// with, within the configured bounds.
+func (c *core) requestTimeout() time.Duration {
+	timeout := time.Duration(c.config.RequestTimeout) * time.Millisecond
+	if !c.config.AdaptiveTimeout || c.valSet == nil {
+		return timeout
+	}
+	validators := make([]common.Address, 0, c.valSet.Size())
+	for _, v := range c.valSet.List() {
+		validators = append(validators, v.Address())
+	}
+	latency, ok := c.latencies.quorumLatency(validators, c.QuorumSize())
+	if !ok {
+		return timeout
+	}
+	timeout = latency * latencyTimeoutFactor
+	if min := time.Duration(c.config.MinRequestTimeout) * time.Millisecond; timeout < min {
+		timeout = min
+	}
+	if max := time.Duration(c.config.MaxRequestTimeout) * time.Millisecond; max > 0 && timeout > max {
+		timeout = max
+	}
+	return timeout
+}
+
+// RequestTimeout returns the timeout of the first round of the current sequence
+func (c *core) RequestTimeout() time.Duration {
+	if timeout := c.latencies.requestTimeout(); timeout > 0 {
+		return timeout
+	}
+	return time.Duration(c.config.RequestTimeout) * time.Millisecond
+}
+
+// Latencies returns the message latencies of the validators observed by the node
+func (c *core) Latencies() []*istanbul.ValidatorLatency {
+	return c.latencies.list()
+}
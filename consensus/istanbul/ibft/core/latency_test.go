@@ -0,0 +1,125 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+func TestLatencyTracker(t *testing.T) {
+	var (
+		tracker = newLatencyTracker()
+		start   = time.Unix(1000, 0)
+		addr    = common.HexToAddress("0x01")
+	)
+	// Messages before the first round are not measured
+	tracker.observe(addr, start)
+	if len(tracker.list()) != 0 {
+		t.Fatal("latency observed outside of a round")
+	}
+
+	// Only the first message of a validator in a round is measured
+	tracker.startRound(start)
+	tracker.observe(addr, start.Add(100*time.Millisecond))
+	tracker.observe(addr, start.Add(500*time.Millisecond))
+	list := tracker.list()
+	if len(list) != 1 || list[0].Latency != 100 || list[0].Samples != 1 {
+		t.Fatalf("latency mismatch: have %+v, want 100ms out of 1 sample", list[0])
+	}
+
+	// The latency is a moving average of the rounds
+	start = start.Add(time.Second)
+	tracker.startRound(start)
+	tracker.observe(addr, start.Add(200*time.Millisecond))
+	list = tracker.list()
+	if want := uint64(100 + latencySmoothing*100); list[0].Latency != want || list[0].Samples != 2 {
+		t.Fatalf("latency mismatch: have %+v, want %dms out of 2 samples", list[0], want)
+	}
+	if list[0].LastSeen != uint64(start.Unix()) {
+		t.Fatalf("last seen mismatch: have %d, want %d", list[0].LastSeen, start.Unix())
+	}
+}
+
+func TestQuorumLatency(t *testing.T) {
+	var (
+		tracker    = newLatencyTracker()
+		start      = time.Unix(1000, 0)
+		validators = generateValidators(4)
+	)
+	tracker.startRound(start)
+	for i, addr := range validators[:3] {
+		tracker.observe(addr, start.Add(time.Duration(300-100*i)*time.Millisecond))
+	}
+	// The quorum answers with the latency of its slowest member
+	if latency, ok := tracker.quorumLatency(validators, 2); !ok || latency != 200*time.Millisecond {
+		t.Fatalf("quorum latency mismatch: have %v (%v), want 200ms", latency, ok)
+	}
+	// Only the given validators are considered
+	if latency, ok := tracker.quorumLatency(validators[:1], 1); !ok || latency != 300*time.Millisecond {
+		t.Fatalf("quorum latency mismatch: have %v (%v), want 300ms", latency, ok)
+	}
+	// A quorum of unobserved validators has no latency
+	if _, ok := tracker.quorumLatency(validators, 4); ok {
+		t.Fatal("quorum latency of unobserved validators")
+	}
+	if _, ok := tracker.quorumLatency(validators, 0); ok {
+		t.Fatal("latency of an empty quorum")
+	}
+}
+
+func TestAdaptiveRequestTimeout(t *testing.T) {
+	var (
+		valSet = newTestValidatorSet(4)
+		start  = time.Unix(1000, 0)
+	)
+	config := *istanbul.DefaultConfig
+	config.RequestTimeout = 10000
+	config.MinRequestTimeout = 2000
+	config.MaxRequestTimeout = 30000
+	c := &core{config: &config, valSet: valSet, latencies: newLatencyTracker()}
+
+	observe := func(latency time.Duration) {
+		start = start.Add(time.Minute)
+		c.latencies = newLatencyTracker()
+		c.latencies.startRound(start)
+		for _, v := range valSet.List() {
+			c.latencies.observe(v.Address(), start.Add(latency))
+		}
+	}
+
+	tests := []struct {
+		adaptive bool
+		latency  time.Duration
+		want     time.Duration
+	}{
+		// The configured timeout applies unless adaptive
+		{false, 3 * time.Second, 10 * time.Second},
+		// The timeout follows the latency of the quorum
+		{true, 3 * time.Second, 3 * time.Second * latencyTimeoutFactor},
+		// Within the configured bounds
+		{true, 100 * time.Millisecond, 2 * time.Second},
+		{true, 20 * time.Second, 30 * time.Second},
+	}
+	for i, test := range tests {
+		config.AdaptiveTimeout = test.adaptive
+		observe(test.latency)
+		if have := c.requestTimeout(); have != test.want {
+			t.Errorf("test %d: timeout mismatch: have %v, want %v", i, have, test.want)
+		}
+	}
+
+	// The configured timeout applies until a quorum is observed
+	config.AdaptiveTimeout = true
+	c.latencies = newLatencyTracker()
+	if have, want := c.requestTimeout(), 10*time.Second; have != want {
+		t.Errorf("timeout mismatch: have %v, want %v", have, want)
+	}
+
+	// The timeout of the sequence is the one set at its start
+	c.latencies.setTimeout(5 * time.Second)
+	if have, want := c.RequestTimeout(), 5*time.Second; have != want {
+		t.Errorf("sequence timeout mismatch: have %v, want %v", have, want)
+	}
+}
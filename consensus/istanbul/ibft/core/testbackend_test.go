@@ -134,6 +134,14 @@ func (self *testSystemBackend) CheckSignature([]byte, common.Address, []byte) er
 	return nil
 }
 
+func (self *testSystemBackend) SignBLS([]byte) []byte {
+	return nil
+}
+
+func (self *testSystemBackend) BLSPublicKey(common.Address) []byte {
+	return nil
+}
+
 func (self *testSystemBackend) CheckValidatorSignature(data []byte, sig []byte) (common.Address, error) {
 	return common.BytesToAddress(sig), nil
 }
@@ -165,6 +173,11 @@ func (self *testSystemBackend) HasPropsal(hash common.Hash, number *big.Int) boo
 	return number.Cmp(big.NewInt(5)) == 0
 }
 
+func (self *testSystemBackend) CurrentNumber() uint64 {
+	proposal, _ := self.LastProposal()
+	return proposal.Number().Uint64()
+}
+
 func (self *testSystemBackend) GetProposer(number uint64) common.Address {
 	return common.Address{}
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 	"golang.org/x/crypto/sha3"
@@ -31,6 +32,10 @@ var (
 	nonceDropVote = hexutil.MustDecode("0x0000000000000000") // Magic nonce number to vote on removing a validator.
 )
 
+// NormalBlockReward is the coefficient added to the rewarded validators of a
+// normal block
+const NormalBlockReward = 20
+
 type SignerFn func(data []byte) ([]byte, error)
 
 type Engine struct {
@@ -70,8 +75,25 @@ func (e *Engine) Author(header *types.Header) (common.Address, error) {
 }
 
 func (e *Engine) CommitHeader(header *types.Header, seals [][]byte, round *big.Int) error {
+	// Aggregate the bls seals after the fork if every committer has signed one
+	if e.cfg.IsBLS(header.Number) {
+		aggregated, err := writeAggregatedCommittedSeals(header, seals)
+		if err != nil {
+			return err
+		}
+		if aggregated {
+			return nil
+		}
+	}
 	// Append seals into extra-data
-	return writeCommittedSeals(header, seals)
+	legacySeals := make([][]byte, len(seals))
+	for i, seal := range seals {
+		if len(seal) > types.IstanbulExtraSeal {
+			seal = seal[:types.IstanbulExtraSeal]
+		}
+		legacySeals[i] = seal
+	}
+	return writeCommittedSeals(header, legacySeals)
 }
 
 func (e *Engine) VerifyBlockProposal(chain consensus.ChainHeaderReader, block *types.Block, validators istanbul.ValidatorSet) (time.Duration, error) {
@@ -127,9 +149,30 @@ func (e *Engine) verifyHeader(chain consensus.ChainHeaderReader, header *types.H
 		return consensus.ErrFutureBlock
 	}
 
-	if _, err := types.ExtractIstanbulExtra(header); err != nil {
+	var istanbulExtra *types.IstanbulExtra
+	var err error
+	if e.cfg.IsExtraVersion(header.Number) && header.Number.Sign() > 0 {
+		istanbulExtra, err = types.ExtractVersionedIstanbulExtra(header)
+		if err == types.ErrUnknownIstanbulExtraVersion {
+			return err
+		}
+	} else {
+		istanbulExtra, err = types.ExtractIstanbulExtra(header)
+	}
+	if err != nil {
 		return istanbulcommon.ErrInvalidExtraDataFormat
 	}
+	if e.cfg.IsExtraVersion(header.Number) && header.Number.Sign() > 0 {
+		// The lists bounded by the validator pool of the parent are checked
+		// on import, the pool is not known for the headers of a batch
+		empty := header.Coinbase == (common.Address{})
+		if err := istanbulExtra.CheckLimits(types.IstanbulExtraLimits{Committee: types.IstanbulCommitteeSize}, empty); err != nil {
+			return err
+		}
+	}
+	if err := VerifyEvidence(e.cfg, header, istanbulExtra.Evidence); err != nil {
+		return err
+	}
 
 	if header.Nonce != (istanbulcommon.EmptyBlockNonce) && !bytes.Equal(header.Nonce[:], nonceAuthVote) && !bytes.Equal(header.Nonce[:], nonceDropVote) {
 		return istanbulcommon.ErrInvalidNonce
@@ -147,7 +190,7 @@ func (e *Engine) verifyHeader(chain consensus.ChainHeaderReader, header *types.H
 
 	// Ensure that the block's difficulty is meaningful (may not be correct at this point)
 	if header.Coinbase == common.HexToAddress("0x0000000000000000000000000000000000000000") && header.Number.Cmp(common.Big0) > 0 {
-		if header.Difficulty == nil || header.Difficulty.Cmp(big.NewInt(24)) != 0 {
+		if header.Difficulty == nil || header.Difficulty.Cmp(new(big.Int).SetUint64(e.cfg.EmptyBlockAt(header.Number).GetDifficulty())) != 0 {
 			return istanbulcommon.ErrInvalidDifficulty
 		}
 	} else {
@@ -164,6 +207,26 @@ func (e *Engine) verifyHeader(chain consensus.ChainHeaderReader, header *types.H
 	return e.verifyCascadingFields(chain, header, validators, parents)
 }
 
+// VerifyEvidence checks that the evidence carried by the header is allowed by
+// the evidence fork, refers to the parent height and proves an equivocation.
+func VerifyEvidence(cfg *istanbul.Config, header *types.Header, evidence []*types.EmptyBlockEvidence) error {
+	if len(evidence) == 0 {
+		return nil
+	}
+	if !cfg.IsEvidence(header.Number) {
+		return istanbulcommon.ErrUnexpectedEvidence
+	}
+	for _, ev := range evidence {
+		if ev.Height == nil || new(big.Int).Add(ev.Height, common.Big1).Cmp(header.Number) != 0 {
+			return istanbulcommon.ErrUnexpectedEvidence
+		}
+		if err := ev.Verify(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // verifyCascadingFields verifies all the header fields that are not standalone,
 // rather depend on a batch of previous headers. The caller may optionally pass
 // in a batch of parents (ascending order) to avoid looking those up from the
@@ -248,6 +311,23 @@ func (e *Engine) verifyCommittedSeals(chain consensus.ChainHeaderReader, header
 
 	if header.Coinbase == common.HexToAddress("0x0000000000000000000000000000000000000000") && header.Number.Cmp(common.Big0) > 0 {
 		return nil
+	} else if extra.CommittedAggregatedSeal != nil {
+		if !e.cfg.IsBLS(header.Number) {
+			return istanbulcommon.ErrInvalidCommittedSeals
+		}
+		validatorList, err := e.parentValidatorPool(chain, header, parents)
+		if err != nil {
+			log.Error("caver|verifyCommittedSeals|parentValidatorPool", "no", header.Number, "err", err)
+			return err
+		}
+		// The validator pool left by a parent in the same batch of blocks is
+		// only known once the parent is processed, core.BlockChain verifies
+		// the seal against the pool it computes when it inserts the block
+		if validatorList != nil {
+			if err := e.VerifyAggregatedSeals(header, validatorList); err != nil {
+				return err
+			}
+		}
 	} else {
 		// The length of Committed seals should be larger than 0
 		if len(committedSeal) == 0 {
@@ -288,6 +368,49 @@ func (e *Engine) verifyCommittedSeals(chain consensus.ChainHeaderReader, header
 	return nil
 }
 
+// validatorPoolReader is implemented by the chains storing the validator pool
+// left by each block.
+type validatorPoolReader interface {
+	ReadValidatorPool(header *types.Header) (*types.ValidatorList, error)
+}
+
+// parentValidatorPool returns the validator pool left by the parent of header,
+// which the aggregated seals of header are verified against. The pool is read
+// from the checkpoint carried by the parent, or from the chain if the parent
+// is imported already. It returns nil without error if the parent carries no
+// checkpoint and is part of the batch of blocks being inserted into a
+// core.BlockChain, an error if the pool is unknown otherwise.
+func (e *Engine) parentValidatorPool(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header) (*types.ValidatorList, error) {
+	number := header.Number.Uint64()
+	var parent *types.Header
+	if len(parents) > 0 {
+		parent = parents[len(parents)-1]
+	} else {
+		parent = chain.GetHeader(header.ParentHash, number-1)
+	}
+	if parent == nil || parent.Number.Uint64() != number-1 || parent.Hash() != header.ParentHash {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	if extra, err := types.ExtractIstanbulExtra(parent); err == nil && extra.Checkpoint != nil && extra.Checkpoint.Hash() == extra.ValidatorsHash {
+		return extra.Checkpoint.ValidatorList(), nil
+	}
+	if len(parents) > 0 {
+		if _, ok := chain.(*core.BlockChain); ok {
+			return nil, nil
+		}
+		return nil, istanbulcommon.ErrUnknownValidatorPool
+	}
+	reader, ok := chain.(validatorPoolReader)
+	if !ok {
+		return nil, istanbulcommon.ErrUnknownValidatorPool
+	}
+	validatorList, err := reader.ReadValidatorPool(parent)
+	if err != nil {
+		return nil, istanbulcommon.ErrUnknownValidatorPool
+	}
+	return validatorList, nil
+}
+
 // VerifyUncles verifies that the given block's uncles conform to the consensus
 // rules of a given engine.
 func (e *Engine) VerifyUncles(chain consensus.ChainReader, block *types.Block) error {
@@ -333,9 +456,10 @@ func (e *Engine) Prepare(chain consensus.ChainHeaderReader, header *types.Header
 	// use the same difficulty for all blocks
 	header.Difficulty = istanbulcommon.DefaultDifficulty
 	var (
-		validatorAddr []common.Address
-		exchangerAddr []common.Address
-		rewardSeals   [][]byte
+		validatorAddr        []common.Address
+		exchangerAddr        []common.Address
+		rewardSeals          [][]byte
+		rewardAggregatedSeal *types.AggregatedSeal
 	)
 	if c, ok := chain.(*core.BlockChain); ok {
 		if header.Number.Uint64() == 1 {
@@ -353,7 +477,7 @@ func (e *Engine) Prepare(chain consensus.ChainHeaderReader, header *types.Header
 				log.Error("Prepare : invalid validators", err.Error())
 				return errors.New("Prepare: invalid validators")
 			}
-			quorumSize := e.QuorumSize(random11Validators.Len())
+			quorumSize := QuorumSize(random11Validators.Len())
 			if quorumSize == 0 {
 				log.Error("Prepare invalid quorum size", "no", header.Number, "size", quorumSize)
 				return errors.New("invalid quorum size")
@@ -394,6 +518,11 @@ func (e *Engine) Prepare(chain consensus.ChainHeaderReader, header *types.Header
 				log.Error("copy commitSeals err", "err", err, "preHeader", preHeader.Number, "preHash", preHeader.Hash().Hex(), "no", header.Number, "hash", header.Hash().Hex())
 				return err
 			}
+			preExtra, err := types.ExtractIstanbulExtra(preHeader)
+			if err != nil {
+				return err
+			}
+			rewardAggregatedSeal = preExtra.CommittedAggregatedSeal.Copy()
 		}
 
 		// reward to openExchangers
@@ -452,6 +581,19 @@ func (e *Engine) Prepare(chain consensus.ChainHeaderReader, header *types.Header
 		return err
 	}
 	header.Extra = extra
+	if e.cfg.IsExtraVersion(header.Number) {
+		if err := types.SetIstanbulExtraVersion(header, types.IstanbulExtraVersion1); err != nil {
+			return err
+		}
+	}
+	if rewardAggregatedSeal != nil {
+		err = updateExtra(header, func(ist *types.IstanbulExtra) {
+			ist.RewardAggregatedSeal = rewardAggregatedSeal
+		})
+		if err != nil {
+			return err
+		}
+	}
 
 	// set header's timestamp
 	now := uint64(time.Now().Unix())
@@ -508,7 +650,16 @@ func (e *Engine) PrepareEmpty(chain consensus.ChainHeaderReader, header *types.H
 	if parent == nil {
 		return consensus.ErrUnknownAncestor
 	}
-	header.Difficulty = big.NewInt(24)
+	header.Difficulty = new(big.Int).SetUint64(e.cfg.EmptyBlockAt(header.Number).GetDifficulty())
+
+	// After the bls fork the votes are aggregated, only the message of the
+	// proposer is kept
+	var emptyBlockAggregatedSeal *types.AggregatedSeal
+	if c, ok := chain.(*core.BlockChain); ok && e.cfg.IsBLS(header.Number) {
+		if validatorList, err := c.ReadValidatorPool(parent); err == nil {
+			emptyBlockMessages, emptyBlockAggregatedSeal = aggregateEmptyBlockMessages(header.Number, emptyBlockMessages, validatorList)
+		}
+	}
 
 	// add validators in snapshot to extraData's validators section
 	extra, err := prepareExtra(header, validator.GetAllVotes(validators.List()), nil, nil, nil, emptyBlockMessages)
@@ -516,6 +667,19 @@ func (e *Engine) PrepareEmpty(chain consensus.ChainHeaderReader, header *types.H
 		return err
 	}
 	header.Extra = extra
+	if e.cfg.IsExtraVersion(header.Number) {
+		if err := types.SetIstanbulExtraVersion(header, types.IstanbulExtraVersion1); err != nil {
+			return err
+		}
+	}
+	if emptyBlockAggregatedSeal != nil {
+		err = updateExtra(header, func(ist *types.IstanbulExtra) {
+			ist.EmptyBlockAggregatedSeal = emptyBlockAggregatedSeal
+		})
+		if err != nil {
+			return err
+		}
+	}
 
 	// set header's timestamp
 	header.Time = uint64(time.Now().Unix())
@@ -573,54 +737,44 @@ func (e *Engine) Finalize(chain consensus.ChainHeaderReader, header *types.Heade
 		if err != nil {
 			return
 		}
+		// The voters and the offenders are resolved against the validator
+		// pool of the parent, as in Participation
+		pool, err := c.ReadValidatorPool(parent.Header())
+		if err != nil {
+			log.Error("Finalize : invalid validator pool", "no", header.Number, "err", err)
+			return
+		}
 
 		if header.Coinbase == (common.Address{}) {
 			// reduce 1 weight
 			for _, v := range random11Validators.Validators {
-				state.SubValidatorCoefficient(v.Address(), 20)
+				state.SubValidatorCoefficient(v.Address(), uint8(e.cfg.EmptyBlockAt(header.Number).GetPenalty()))
 			}
 
-			voteAddrs := make([]common.Address, 0)
-			emptyMsg := new(types.EmptyMsg)
-			for _, emptyMessage := range istanbulExtra.EmptyBlockMessages {
-				if err := emptyMsg.FromPayload(emptyMessage); err != nil {
-					log.Error("Certify Failed to decode message from payload", "err", err)
-					continue
-				}
-				//var signature *types.SignatureData
-				//err = emptyMsg.Decode(&signature)
-				//if err !=nil {
-				//	log.Info("recoverMsg", "err", err)
-				//	continue
-				//}
-				sender, err := emptyMsg.RecoverAddress(emptyMessage)
-				//log.Info("recoverAddress", "msg", signature.Vote, "height", signature.Height, "sender", sender)
-				if err != nil {
-					log.Info("recover emptyMessage", "err", err)
-					continue
-				}
-
-				for _, val := range state.ValidatorPool {
-					if val.Addr == sender || val.Proxy == sender {
-						voteAddrs = append(voteAddrs, val.Addr)
-						break
-					}
-				}
+			voteAddrs, err := EmptyBlockVoters(istanbulExtra, pool.Validators)
+			if err != nil {
+				log.Error("Finalize empty block aggregated seal", "err", err, "no", header.Number)
+				return
+			}
+			if len(voteAddrs) == 0 {
+				log.Error("Finalize empty block without votes", "no", header.Number)
+				return
 			}
 
 			for _, vote := range voteAddrs[1:] {
 				log.Info("AddValidatorCoefficient", "addr", vote)
-				state.AddValidatorCoefficient(vote, 70)
+				state.AddValidatorCoefficient(vote, uint8(e.cfg.EmptyBlockAt(header.Number).GetVoteReward()))
 			}
 		} else {
 			// add 2 weight
 			for _, v := range istanbulExtra.ValidatorAddr {
-				state.AddValidatorCoefficient(v, 20)
+				state.AddValidatorCoefficient(v, NormalBlockReward)
 			}
 		}
+		PenalizeEquivocations(e.cfg, header, istanbulExtra, pool.Validators, state)
 
 		if header.Coinbase == (common.Address{}) {
-			state.CreateNFTByOfficial16(istanbulExtra.ValidatorAddr, istanbulExtra.ExchangerAddr, header.Number)
+			state.CreateNFTByOfficial16(istanbulExtra.ValidatorAddr, istanbulExtra.ExchangerAddr, header.Number, chain.Config().IsOfficialNFTProposal(header.Number))
 
 			/// No block rewards in Istanbul, so the state remains as is and uncles are dropped
 			header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
@@ -633,7 +787,7 @@ func (e *Engine) Finalize(chain consensus.ChainHeaderReader, header *types.Heade
 				validatorAddr = make([]common.Address, 0)
 			} else {
 				// quorum Size
-				quorumSize := e.QuorumSize(random11Validators.Len())
+				quorumSize := QuorumSize(random11Validators.Len())
 				if quorumSize == 0 {
 					log.Error("Finalize invalid quorum size", "no", header.Number, "size", quorumSize)
 					return
@@ -648,7 +802,12 @@ func (e *Engine) Finalize(chain consensus.ChainHeaderReader, header *types.Heade
 				log.Info("Finalize getPreHash ok", "preHeader", preHeader.Number, "preHash", preHeader.Hash().Hex(), "no", header.Number, "hash", header.Hash().Hex())
 				// decode rewards
 				// preHeader + currentRewadSeal
-				rewarders, err := e.RecoverRewards(preHeader, istanbulExtra.RewardSeal)
+				var rewarders []common.Address
+				if istanbulExtra.RewardAggregatedSeal != nil {
+					rewarders, err = e.RecoverAggregatedRewards(preHeader, istanbulExtra.RewardAggregatedSeal)
+				} else {
+					rewarders, err = e.RecoverRewards(preHeader, istanbulExtra.RewardSeal)
+				}
 				if err != nil {
 					log.Error("Finalize rewarders err", "err", err.Error(), "preHeader", preHeader.Number, "preHash", preHeader.Hash().Hex(), "no", header.Number, "hash", header.Hash().Hex())
 					return
@@ -696,7 +855,7 @@ func (e *Engine) Finalize(chain consensus.ChainHeaderReader, header *types.Heade
 				log.Info("Finalize : CreateNFTByOfficial16", "ExchangerAddr=", addr.Hex(), "Coinbase", header.Coinbase.Hex(), "no", header.Number.Uint64())
 			}
 
-			state.CreateNFTByOfficial16(validatorAddr, istanbulExtra.ExchangerAddr, header.Number)
+			state.CreateNFTByOfficial16(validatorAddr, istanbulExtra.ExchangerAddr, header.Number, chain.Config().IsOfficialNFTProposal(header.Number))
 
 			/// No block rewards in Istanbul, so the state remains as is and uncles are dropped
 			header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
@@ -726,23 +885,29 @@ func (e *Engine) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *
 			log.Error("FinalizeAndAssemble : invalid validators", err.Error())
 			return nil, err
 		}
+		pool, err := c.ReadValidatorPool(parent.Header())
+		if err != nil {
+			log.Error("FinalizeAndAssemble : invalid validator pool", "no", header.Number, "err", err)
+			return nil, err
+		}
 		if header.Coinbase == (common.Address{}) {
 			// reduce 1 weight
 			for _, v := range random11Validators.Validators {
-				state.SubValidatorCoefficient(v.Address(), 20)
+				state.SubValidatorCoefficient(v.Address(), uint8(e.cfg.EmptyBlockAt(header.Number).GetPenalty()))
 			}
 
 			for _, v := range istanbulExtra.Validators[1:] {
 				log.Info("AddValidatorCoefficient", "addr", v)
-				state.AddValidatorCoefficient(v, 70)
+				state.AddValidatorCoefficient(v, uint8(e.cfg.EmptyBlockAt(header.Number).GetVoteReward()))
 			}
 
 		} else {
 			// add 2 weight
 			for _, v := range istanbulExtra.ValidatorAddr {
-				state.AddValidatorCoefficient(v, 20)
+				state.AddValidatorCoefficient(v, NormalBlockReward)
 			}
 		}
+		PenalizeEquivocations(e.cfg, header, istanbulExtra, pool.Validators, state)
 	}
 	for _, addr := range istanbulExtra.ValidatorAddr {
 		log.Info("FinalizeAndAssemble : CreateNFTByOfficial16", "ValidatorAddr=", addr.Hex(), "Coinbase=", header.Coinbase.Hex(), "no", header.Number.Uint64())
@@ -751,7 +916,7 @@ func (e *Engine) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *
 	for _, addr := range istanbulExtra.ExchangerAddr {
 		log.Info("FinalizeAndAssemble : CreateNFTByOfficial16", "ExchangerAddr=", addr.Hex(), "Coinbase=", header.Coinbase.Hex(), "no", header.Number.Uint64())
 	}
-	state.CreateNFTByOfficial16(istanbulExtra.ValidatorAddr, istanbulExtra.ExchangerAddr, header.Number)
+	state.CreateNFTByOfficial16(istanbulExtra.ValidatorAddr, istanbulExtra.ExchangerAddr, header.Number, chain.Config().IsOfficialNFTProposal(header.Number))
 
 	/// No block rewards in Istanbul, so the state remains as is and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
@@ -852,6 +1017,9 @@ func (e *Engine) Signers(header *types.Header) ([]common.Address, error) {
 	if err != nil {
 		return []common.Address{}, err
 	}
+	if extra.CommittedAggregatedSeal != nil {
+		return extra.CommittedAggregatedSeal.Signers(extra.Validators)
+	}
 	committedSeal := extra.CommittedSeal
 	proposalSeal := PrepareCommittedSeal(header.Hash())
 
@@ -890,6 +1058,65 @@ func (e *Engine) RecoverRewards(header *types.Header, rewardSeal [][]byte) ([]co
 	return addrs, nil
 }
 
+// RewardSigners returns the committers of preHeader, the last normal block,
+// proven by the reward seals of header
+func (e *Engine) RewardSigners(preHeader, header *types.Header) ([]common.Address, error) {
+	istanbulExtra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	if istanbulExtra.RewardAggregatedSeal != nil {
+		return e.RecoverAggregatedRewards(preHeader, istanbulExtra.RewardAggregatedSeal)
+	}
+	return e.RecoverRewards(preHeader, istanbulExtra.RewardSeal)
+}
+
+// RecoverAggregatedRewards returns the committers of header out of the reward
+// seal, which has to be the aggregated committed seal of header.
+func (e *Engine) RecoverAggregatedRewards(header *types.Header, rewardSeal *types.AggregatedSeal) ([]common.Address, error) {
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	committedSeal := extra.CommittedAggregatedSeal
+	if committedSeal == nil ||
+		!bytes.Equal(committedSeal.Signature, rewardSeal.Signature) ||
+		!bytes.Equal(committedSeal.Bitmap, rewardSeal.Bitmap) {
+		return nil, istanbulcommon.ErrInvalidSignature
+	}
+	return rewardSeal.Signers(extra.Validators)
+}
+
+// VerifyAggregatedSeals checks the aggregated committed seal of header against
+// the bls keys in validatorList, the validator list of its parent.
+func (e *Engine) VerifyAggregatedSeals(header *types.Header, validatorList *types.ValidatorList) error {
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return err
+	}
+	if extra.CommittedAggregatedSeal == nil {
+		return nil
+	}
+	committers, err := extra.CommittedAggregatedSeal.Signers(extra.Validators)
+	if err != nil {
+		return err
+	}
+	pubKeys := make([][]byte, 0, len(committers))
+	for _, addr := range committers {
+		pubKey := validatorList.BLSPubKey(addr)
+		if pubKey == nil {
+			log.Error("caver|VerifyAggregatedSeals|no bls key", "no", header.Number, "addr", addr)
+			return istanbulcommon.ErrInvalidCommittedSeals
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	if !bls.VerifyAggregate(pubKeys, PrepareCommittedSeal(header.Hash()), extra.CommittedAggregatedSeal.Signature) {
+		log.Error("caver|VerifyAggregatedSeals|invalid signature", "no", header.Number, "hash", header.Hash())
+		return istanbulcommon.ErrInvalidCommittedSeals
+	}
+	return nil
+}
+
 func (e *Engine) Address() common.Address {
 	return e.signer
 }
@@ -961,6 +1188,300 @@ func writeCommittedSeals(h *types.Header, committedSeals [][]byte) error {
 	return nil
 }
 
+// writeAggregatedCommittedSeals aggregates the bls parts of the committed seals
+// into the extra-data, the signers are located in the validators of the header
+// by the ecdsa parts. It returns false without touching the header if any of
+// the seals has no bls part.
+func writeAggregatedCommittedSeals(h *types.Header, committedSeals [][]byte) (bool, error) {
+	if len(committedSeals) == 0 {
+		return false, istanbulcommon.ErrInvalidCommittedSeals
+	}
+	istanbulExtra, err := types.ExtractIstanbulExtra(h)
+	if err != nil {
+		return false, err
+	}
+
+	proposalSeal := PrepareCommittedSeal(h.Hash())
+	signed := make(map[int]bool)
+	indices := make([]int, 0, len(committedSeals))
+	blsSeals := make([][]byte, 0, len(committedSeals))
+	for _, seal := range committedSeals {
+		if len(seal) != types.IstanbulExtraSeal+bls.SignatureLength {
+			return false, nil
+		}
+		addr, err := istanbulcommon.GetSignatureAddress(proposalSeal, seal[:types.IstanbulExtraSeal])
+		if err != nil {
+			return false, nil
+		}
+		index := -1
+		for i, v := range istanbulExtra.Validators {
+			if v == addr {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return false, nil
+		}
+		if signed[index] {
+			continue
+		}
+		signed[index] = true
+		indices = append(indices, index)
+		blsSeals = append(blsSeals, seal[types.IstanbulExtraSeal:])
+	}
+	signature, err := bls.AggregateSignatures(blsSeals)
+	if err != nil {
+		return false, nil
+	}
+
+	istanbulExtra.CommittedSeal = [][]byte{}
+	istanbulExtra.CommittedAggregatedSeal = types.NewAggregatedSeal(signature, indices)
+	payload, err := rlp.EncodeToBytes(&istanbulExtra)
+	if err != nil {
+		return false, err
+	}
+
+	h.Extra = append(h.Extra[:types.IstanbulExtraVanity], payload...)
+	return true, nil
+}
+
+// aggregateEmptyBlockMessages aggregates the bls signatures of the votes for
+// the empty block at number, the bitmap of the seal refers to the validators
+// of validatorList. The first message, the one of the proposer, is kept. The
+// messages are returned unchanged if any of the votes has no valid bls signature.
+func aggregateEmptyBlockMessages(number *big.Int, emptyBlockMessages [][]byte, validatorList *types.ValidatorList) ([][]byte, *types.AggregatedSeal) {
+	if len(emptyBlockMessages) < 2 {
+		return emptyBlockMessages, nil
+	}
+	voteHash := types.EmptyBlockVoteHash(number)
+	signed := make(map[int]bool)
+	indices := make([]int, 0, len(emptyBlockMessages)-1)
+	blsSignatures := make([][]byte, 0, len(emptyBlockMessages)-1)
+	for _, emptyBlockMessage := range emptyBlockMessages[1:] {
+		msg := new(types.EmptyMsg)
+		sender, err := msg.RecoverAddress(emptyBlockMessage)
+		if err != nil {
+			return emptyBlockMessages, nil
+		}
+		index := validatorList.GetByAddress(sender)
+		if index < 0 {
+			return emptyBlockMessages, nil
+		}
+		pubKey := validatorList.Validators[index].BLSPubKey
+		if pubKey == nil || !bls.Verify(pubKey, voteHash, msg.BLSSignature) {
+			return emptyBlockMessages, nil
+		}
+		if signed[index] {
+			continue
+		}
+		signed[index] = true
+		indices = append(indices, index)
+		blsSignatures = append(blsSignatures, msg.BLSSignature)
+	}
+	signature, err := bls.AggregateSignatures(blsSignatures)
+	if err != nil {
+		return emptyBlockMessages, nil
+	}
+	return emptyBlockMessages[:1], types.NewAggregatedSeal(signature, indices)
+}
+
+// PenalizeEquivocations subtracts the equivocation penalty from the offenders
+// of the evidence in the block, validators is the validator pool of the parent.
+func PenalizeEquivocations(cfg *istanbul.Config, header *types.Header, istanbulExtra *types.IstanbulExtra, validators []*types.Validator, state *state.StateDB) {
+	for _, addr := range Equivocators(cfg, header, istanbulExtra, validators) {
+		log.Info("SubValidatorCoefficient equivocation", "addr", addr, "no", header.Number)
+		state.SubValidatorCoefficient(addr, uint8(cfg.EmptyBlockAt(header.Number).GetEquivocationPenalty()))
+	}
+}
+
+// Equivocators returns the validators proven to equivocate by the evidence in
+// the block. The evidence is only valid for the votes of the empty block at
+// the parent height, so an equivocation is penalized once.
+func Equivocators(cfg *istanbul.Config, header *types.Header, istanbulExtra *types.IstanbulExtra, validators []*types.Validator) []common.Address {
+	if len(istanbulExtra.Evidence) == 0 || !cfg.IsEvidence(header.Number) {
+		return nil
+	}
+	height := new(big.Int).Sub(header.Number, common.Big1)
+	var offenders []common.Address
+	penalized := make(map[common.Address]bool)
+	for _, evidence := range istanbulExtra.Evidence {
+		if evidence.Height == nil || evidence.Height.Cmp(height) != 0 {
+			continue
+		}
+		if err := evidence.Verify(); err != nil {
+			log.Error("equivocators: invalid evidence", "err", err, "no", header.Number)
+			continue
+		}
+		for _, val := range validators {
+			if val.Addr == evidence.Offender || val.Proxy == evidence.Offender {
+				if !penalized[val.Addr] {
+					penalized[val.Addr] = true
+					offenders = append(offenders, val.Addr)
+				}
+				break
+			}
+		}
+	}
+	return offenders
+}
+
+// EmptyBlockVoters returns the validators that voted for the empty block, the
+// first one is the proposer of the block.
+func EmptyBlockVoters(istanbulExtra *types.IstanbulExtra, validators []*types.Validator) ([]common.Address, error) {
+	voteAddrs := make([]common.Address, 0)
+	emptyMsg := new(types.EmptyMsg)
+	for _, emptyMessage := range istanbulExtra.EmptyBlockMessages {
+		if err := emptyMsg.FromPayload(emptyMessage); err != nil {
+			log.Error("Certify Failed to decode message from payload", "err", err)
+			continue
+		}
+		sender, err := emptyMsg.RecoverAddress(emptyMessage)
+		if err != nil {
+			log.Info("recover emptyMessage", "err", err)
+			continue
+		}
+
+		for _, val := range validators {
+			if val.Addr == sender || val.Proxy == sender {
+				voteAddrs = append(voteAddrs, val.Addr)
+				break
+			}
+		}
+	}
+	if istanbulExtra.EmptyBlockAggregatedSeal != nil {
+		indices, err := istanbulExtra.EmptyBlockAggregatedSeal.Indices(len(validators))
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range indices {
+			voteAddrs = append(voteAddrs, validators[i].Addr)
+		}
+	}
+	return voteAddrs, nil
+}
+
+// Participation implements consensus.ParticipationReader, attributing the
+// proposal, the seals and the empty block votes of the block to the validators
+// along with the coefficient adjustments of Finalize.
+func (e *Engine) Participation(chain consensus.ChainHeaderReader, header *types.Header) ([]*types.ValidatorStats, error) {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil, nil
+	}
+	c, ok := chain.(*core.BlockChain)
+	if !ok {
+		return nil, errors.New("Participation: unsupported chain")
+	}
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	pool, err := c.ReadValidatorPool(parent)
+	if err != nil {
+		return nil, err
+	}
+	istanbulExtra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	// Seals are signed by the proxies of the validators
+	validatorAddr := func(addr common.Address) common.Address {
+		if v := pool.GetValidatorAddr(addr); v != (common.Address{}) {
+			return v
+		}
+		return addr
+	}
+
+	stats := make(types.ValidatorStatsSet)
+	emptyBlock := e.cfg.EmptyBlockAt(header.Number)
+	if header.Coinbase == (common.Address{}) {
+		random11Validators, err := c.Random11ValidatorWithOutProxy(parent)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range random11Validators.Validators {
+			stats.Get(v.Address()).CoefficientSubtracted += emptyBlock.GetPenalty()
+		}
+		voteAddrs, err := EmptyBlockVoters(istanbulExtra, pool.Validators)
+		if err != nil {
+			return nil, err
+		}
+		if len(voteAddrs) > 0 {
+			stats.Get(voteAddrs[0]).Proposed++
+			for _, vote := range voteAddrs[1:] {
+				s := stats.Get(vote)
+				s.EmptyBlockVotes++
+				s.CoefficientAdded += emptyBlock.GetVoteReward()
+			}
+		}
+	} else {
+		proposer, err := e.Author(header)
+		if err != nil {
+			return nil, err
+		}
+		stats.Get(validatorAddr(proposer)).Proposed++
+
+		committers, err := e.Signers(header)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range committers {
+			stats.Get(validatorAddr(addr)).CommittedSeals++
+		}
+		if number > 1 {
+			if preHeader, err := getPreHash(chain, header); err == nil {
+				rewarders, err := e.RewardSigners(preHeader, header)
+				if err != nil {
+					return nil, err
+				}
+				for _, addr := range rewarders {
+					stats.Get(validatorAddr(addr)).RewardSeals++
+				}
+			}
+		}
+		for _, v := range istanbulExtra.ValidatorAddr {
+			stats.Get(v).CoefficientAdded += NormalBlockReward
+		}
+	}
+	for _, addr := range Equivocators(e.cfg, header, istanbulExtra, pool.Validators) {
+		stats.Get(addr).CoefficientSubtracted += emptyBlock.GetEquivocationPenalty()
+	}
+	return stats.List(), nil
+}
+
+// WriteEvidence writes the evidence of equivocating empty block voters into
+// the extra-data of the header
+func (e *Engine) WriteEvidence(header *types.Header, evidence []*types.EmptyBlockEvidence) error {
+	return updateExtra(header, func(istanbulExtra *types.IstanbulExtra) {
+		istanbulExtra.Evidence = evidence
+	})
+}
+
+// WriteValidatorCommitment writes the hash of the validator checkpoint left by
+// the block, and the checkpoint itself if given, into the extra-data of the header
+func (e *Engine) WriteValidatorCommitment(header *types.Header, hash common.Hash, checkpoint *types.ValidatorCheckpoint) error {
+	return updateExtra(header, func(istanbulExtra *types.IstanbulExtra) {
+		istanbulExtra.ValidatorsHash = hash
+		istanbulExtra.Checkpoint = checkpoint
+	})
+}
+
+// updateExtra applies update to the istanbul extra-data of h
+func updateExtra(h *types.Header, update func(*types.IstanbulExtra)) error {
+	istanbulExtra, err := types.ExtractIstanbulExtra(h)
+	if err != nil {
+		return err
+	}
+	update(istanbulExtra)
+	payload, err := rlp.EncodeToBytes(&istanbulExtra)
+	if err != nil {
+		return err
+	}
+	h.Extra = append(h.Extra[:types.IstanbulExtraVanity], payload...)
+	return nil
+}
+
 // PrepareCommittedSeal returns a committed seal for the given hash
 func PrepareCommittedSeal(hash common.Hash) []byte {
 	var buf bytes.Buffer
@@ -978,6 +1499,8 @@ func BytesToInt(bys []byte) int {
 	return int(data)
 }
 
-func (e *Engine) QuorumSize(valSize int) int {
+// QuorumSize returns the number of committers of a committee of valSize
+// validators rewarded in the next normal block
+func QuorumSize(valSize int) int {
 	return 2*(int(math.Ceil(float64(valSize)/3))-1) + 1
 }
@@ -2,14 +2,23 @@ package ibftengine
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
 	"reflect"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -34,7 +43,7 @@ func TestPrepareExtra(t *testing.T) {
 		Extra: vanity,
 	}
 
-	payload, err := prepareExtra(h, validators, validators, validators)
+	payload, err := prepareExtra(h, validators, validators, validators, nil, nil)
 	if err != nil {
 		t.Errorf("error mismatch: have %v, want: nil", err)
 	}
@@ -45,7 +54,7 @@ func TestPrepareExtra(t *testing.T) {
 	// append useless information to extra-data
 	h.Extra = append(vanity, make([]byte, 15)...)
 
-	payload, _ = prepareExtra(h, validators, validators, validators)
+	payload, _ = prepareExtra(h, validators, validators, validators, nil, nil)
 	if !reflect.DeepEqual(payload, expectedResult) {
 		t.Errorf("payload mismatch: have %v, want %v", payload, expectedResult)
 	}
@@ -140,3 +149,160 @@ func TestWriteCommittedSeals(t *testing.T) {
 		t.Errorf("error mismatch: have %v, want %v", err, istanbulcommon.ErrInvalidCommittedSeals)
 	}
 }
+
+func signVote(t *testing.T, key *ecdsa.PrivateKey, data *types.SignatureData) []byte {
+	enc, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &types.EmptyMsg{Code: 1, Msg: enc, Address: crypto.PubkeyToAddress(key.PublicKey)}
+	unsigned, err := msg.PayloadNoSig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Signature, err = crypto.Sign(crypto.Keccak256(unsigned), key); err != nil {
+		t.Fatal(err)
+	}
+	payload, err := msg.Payload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
+
+func newEvidence(t *testing.T, key *ecdsa.PrivateKey, height *big.Int) *types.EmptyBlockEvidence {
+	first := signVote(t, key, &types.SignatureData{Vote: common.HexToAddress("0x01"), Height: height, Round: 1})
+	second := signVote(t, key, &types.SignatureData{Vote: common.HexToAddress("0x02"), Height: height, Round: 1})
+	evidence, err := types.NewEmptyBlockEvidence(first, second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return evidence
+}
+
+// TestPenalizeEquivocations checks that the offenders of the valid evidence
+// of the votes at the parent height are penalized once, by their validator
+// address if they vote with a proxy.
+func TestPenalizeEquivocations(t *testing.T) {
+	var (
+		keys       = make([]*ecdsa.PrivateKey, 4)
+		validators = make([]*types.Validator, 4)
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		validators[i] = &types.Validator{Addr: crypto.PubkeyToAddress(keys[i].PublicKey), Balance: big.NewInt(1e18)}
+	}
+	// The last validator votes with a proxy
+	proxyKey, _ := crypto.GenerateKey()
+	validators[3].Proxy = crypto.PubkeyToAddress(proxyKey.PublicKey)
+
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range validators {
+		statedb.AddValidatorCoefficient(v.Addr, 0)
+	}
+
+	header := &types.Header{Number: big.NewInt(10)}
+	parent := big.NewInt(9)
+	invalid := newEvidence(t, keys[2], parent)
+	invalid.Offender = validators[1].Addr
+	extra := &types.IstanbulExtra{
+		Evidence: []*types.EmptyBlockEvidence{
+			newEvidence(t, keys[0], parent),
+			newEvidence(t, keys[0], parent), // Penalized once
+			invalid,                         // Signed by another validator
+			newEvidence(t, keys[2], big.NewInt(8)),
+			newEvidence(t, proxyKey, parent),
+		},
+	}
+
+	// Before the evidence fork the evidence is ignored
+	chainConfig := &params.ChainConfig{Istanbul: &params.IstanbulConfig{EvidenceBlock: big.NewInt(11)}}
+	engine := NewEngine(&istanbul.Config{ChainConfig: chainConfig}, common.Address{}, nil, nil)
+	if offenders := Equivocators(engine.cfg, header, extra, validators); len(offenders) != 0 {
+		t.Fatalf("offenders before fork: have %v, want none", offenders)
+	}
+
+	chainConfig.Istanbul.EvidenceBlock = big.NewInt(10)
+	PenalizeEquivocations(engine.cfg, header, extra, validators, statedb)
+	penalty := uint8(params.DefaultEmptyBlockConfig.GetEquivocationPenalty())
+	for i, want := range []uint8{state.VALIDATOR_COEFFICIENT - penalty, state.VALIDATOR_COEFFICIENT, state.VALIDATOR_COEFFICIENT, state.VALIDATOR_COEFFICIENT - penalty} {
+		if have := statedb.GetValidatorCoefficient(validators[i].Addr); have != want {
+			t.Errorf("coefficient of validator %d mismatch: have %d, want %d", i, have, want)
+		}
+	}
+}
+
+type testPoolChain struct {
+	headers map[common.Hash]*types.Header
+	pools   map[common.Hash]*types.ValidatorList
+}
+
+func (c *testPoolChain) Config() *params.ChainConfig  { return params.TestChainConfig }
+func (c *testPoolChain) CurrentHeader() *types.Header { return nil }
+func (c *testPoolChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	return c.headers[hash]
+}
+func (c *testPoolChain) GetHeaderByNumber(number uint64) *types.Header { return nil }
+func (c *testPoolChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	return c.headers[hash]
+}
+func (c *testPoolChain) ReadValidatorPool(header *types.Header) (*types.ValidatorList, error) {
+	if pool, ok := c.pools[header.Hash()]; ok {
+		return pool, nil
+	}
+	return nil, errors.New("unknown pool")
+}
+
+func newPoolTestHeader(t *testing.T, number int64, parent common.Hash, extra *types.IstanbulExtra) *types.Header {
+	payload, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &types.Header{
+		Number:     big.NewInt(number),
+		ParentHash: parent,
+		Extra:      append(make([]byte, types.IstanbulExtraVanity), payload...),
+	}
+}
+
+func TestParentValidatorPool(t *testing.T) {
+	engine := NewEngine(&istanbul.Config{ChainConfig: params.TestChainConfig}, common.Address{}, nil, nil)
+	pool := &types.ValidatorList{Validators: []*types.Validator{{Addr: common.HexToAddress("0x01"), Balance: big.NewInt(1e18), BLSPubKey: []byte{0x01}}}}
+	checkpoint := types.NewValidatorCheckpoint(pool, nil)
+
+	plain := newPoolTestHeader(t, 9, common.Hash{}, &types.IstanbulExtra{})
+	checkpointed := newPoolTestHeader(t, 9, common.Hash{}, &types.IstanbulExtra{ValidatorsHash: checkpoint.Hash(), Checkpoint: checkpoint})
+	chain := &testPoolChain{
+		headers: map[common.Hash]*types.Header{plain.Hash(): plain, checkpointed.Hash(): checkpointed},
+		pools:   map[common.Hash]*types.ValidatorList{plain.Hash(): pool},
+	}
+
+	// The pool of an imported parent is read from the chain
+	header := newPoolTestHeader(t, 10, plain.Hash(), &types.IstanbulExtra{})
+	if have, err := engine.parentValidatorPool(chain, header, nil); err != nil || have != pool {
+		t.Fatalf("imported parent: have %v %v, want the stored pool", have, err)
+	}
+	// The pool of a parent in the batch is read from its checkpoint
+	header = newPoolTestHeader(t, 10, checkpointed.Hash(), &types.IstanbulExtra{})
+	have, err := engine.parentValidatorPool(chain, header, []*types.Header{checkpointed})
+	if err != nil || len(have.Validators) != 1 || !bytes.Equal(have.BLSPubKey(pool.Validators[0].Addr), []byte{0x01}) {
+		t.Fatalf("checkpointed parent: have %v %v, want the checkpoint pool", have, err)
+	}
+	// The pool is unknown otherwise
+	header = newPoolTestHeader(t, 10, plain.Hash(), &types.IstanbulExtra{})
+	if _, err := engine.parentValidatorPool(chain, header, []*types.Header{plain}); err != istanbulcommon.ErrUnknownValidatorPool {
+		t.Fatalf("parent in batch: error mismatch: have %v, want %v", err, istanbulcommon.ErrUnknownValidatorPool)
+	}
+	delete(chain.pools, plain.Hash())
+	if _, err := engine.parentValidatorPool(chain, header, nil); err != istanbulcommon.ErrUnknownValidatorPool {
+		t.Fatalf("imported parent without pool: error mismatch: have %v, want %v", err, istanbulcommon.ErrUnknownValidatorPool)
+	}
+	// The parent has to be known
+	header = newPoolTestHeader(t, 10, common.HexToHash("0xff"), &types.IstanbulExtra{})
+	if _, err := engine.parentValidatorPool(chain, header, nil); err != consensus.ErrUnknownAncestor {
+		t.Fatalf("unknown parent: error mismatch: have %v, want %v", err, consensus.ErrUnknownAncestor)
+	}
+}
@@ -292,3 +292,11 @@ func (c *core) ConsensusInfo() chan map[string]interface{} {
 func (c *core) OnlineValidators(height uint64) []common.Address {
 	return nil
 }
+
+func (c *core) RequestTimeout() time.Duration {
+	return time.Duration(c.config.RequestTimeout) * time.Millisecond
+}
+
+func (c *core) Latencies() []*istanbul.ValidatorLatency {
+	return nil
+}
@@ -2,15 +2,18 @@ package qbftengine
 
 import (
 	"bytes"
+	"errors"
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
+	ibftengine "github.com/ethereum/go-ethereum/consensus/istanbul/ibft/engine"
 	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -21,6 +24,14 @@ import (
 
 var (
 	nilUncleHash = types.CalcUncleHash(nil) // Always Keccak256(RLP([])) as uncles are meaningless outside of PoW.
+
+	// errInsufficientRewardSeals is returned if the reward seals of a block
+	// don't prove a quorum of committers of the last normal block
+	errInsufficientRewardSeals = errors.New("insufficient reward seals")
+
+	// errInvalidExchangers is returned if the rewarded exchangers of a block
+	// aren't distinct open exchangers
+	errInvalidExchangers = errors.New("invalid rewarded exchangers")
 )
 
 type SignerFn func(data []byte) ([]byte, error)
@@ -123,9 +134,30 @@ func (e *Engine) verifyHeader(chain consensus.ChainHeaderReader, header *types.H
 		return consensus.ErrFutureBlock
 	}
 
-	if _, err := types.ExtractQBFTExtra(header); err != nil {
+	var extra *types.QBFTExtra
+	var err error
+	if e.cfg.IsExtraVersion(header.Number) && header.Number.Sign() > 0 {
+		extra, err = types.ExtractVersionedQBFTExtra(header)
+		if err == types.ErrUnknownIstanbulExtraVersion {
+			return err
+		}
+	} else {
+		extra, err = types.ExtractQBFTExtra(header)
+	}
+	if err != nil {
 		return istanbulcommon.ErrInvalidExtraDataFormat
 	}
+	if e.cfg.IsExtraVersion(header.Number) && header.Number.Sign() > 0 {
+		// The lists bounded by the validator pool of the parent are checked
+		// on import, the pool is not known for the headers of a batch
+		empty := header.Coinbase == (common.Address{})
+		if err := extra.CheckLimits(types.IstanbulExtraLimits{Committee: types.IstanbulCommitteeSize}, empty); err != nil {
+			return err
+		}
+	}
+	if err := ibftengine.VerifyEvidence(e.cfg, header, extra.Evidence); err != nil {
+		return err
+	}
 
 	// Ensure that the mix digest is zero as we don't have fork protection currently
 	if header.MixDigest != types.IstanbulDigest {
@@ -138,7 +170,11 @@ func (e *Engine) verifyHeader(chain consensus.ChainHeaderReader, header *types.H
 	// }
 
 	// Ensure that the block's difficulty is meaningful (may not be correct at this point)
-	if header.Difficulty == nil || header.Difficulty.Cmp(istanbulcommon.DefaultDifficulty) != 0 {
+	if header.Coinbase == (common.Address{}) && header.Number.Cmp(common.Big0) > 0 {
+		if header.Difficulty == nil || header.Difficulty.Cmp(new(big.Int).SetUint64(e.cfg.EmptyBlockAt(header.Number).GetDifficulty())) != 0 {
+			return istanbulcommon.ErrInvalidDifficulty
+		}
+	} else if header.Difficulty == nil || header.Difficulty.Cmp(istanbulcommon.DefaultDifficulty) != 0 {
 		return istanbulcommon.ErrInvalidDifficulty
 	}
 
@@ -201,6 +237,12 @@ func (e *Engine) verifyCascadingFields(chain consensus.ChainHeaderReader, header
 		return istanbulcommon.ErrInvalidTimestamp
 	}
 
+	// Empty blocks are neither proposed nor committed, their votes are
+	// verified by the blockchain
+	if header.Coinbase == (common.Address{}) {
+		return nil
+	}
+
 	// Verify signer
 	if err := e.verifySigner(chain, header, parents, validators); err != nil {
 		return err
@@ -303,7 +345,9 @@ func (e *Engine) VerifySeal(chain consensus.ChainHeaderReader, header *types.Hea
 }
 
 func (e *Engine) Prepare(chain consensus.ChainHeaderReader, header *types.Header, validators istanbul.ValidatorSet) error {
-	header.Coinbase = common.Address{}
+	if header.Coinbase == (common.Address{}) && header.Number.Cmp(common.Big0) > 0 {
+		return errors.New("not a normal block")
+	}
 	header.Nonce = istanbulcommon.EmptyBlockNonce
 	header.MixDigest = types.IstanbulDigest
 
@@ -324,17 +368,158 @@ func (e *Engine) Prepare(chain consensus.ChainHeaderReader, header *types.Header
 		header.Time = uint64(time.Now().Unix())
 	}
 
+	var (
+		validatorAddr        []common.Address
+		exchangerAddr        []common.Address
+		rewardSeals          [][]byte
+		rewardAggregatedSeal *types.AggregatedSeal
+	)
+	if c, ok := chain.(*core.BlockChain); ok {
+		if number > 1 {
+			random11Validators, err := c.Random11ValidatorWithOutProxy(parent)
+			if err != nil {
+				log.Error("Prepare : invalid validators", "no", header.Number, "err", err)
+				return errors.New("Prepare: invalid validators")
+			}
+			validatorPool, err := c.ReadValidatorPool(c.CurrentBlock().Header())
+			if err != nil {
+				log.Error("Prepare : validator pool err", "no", header.Number, "err", err)
+				return err
+			}
+			// The committers of the last normal block are rewarded, its
+			// committed seals are copied to prove it
+			preHeader, err := getPreHash(chain, header)
+			if err != nil {
+				log.Error("Prepare get preHash err", "err", err, "no", header.Number)
+				return err
+			}
+			preExtra, err := types.ExtractWormholesExtra(preHeader)
+			if err != nil {
+				return err
+			}
+			rewardSeals = copySeals(preExtra.CommittedSeal)
+			rewardAggregatedSeal = preExtra.CommittedAggregatedSeal.Copy()
+
+			validatorAddr, err = e.rewarders(preHeader, rewardSeals, rewardAggregatedSeal, random11Validators.Len(), validatorPool)
+			if err != nil {
+				log.Error("Prepare rewarders err", "err", err, "preHeader", preHeader.Number, "no", header.Number)
+				return err
+			}
+		}
+
+		// reward to openExchangers
+		validatorList, err := c.ReadValidatorPool(parent)
+		if err != nil {
+			log.Error("Engine: Prepare", "err", err, "no", header.Number)
+			return err
+		}
+		exchangerAddr, err = selectExchangers(c, parent, validatorList)
+		if err != nil {
+			log.Error("Engine: Prepare", "selectExchangers err", err, "no", header.Number)
+			return err
+		}
+	}
+
 	// add validators in snapshot to extraData's validators section
 	return ApplyHeaderQBFTExtra(
 		header,
 		WriteValidators(validator.SortedAddresses(validators.List())),
+		writeRewards(exchangerAddr, validatorAddr, rewardSeals, rewardAggregatedSeal),
+		e.writeExtraVersion(header.Number),
 	)
 }
 
-func (e *Engine) PrepareEmpty(chain consensus.ChainHeaderReader, header *types.Header, validators istanbul.ValidatorSet, emptyBlockMessages [][]byte) error {
+// selectExchangers returns the exchangers rewarded in the child of parent,
+// they are drawn from the stakers of the chain head at the random drop of
+// the parent.
+func selectExchangers(c *core.BlockChain, parent *types.Header, validatorList *types.ValidatorList) ([]common.Address, error) {
+	// Obtain random landing points according to the surrounding chain algorithm
+	randomHash := core.GetRandomDrop(validatorList, parent)
+	if randomHash == (common.Hash{}) {
+		return nil, errors.New("invalid random hash")
+	}
+	return c.GetStakerPool().SelectRandom4Address(types.IstanbulRewardedExchangers, randomHash.Bytes())
+}
+
+// verifyExchangers checks that the rewarded exchangers of the child of parent
+// are distinct open exchangers of the parent. The draw itself can't be
+// recomputed as the stakers are only known at the chain head. Parents written
+// before the index of open exchangers existed aren't checked.
+func verifyExchangers(c *core.BlockChain, parent *types.Header, exchangerAddr []common.Address) error {
+	if len(exchangerAddr) > types.IstanbulRewardedExchangers {
+		return errInvalidExchangers
+	}
+	exchangerPool := c.ReadExchangerPool(parent)
+	if exchangerPool == nil {
+		return nil
+	}
+	seen := make(map[common.Address]bool)
+	for _, addr := range exchangerAddr {
+		if seen[addr] || exchangerPool.GetExchanger(addr) == nil {
+			return errInvalidExchangers
+		}
+		seen[addr] = true
+	}
 	return nil
 }
 
+// copySeals returns a deep copy of the committed seals
+func copySeals(seals [][]byte) [][]byte {
+	cpy := make([][]byte, len(seals))
+	for i, seal := range seals {
+		cpy[i] = make([]byte, types.IstanbulExtraSeal)
+		copy(cpy[i], seal)
+	}
+	return cpy
+}
+
+// getPreHash returns the header of the last normal block before header
+func getPreHash(chain consensus.ChainHeaderReader, header *types.Header) (*types.Header, error) {
+	preHeader := chain.GetHeaderByHash(header.ParentHash)
+	for preHeader != nil && preHeader.Coinbase == (common.Address{}) && preHeader.Number.Sign() > 0 {
+		preHeader = chain.GetHeaderByHash(preHeader.ParentHash)
+	}
+	if preHeader == nil {
+		return nil, errors.New("getPreHash : invalid preHeader")
+	}
+	return preHeader, nil
+}
+
+func (e *Engine) PrepareEmpty(chain consensus.ChainHeaderReader, header *types.Header, validators istanbul.ValidatorSet, emptyBlockMessages [][]byte) error {
+	if header.Coinbase != (common.Address{}) {
+		return errors.New("not a empty block")
+	}
+	header.Nonce = istanbulcommon.EmptyBlockNonce
+	header.MixDigest = types.IstanbulDigest
+
+	number := header.Number.Uint64()
+	if parent := chain.GetHeader(header.ParentHash, number-1); parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	header.Difficulty = new(big.Int).SetUint64(e.cfg.EmptyBlockAt(header.Number).GetDifficulty())
+	header.Time = uint64(time.Now().Unix())
+
+	// The voters are written as the validators, the proposer of the empty
+	// block first
+	return ApplyHeaderQBFTExtra(
+		header,
+		WriteValidators(validator.GetAllVotes(validators.List())),
+		writeEmptyBlockMessages(emptyBlockMessages),
+		e.writeExtraVersion(header.Number),
+	)
+}
+
+// writeExtraVersion writes the version of the extra-data of the block after
+// the extra version fork
+func (e *Engine) writeExtraVersion(number *big.Int) ApplyQBFTExtra {
+	return func(qbftExtra *types.QBFTExtra) error {
+		if !e.cfg.IsExtraVersion(number) {
+			return nil
+		}
+		return qbftExtra.SetVersion(types.IstanbulExtraVersion1)
+	}
+}
+
 func WriteValidators(validators []common.Address) ApplyQBFTExtra {
 	return func(qbftExtra *types.QBFTExtra) error {
 		qbftExtra.Validators = validators
@@ -342,20 +527,55 @@ func WriteValidators(validators []common.Address) ApplyQBFTExtra {
 	}
 }
 
+// writeRewards writes the rewarded exchangers and validators of a normal block
+// along with the seals proving the validators committed the last normal block.
+func writeRewards(exchangerAddr, validatorAddr []common.Address, rewardSeals [][]byte, rewardAggregatedSeal *types.AggregatedSeal) ApplyQBFTExtra {
+	return func(qbftExtra *types.QBFTExtra) error {
+		qbftExtra.ExchangerAddr = exchangerAddr
+		qbftExtra.ValidatorAddr = validatorAddr
+		qbftExtra.RewardSeal = rewardSeals
+		qbftExtra.RewardAggregatedSeal = rewardAggregatedSeal
+		return nil
+	}
+}
+
+// writeEmptyBlockMessages writes the votes of an empty block.
+func writeEmptyBlockMessages(emptyBlockMessages [][]byte) ApplyQBFTExtra {
+	return func(qbftExtra *types.QBFTExtra) error {
+		qbftExtra.EmptyBlockMessages = emptyBlockMessages
+		return nil
+	}
+}
+
 // Finalize runs any post-transaction state modifications (e.g. block rewards)
 // and assembles the final block.
 //
 // Note, the block header and state database might be updated to reflect any
 // consensus rules that happen at finalization (e.g. block rewards).
 func (e *Engine) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header) {
-	// No block rewards in Istanbul, so the state remains as is and uncles are dropped
+	if c, ok := chain.(*core.BlockChain); ok {
+		if err := e.applyRewards(c, header, state); err != nil {
+			log.Error("Finalize: rewards not applied", "no", header.Number, "err", err)
+			return
+		}
+	}
+
+	/// No block rewards in Istanbul, so the state remains as is and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = nilUncleHash
 }
 
-// FinalizeAndAssemble implements consensus.Engine, ensuring no uncles are set,
-// nor block rewards given, and returns the final block.
+// FinalizeAndAssemble implements consensus.Engine, applying the coefficient
+// adjustments and the rewards of the block written in Prepare or PrepareEmpty,
+// and returns the final block.
 func (e *Engine) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
+	if c, ok := chain.(*core.BlockChain); ok {
+		if err := e.applyRewards(c, header, state); err != nil {
+			log.Error("FinalizeAndAssemble: rewards not applied", "no", header.Number, "err", err)
+			return nil, err
+		}
+	}
+
 	/// No block rewards in Istanbul, so the state remains as is and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = nilUncleHash
@@ -364,9 +584,284 @@ func (e *Engine) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *
 	return types.NewBlock(header, txs, nil, receipts, new(trie.Trie)), nil
 }
 
+// applyRewards applies the coefficient adjustments and the rewards of the
+// block to state. The voters and the rewarded validators are recovered from
+// the signatures against the validator pool of the parent, as in
+// Participation, the addresses in the extra-data are not trusted.
+func (e *Engine) applyRewards(c *core.BlockChain, header *types.Header, state *state.StateDB) error {
+	parent := c.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	random11Validators, err := c.Random11ValidatorWithOutProxy(parent)
+	if err != nil {
+		return err
+	}
+	extra, err := types.ExtractWormholesExtra(header)
+	if err != nil {
+		return err
+	}
+	validatorPool, err := c.ReadValidatorPool(parent)
+	if err != nil {
+		return err
+	}
+
+	// The rewards of an empty block are left out of its hash, so only its
+	// voters are trusted
+	var voters, validatorAddr, exchangerAddr []common.Address
+	if header.Coinbase == (common.Address{}) {
+		if voters, err = ibftengine.EmptyBlockVoters(extra, validatorPool.Validators); err != nil {
+			return err
+		}
+		if len(voters) == 0 {
+			return errors.New("empty block without votes")
+		}
+	} else {
+		if header.Number.Uint64() > 1 {
+			preHeader, err := getPreHash(c, header)
+			if err != nil {
+				return err
+			}
+			validatorAddr, err = e.rewarders(preHeader, extra.RewardSeal, extra.RewardAggregatedSeal, random11Validators.Len(), validatorPool)
+			if err != nil {
+				return err
+			}
+		}
+		if err := verifyExchangers(c, parent, extra.ExchangerAddr); err != nil {
+			return err
+		}
+		exchangerAddr = extra.ExchangerAddr
+	}
+	e.accumulateRewards(c, header, state, random11Validators.ConvertToAddress(), voters, validatorAddr, exchangerAddr)
+	ibftengine.PenalizeEquivocations(e.cfg, header, extra, validatorPool.Validators, state)
+	return nil
+}
+
+// accumulateRewards applies the coefficient adjustments of the block: the
+// committee of an empty block is penalized and its voters, the proposer at the
+// head excluded, are rewarded, while the rewarded validators of a normal block
+// gain the normal block reward. The validators and the exchangers are then
+// rewarded with ERB and SNFTs.
+func (e *Engine) accumulateRewards(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, committee, voters, validatorAddr, exchangerAddr []common.Address) {
+	if header.Coinbase == (common.Address{}) {
+		for _, addr := range committee {
+			state.SubValidatorCoefficient(addr, uint8(e.cfg.EmptyBlockAt(header.Number).GetPenalty()))
+		}
+		if len(voters) > 0 {
+			for _, vote := range voters[1:] {
+				state.AddValidatorCoefficient(vote, uint8(e.cfg.EmptyBlockAt(header.Number).GetVoteReward()))
+			}
+		}
+	} else {
+		for _, addr := range validatorAddr {
+			state.AddValidatorCoefficient(addr, ibftengine.NormalBlockReward)
+		}
+	}
+	for _, addr := range validatorAddr {
+		log.Info("Finalize : CreateNFTByOfficial16", "ValidatorAddr=", addr.Hex(), "Coinbase", header.Coinbase.Hex(), "no", header.Number.Uint64())
+	}
+	for _, addr := range exchangerAddr {
+		log.Info("Finalize : CreateNFTByOfficial16", "ExchangerAddr=", addr.Hex(), "Coinbase", header.Coinbase.Hex(), "no", header.Number.Uint64())
+	}
+	state.CreateNFTByOfficial16(validatorAddr, exchangerAddr, header.Number, chain.Config().IsOfficialNFTProposal(header.Number))
+}
+
+// rewarders returns the validators rewarded for committing preHeader, the
+// last normal block, out of its committed seals copied into the reward seals.
+// A quorum of the committee has to be proven, proxies are resolved to their
+// validators in validatorPool.
+func (e *Engine) rewarders(preHeader *types.Header, rewardSeal [][]byte, rewardAggregatedSeal *types.AggregatedSeal, committeeSize int, validatorPool *types.ValidatorList) ([]common.Address, error) {
+	quorumSize := ibftengine.QuorumSize(committeeSize)
+	if quorumSize == 0 {
+		return nil, errors.New("invalid quorum size")
+	}
+	var (
+		committers []common.Address
+		err        error
+	)
+	if rewardAggregatedSeal != nil {
+		committers, err = e.RecoverAggregatedRewards(preHeader, rewardAggregatedSeal)
+	} else {
+		committers, err = e.RecoverRewards(preHeader, rewardSeal)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(committers) < quorumSize {
+		return nil, errInsufficientRewardSeals
+	}
+	validatorAddr := make([]common.Address, quorumSize)
+	copy(validatorAddr, committers)
+
+	// If the reward address is on a proxy account, it will be restored to a pledge account
+	if validatorPool != nil {
+		for i, addr := range validatorAddr {
+			for _, v := range validatorPool.Validators {
+				if v.Proxy != (common.Address{}) && v.Proxy == addr {
+					validatorAddr[i] = v.Addr
+					break
+				}
+			}
+		}
+	}
+	return validatorAddr, nil
+}
+
+// RewardSigners returns the committers of preHeader, the last normal block,
+// proven by the reward seals of header
+func (e *Engine) RewardSigners(preHeader, header *types.Header) ([]common.Address, error) {
+	extra, err := types.ExtractWormholesExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	if extra.RewardAggregatedSeal != nil {
+		return e.RecoverAggregatedRewards(preHeader, extra.RewardAggregatedSeal)
+	}
+	return e.RecoverRewards(preHeader, extra.RewardSeal)
+}
+
+// RecoverRewards returns the committers of header out of the reward seals,
+// which are the committed seals of header. The header is the last normal
+// block, which is sealed by ibft if it precedes the qbft fork.
+func (e *Engine) RecoverRewards(header *types.Header, rewardSeal [][]byte) ([]common.Address, error) {
+	var (
+		proposalSeal []byte
+		recover      = istanbul.GetSignatureAddressNoHashing
+	)
+	if qbftExtra, err := types.ExtractQBFTExtra(header); err == nil {
+		proposalSeal = PrepareCommittedSeal(header, qbftExtra.Round)
+	} else {
+		proposalSeal, recover = ibftengine.PrepareCommittedSeal(header.Hash()), istanbul.GetSignatureAddress
+	}
+
+	var addrs []common.Address
+	for _, seal := range rewardSeal {
+		addr, err := recover(proposalSeal, seal)
+		if err != nil {
+			return nil, istanbulcommon.ErrInvalidSignature
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// RecoverAggregatedRewards returns the committers of header out of the reward
+// seal, which has to be the aggregated committed seal of header. Only the
+// ibft blocks after the bls fork carry aggregated seals.
+func (e *Engine) RecoverAggregatedRewards(header *types.Header, rewardSeal *types.AggregatedSeal) ([]common.Address, error) {
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, istanbulcommon.ErrInvalidSignature
+	}
+	committedSeal := extra.CommittedAggregatedSeal
+	if committedSeal == nil ||
+		!bytes.Equal(committedSeal.Signature, rewardSeal.Signature) ||
+		!bytes.Equal(committedSeal.Bitmap, rewardSeal.Bitmap) {
+		return nil, istanbulcommon.ErrInvalidSignature
+	}
+	return rewardSeal.Signers(extra.Validators)
+}
+
+// Participation implements consensus.ParticipationReader, attributing the
+// proposal, the seals and the empty block votes of the block to the validators
+// along with the coefficient adjustments of Finalize.
+func (e *Engine) Participation(chain consensus.ChainHeaderReader, header *types.Header) ([]*types.ValidatorStats, error) {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil, nil
+	}
+	c, ok := chain.(*core.BlockChain)
+	if !ok {
+		return nil, errors.New("Participation: unsupported chain")
+	}
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	pool, err := c.ReadValidatorPool(parent)
+	if err != nil {
+		return nil, err
+	}
+	extra, err := types.ExtractWormholesExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	// Seals are signed by the proxies of the validators
+	validatorAddr := func(addr common.Address) common.Address {
+		if v := pool.GetValidatorAddr(addr); v != (common.Address{}) {
+			return v
+		}
+		return addr
+	}
+
+	random11Validators, err := c.Random11ValidatorWithOutProxy(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(types.ValidatorStatsSet)
+	emptyBlock := e.cfg.EmptyBlockAt(header.Number)
+	for _, addr := range ibftengine.Equivocators(e.cfg, header, extra, pool.Validators) {
+		stats.Get(addr).CoefficientSubtracted += emptyBlock.GetEquivocationPenalty()
+	}
+	if header.Coinbase == (common.Address{}) {
+		for _, v := range random11Validators.Validators {
+			stats.Get(v.Address()).CoefficientSubtracted += emptyBlock.GetPenalty()
+		}
+		voteAddrs, err := ibftengine.EmptyBlockVoters(extra, pool.Validators)
+		if err != nil {
+			return nil, err
+		}
+		if len(voteAddrs) > 0 {
+			stats.Get(voteAddrs[0]).Proposed++
+			for _, vote := range voteAddrs[1:] {
+				s := stats.Get(vote)
+				s.EmptyBlockVotes++
+				s.CoefficientAdded += emptyBlock.GetVoteReward()
+			}
+		}
+		return stats.List(), nil
+	}
+	stats.Get(validatorAddr(header.Coinbase)).Proposed++
+
+	committers, err := e.Signers(header)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range committers {
+		stats.Get(validatorAddr(addr)).CommittedSeals++
+	}
+	if number > 1 {
+		if preHeader, err := getPreHash(chain, header); err == nil {
+			rewarders, err := e.RewardSigners(preHeader, header)
+			if err != nil {
+				return nil, err
+			}
+			for _, addr := range rewarders {
+				stats.Get(validatorAddr(addr)).RewardSeals++
+			}
+			rewarded, err := e.rewarders(preHeader, extra.RewardSeal, extra.RewardAggregatedSeal, random11Validators.Len(), pool)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range rewarded {
+				stats.Get(v).CoefficientAdded += ibftengine.NormalBlockReward
+			}
+		}
+	}
+	return stats.List(), nil
+}
+
 // Seal generates a new block for the given input block with the local miner's
 // seal place on top.
 func (e *Engine) Seal(chain consensus.ChainHeaderReader, block *types.Block, validators istanbul.ValidatorSet) (*types.Block, error) {
+	// An empty block has no proposer, its coinbase is left unset
+	if block.Coinbase() == (common.Address{}) && block.Number().Sign() > 0 {
+		if parent := chain.GetHeader(block.ParentHash(), block.NumberU64()-1); parent == nil {
+			return block, consensus.ErrUnknownAncestor
+		}
+		return block, nil
+	}
 	if _, v := validators.GetByAddress(e.signer); v == nil {
 		log.Info("caver|engine|seal", "no", block.NumberU64())
 		return block, istanbulcommon.ErrUnauthorized
@@ -469,6 +964,31 @@ func WriteVote(candidate common.Address, authorize bool) ApplyQBFTExtra {
 	}
 }
 
+// WriteEvidence writes the evidence of equivocating empty block voters into
+// the extra-data of the header
+func (e *Engine) WriteEvidence(header *types.Header, evidence []*types.EmptyBlockEvidence) error {
+	return ApplyHeaderQBFTExtra(
+		header,
+		func(qbftExtra *types.QBFTExtra) error {
+			qbftExtra.Evidence = evidence
+			return nil
+		},
+	)
+}
+
+// WriteValidatorCommitment writes the hash of the validator checkpoint left by
+// the block, and the checkpoint itself if given, into the extra-data of the header
+func (e *Engine) WriteValidatorCommitment(header *types.Header, hash common.Hash, checkpoint *types.ValidatorCheckpoint) error {
+	return ApplyHeaderQBFTExtra(
+		header,
+		func(qbftExtra *types.QBFTExtra) error {
+			qbftExtra.ValidatorsHash = hash
+			qbftExtra.Checkpoint = checkpoint
+			return nil
+		},
+	)
+}
+
 func (e *Engine) ReadVote(header *types.Header) (candidate common.Address, authorize bool, err error) {
 	qbftExtra, err := getExtra(header)
 	if err != nil {
@@ -2,14 +2,22 @@ package qbftengine
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"math/big"
 	"reflect"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
+	ibftengine "github.com/ethereum/go-ethereum/consensus/istanbul/ibft/engine"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 func TestPrepareExtra(t *testing.T) {
@@ -159,3 +167,192 @@ func TestWriteValidatorVote(t *testing.T) {
 		t.Errorf("extra data mismatch: have %v, want %v", istExtra, expectedIstExtra)
 	}
 }
+
+type testChainReader map[common.Hash]*types.Header
+
+func (r testChainReader) Config() *params.ChainConfig  { return params.TestChainConfig }
+func (r testChainReader) CurrentHeader() *types.Header { return nil }
+func (r testChainReader) GetHeader(hash common.Hash, number uint64) *types.Header {
+	return r[hash]
+}
+func (r testChainReader) GetHeaderByNumber(number uint64) *types.Header {
+	for _, h := range r {
+		if h.Number.Uint64() == number {
+			return h
+		}
+	}
+	return nil
+}
+func (r testChainReader) GetHeaderByHash(hash common.Hash) *types.Header { return r[hash] }
+
+func newTestState(t *testing.T) *state.StateDB {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mask, _ := new(big.Int).SetString("8000000000000000000000000000000000000000", 16)
+	statedb.MintDeep = &types.MintDeep{UserMint: big.NewInt(1), OfficialMint: mask}
+	statedb.OfficialNFTPool = &types.InjectedOfficialNFTList{
+		InjectedOfficialNFTs: []*types.InjectedOfficialNFT{{
+			Dir:        "/ipfs/snft",
+			StartIndex: big.NewInt(0),
+			Number:     4096,
+			Royalty:    100,
+			Creator:    "0x0000000000000000000000000000000000000001",
+			VoteWeight: big.NewInt(0),
+		}},
+	}
+	statedb.NominatedOfficialNFT = new(types.NominatedOfficialNFT)
+	return statedb
+}
+
+// TestIBFTToQBFTTransition checks that the first qbft blocks reward the
+// committers of the last ibft block, across an empty block, and that the
+// coefficient adjustments and rewards of ibft are applied by qbft.
+func TestIBFTToQBFTTransition(t *testing.T) {
+	var (
+		keys       = make([]*ecdsa.PrivateKey, 4)
+		validators = make([]common.Address, 4)
+		proxyKey   *ecdsa.PrivateKey
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	// The second validator seals with a proxy
+	proxyKey, _ = crypto.GenerateKey()
+	pool := new(types.ValidatorList)
+	for i, key := range keys {
+		validators[i] = crypto.PubkeyToAddress(key.PublicKey)
+		var proxy common.Address
+		if i == 1 {
+			proxy = crypto.PubkeyToAddress(proxyKey.PublicKey)
+		}
+		pool.AddValidator(validators[i], big.NewInt(1e18), proxy)
+	}
+	signers := []*ecdsa.PrivateKey{keys[0], proxyKey, keys[2], keys[3]}
+	chain := make(testChainReader)
+
+	genesis := &types.Header{Number: big.NewInt(0), Coinbase: validators[0], MixDigest: types.IstanbulDigest}
+	chain[genesis.Hash()] = genesis
+
+	// Block 1 is the last ibft block
+	ibftHeader := &types.Header{ParentHash: genesis.Hash(), Number: big.NewInt(1), Coinbase: validators[0], Difficulty: istanbulcommon.DefaultDifficulty, MixDigest: types.IstanbulDigest}
+	ibftExtra := &types.IstanbulExtra{Validators: validators, Seal: []byte{}, CommittedSeal: [][]byte{}}
+	payload, _ := rlp.EncodeToBytes(ibftExtra)
+	ibftHeader.Extra = append(bytes.Repeat([]byte{0x00}, types.IstanbulExtraVanity), payload...)
+	proposalSeal := crypto.Keccak256(ibftengine.PrepareCommittedSeal(ibftHeader.Hash()))
+	for _, key := range signers {
+		seal, _ := crypto.Sign(proposalSeal, key)
+		ibftExtra.CommittedSeal = append(ibftExtra.CommittedSeal, seal)
+	}
+	payload, _ = rlp.EncodeToBytes(ibftExtra)
+	ibftHeader.Extra = append(bytes.Repeat([]byte{0x00}, types.IstanbulExtraVanity), payload...)
+	chain[ibftHeader.Hash()] = ibftHeader
+
+	// Block 2 is an empty qbft block
+	emptyHeader := &types.Header{ParentHash: ibftHeader.Hash(), Number: big.NewInt(2), Difficulty: big.NewInt(24), MixDigest: types.IstanbulDigest}
+	if err := ApplyHeaderQBFTExtra(emptyHeader, WriteValidators([]common.Address{validators[2], validators[3]}), writeEmptyBlockMessages([][]byte{{0x01}})); err != nil {
+		t.Fatal(err)
+	}
+	chain[emptyHeader.Hash()] = emptyHeader
+
+	// The votes of the empty block don't change its hash
+	votedHeader := types.CopyHeader(emptyHeader)
+	if err := ApplyHeaderQBFTExtra(votedHeader, WriteValidators(validators), writeEmptyBlockMessages([][]byte{{0x02}})); err != nil {
+		t.Fatal(err)
+	}
+	if votedHeader.Hash() != emptyHeader.Hash() {
+		t.Fatal("empty block hash depends on the votes")
+	}
+
+	// Block 3 is the first normal qbft block, it rewards the committers of
+	// block 1 with the proxy resolved to its validator
+	engine := NewEngine(istanbul.DefaultConfig, validators[2], nil)
+	qbftHeader := &types.Header{ParentHash: emptyHeader.Hash(), Number: big.NewInt(3), Coinbase: validators[2], Difficulty: istanbulcommon.DefaultDifficulty, MixDigest: types.IstanbulDigest}
+	preHeader, err := getPreHash(chain, qbftHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preHeader.Hash() != ibftHeader.Hash() {
+		t.Fatalf("preHeader mismatch: have %d, want %d", preHeader.Number, ibftHeader.Number)
+	}
+	rewardSeals := copySeals(ibftExtra.CommittedSeal)
+	rewarded, err := engine.rewarders(preHeader, rewardSeals, nil, len(validators), pool)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := validators[:3]; !reflect.DeepEqual(rewarded, want) {
+		t.Fatalf("rewarded validators mismatch: have %v, want %v", rewarded, want)
+	}
+	if _, err := engine.rewarders(preHeader, rewardSeals[:2], nil, len(validators), pool); err != errInsufficientRewardSeals {
+		t.Fatalf("error mismatch: have %v, want %v", err, errInsufficientRewardSeals)
+	}
+	exchangers := []common.Address{common.HexToAddress("0x0e0e"), common.HexToAddress("0x0f0f")}
+	if err := ApplyHeaderQBFTExtra(qbftHeader, WriteValidators(validators), writeRewards(exchangers, rewarded, rewardSeals, nil)); err != nil {
+		t.Fatal(err)
+	}
+	extra, err := types.ExtractWormholesExtra(qbftHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(extra.ValidatorAddr, rewarded) || !reflect.DeepEqual(extra.ExchangerAddr, exchangers) || len(extra.RewardSeal) != len(signers) {
+		t.Fatalf("extra mismatch: have %+v", extra)
+	}
+
+	statedb := newTestState(t)
+	engine.accumulateRewards(chain, qbftHeader, statedb, validators, nil, rewarded, exchangers)
+	reward := state.GetRewardAmount(qbftHeader.Number.Uint64(), state.DREBlockReward)
+	for _, addr := range rewarded {
+		if have := statedb.GetBalance(addr); have.Cmp(reward) != 0 {
+			t.Errorf("reward of %x mismatch: have %v, want %v", addr, have, reward)
+		}
+		if have := statedb.GetValidatorCoefficient(addr); have != state.VALIDATOR_COEFFICIENT {
+			t.Errorf("coefficient of %x mismatch: have %d, want %d", addr, have, state.VALIDATOR_COEFFICIENT)
+		}
+	}
+	for i, addr := range exchangers {
+		snft := common.BigToAddress(new(big.Int).Add(statedb.MintDeep.OfficialMint, big.NewInt(int64(i-len(exchangers)))))
+		if have := statedb.GetNFTOwner16(snft); have != addr {
+			t.Errorf("owner of snft %x mismatch: have %x, want %x", snft, have, addr)
+		}
+	}
+	chain[qbftHeader.Hash()] = qbftHeader
+
+	// The committed seals of block 3 are signed over the qbft hash, they prove
+	// the rewards of block 4
+	qbftExtra, _ := types.ExtractQBFTExtra(qbftHeader)
+	qbftExtra.Round = 1
+	payload, _ = rlp.EncodeToBytes(qbftExtra)
+	qbftHeader.Extra = payload
+	var committedSeals [][]byte
+	for _, key := range signers[1:] {
+		seal, _ := crypto.Sign(PrepareCommittedSeal(qbftHeader, 1), key)
+		committedSeals = append(committedSeals, seal)
+	}
+	if err := engine.CommitHeader(qbftHeader, committedSeals, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	chain[qbftHeader.Hash()] = qbftHeader
+	nextHeader := &types.Header{ParentHash: qbftHeader.Hash(), Number: big.NewInt(4), Coinbase: validators[3]}
+	if preHeader, err = getPreHash(chain, nextHeader); err != nil {
+		t.Fatal(err)
+	}
+	rewarded, err = engine.rewarders(preHeader, copySeals(committedSeals), nil, len(validators), pool)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := validators[1:]; !reflect.DeepEqual(rewarded, want) {
+		t.Fatalf("rewarded validators mismatch: have %v, want %v", rewarded, want)
+	}
+
+	// An empty block penalizes the committee and rewards its voters but the
+	// proposer
+	statedb.AddValidatorCoefficient(validators[3], 0)
+	engine.accumulateRewards(chain, emptyHeader, statedb, validators, []common.Address{validators[0], validators[1]}, nil, nil)
+	penalty := uint8(params.DefaultEmptyBlockConfig.GetPenalty())
+	for i, want := range []uint8{state.VALIDATOR_COEFFICIENT - penalty, state.VALIDATOR_COEFFICIENT, state.VALIDATOR_COEFFICIENT - penalty, state.VALIDATOR_COEFFICIENT - penalty} {
+		if have := statedb.GetValidatorCoefficient(validators[i]); have != want {
+			t.Errorf("coefficient of validator %d mismatch: have %d, want %d", i, have, want)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// pollInterval is the interval the heads of the nodes are polled at
+const pollInterval = 100 * time.Millisecond
+
+var (
+	// ErrNotLive is returned if the network doesn't reach a height in time.
+	ErrNotLive = errors.New("network not live")
+
+	// ErrNoEmptyBlock is returned if the network doesn't produce an empty
+	// block in time.
+	ErrNoEmptyBlock = errors.New("no empty block produced")
+
+	// ErrInconsistent is returned if two nodes disagree on a block.
+	ErrInconsistent = errors.New("inconsistent chains")
+)
+
+// selectNodes returns the nodes of the indices, all of them if none is given.
+func (n *Network) selectNodes(indices []int) ([]*Node, error) {
+	if len(indices) == 0 {
+		return n.nodes, nil
+	}
+	nodes := make([]*Node, 0, len(indices))
+	for _, i := range indices {
+		node, err := n.Node(i)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// WaitHeight waits until the nodes, all of them if none is given, reach the
+// height. It checks the liveness of the network.
+func (n *Network) WaitHeight(height uint64, timeout time.Duration, indices ...int) error {
+	nodes, err := n.selectNodes(indices)
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		lowest := ^uint64(0)
+		for _, node := range nodes {
+			if number := node.Head().NumberU64(); number < lowest {
+				lowest = number
+			}
+		}
+		if lowest >= height {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%w: height %d after %v, want %d", ErrNotLive, lowest, timeout, height)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// EmptyBlocks returns the numbers of the empty blocks the node has between
+// from and to, both included. Empty blocks have no coinbase, they are voted
+// by the online validators when no committee seals a block.
+func (n *Network) EmptyBlocks(index int, from, to uint64) ([]uint64, error) {
+	node, err := n.Node(index)
+	if err != nil {
+		return nil, err
+	}
+	chain := node.Ethereum().BlockChain()
+	var empty []uint64
+	for number := from; number <= to; number++ {
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			break
+		}
+		if number > 0 && header.Coinbase == (common.Address{}) {
+			empty = append(empty, number)
+		}
+	}
+	return empty, nil
+}
+
+// WaitEmptyBlock waits until the node imports an empty block after its current
+// head and returns its number.
+func (n *Network) WaitEmptyBlock(index int, timeout time.Duration) (uint64, error) {
+	node, err := n.Node(index)
+	if err != nil {
+		return 0, err
+	}
+	next := node.Head().NumberU64() + 1
+	deadline := time.Now().Add(timeout)
+	for {
+		head := node.Head().NumberU64()
+		if head >= next {
+			empty, err := n.EmptyBlocks(index, next, head)
+			if err != nil {
+				return 0, err
+			}
+			if len(empty) > 0 {
+				return empty[0], nil
+			}
+			next = head + 1
+		}
+		if time.Now().After(deadline) {
+			return 0, fmt.Errorf("%w: head %d after %v", ErrNoEmptyBlock, head, timeout)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// CheckConsistency checks that the nodes, all of them if none is given, agree
+// on every block up to the lowest of their heads and returns that height. The
+// state roots are compared first to tell a state divergence apart from a fork.
+func (n *Network) CheckConsistency(indices ...int) (uint64, error) {
+	nodes, err := n.selectNodes(indices)
+	if err != nil {
+		return 0, err
+	}
+	lowest := ^uint64(0)
+	for _, node := range nodes {
+		if number := node.Head().NumberU64(); number < lowest {
+			lowest = number
+		}
+	}
+	reference := nodes[0].Ethereum().BlockChain()
+	for number := uint64(0); number <= lowest; number++ {
+		want := reference.GetHeaderByNumber(number)
+		if want == nil {
+			return 0, fmt.Errorf("%w: node %d misses block %d", ErrInconsistent, nodes[0].Index, number)
+		}
+		for _, node := range nodes[1:] {
+			have := node.Ethereum().BlockChain().GetHeaderByNumber(number)
+			switch {
+			case have == nil:
+				return 0, fmt.Errorf("%w: node %d misses block %d", ErrInconsistent, node.Index, number)
+			case have.Root != want.Root:
+				return 0, fmt.Errorf("%w: state root of block %d is %x on node %d, %x on node %d",
+					ErrInconsistent, number, have.Root, node.Index, want.Root, nodes[0].Index)
+			case have.Hash() != want.Hash():
+				return 0, fmt.Errorf("%w: block %d is %x on node %d, %x on node %d",
+					ErrInconsistent, number, have.Hash(), node.Index, want.Hash(), nodes[0].Index)
+			}
+		}
+	}
+	return lowest, nil
+}
@@ -0,0 +1,129 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// FaultKind is the kind of a fault injected in the network.
+type FaultKind uint8
+
+const (
+	// FaultOffline disconnects the nodes from all the others
+	FaultOffline FaultKind = iota
+
+	// FaultPartition separates the nodes from the rest of the network
+	FaultPartition
+
+	// FaultDelay delays the messages of the nodes
+	FaultDelay
+)
+
+func (k FaultKind) String() string {
+	switch k {
+	case FaultOffline:
+		return "offline"
+	case FaultPartition:
+		return "partition"
+	case FaultDelay:
+		return "delay"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(k))
+	}
+}
+
+// Fault is a fault injected in the network for some time.
+type Fault struct {
+	Kind     FaultKind
+	Nodes    []int         // Nodes affected by the fault
+	Latency  time.Duration // Delay of the messages of the nodes, FaultDelay only
+	Duration time.Duration // Time the fault lasts before the network is repaired
+}
+
+func (f Fault) String() string {
+	if f.Kind == FaultDelay {
+		return fmt.Sprintf("%v%v by %v for %v", f.Kind, f.Nodes, f.Latency, f.Duration)
+	}
+	return fmt.Sprintf("%v%v for %v", f.Kind, f.Nodes, f.Duration)
+}
+
+// RandomSchedule generates steps faults from the seed. The faults affect at
+// most a third of the validators minus one, the others keep a quorum of the
+// committee, and last between duration and twice duration.
+func RandomSchedule(seed int64, validators int, steps int, duration time.Duration) []Fault {
+	rng := rand.New(rand.NewSource(seed))
+	faulty := (validators+2)/3 - 1
+	if faulty < 1 {
+		faulty = 1
+	}
+	schedule := make([]Fault, 0, steps)
+	for i := 0; i < steps; i++ {
+		fault := Fault{
+			Kind:     FaultKind(rng.Intn(int(FaultDelay) + 1)),
+			Nodes:    rng.Perm(validators)[:1+rng.Intn(faulty)],
+			Duration: duration + time.Duration(rng.Int63n(int64(duration)+1)),
+		}
+		if fault.Kind == FaultDelay {
+			fault.Latency = time.Duration(50+rng.Intn(450)) * time.Millisecond
+		}
+		schedule = append(schedule, fault)
+	}
+	return schedule
+}
+
+// Inject applies the fault to the network.
+func (n *Network) Inject(fault Fault) error {
+	log.Info("Injecting simulated fault", "fault", fault)
+	switch fault.Kind {
+	case FaultOffline:
+		return n.Offline(fault.Nodes...)
+	case FaultPartition:
+		return n.Partition(fault.Nodes)
+	case FaultDelay:
+		for _, i := range fault.Nodes {
+			if err := n.Delay(i, fault.Latency); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown fault kind %v", fault.Kind)
+	}
+}
+
+// Repair brings the offline nodes back online, removes the partition and the
+// latencies.
+func (n *Network) Repair() error {
+	n.lock.Lock()
+	offline := make([]int, 0, len(n.offline))
+	for i := range n.offline {
+		offline = append(offline, i)
+	}
+	n.lock.Unlock()
+
+	if err := n.Heal(); err != nil {
+		return err
+	}
+	return n.Online(offline...)
+}
+
+// Run injects the faults of the schedule one after the other, each one lasts
+// for its duration before the network is repaired.
+func (n *Network) Run(schedule []Fault) error {
+	if !n.isStarted() {
+		return errNotStarted
+	}
+	for _, fault := range schedule {
+		if err := n.Inject(fault); err != nil {
+			return err
+		}
+		time.Sleep(fault.Duration)
+		if err := n.Repair(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
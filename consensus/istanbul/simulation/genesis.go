@@ -0,0 +1,196 @@
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	// errTooFewValidators is returned if the committee of 11 validators can't
+	// be drawn from the validator pool.
+	errTooFewValidators = errors.New("at least 11 validators are needed")
+
+	// errTooFewExchangers is returned if the 4 rewarded stakers can't be drawn
+	// from the staker pool.
+	errTooFewExchangers = errors.New("at least 4 exchangers are needed")
+
+	// errTooManyProxies is returned if more validators seal through a proxy
+	// than there are validators.
+	errTooManyProxies = errors.New("more proxies than validators")
+)
+
+var (
+	ether = big.NewInt(params.Ether)
+
+	// validatorBalance is the amount pledged by every validator in the genesis
+	validatorBalance = new(big.Int).Mul(big.NewInt(70000), ether)
+
+	// exchangerBalance is the amount staked by every exchanger in the genesis
+	exchangerBalance = new(big.Int).Mul(big.NewInt(1000), ether)
+
+	// accountBalance is the free balance of every generated account
+	accountBalance = new(big.Int).Mul(big.NewInt(1000000), ether)
+)
+
+// Config is the configuration of a simulated network.
+type Config struct {
+	Validators int   // Number of validators, each one runs a node
+	Proxies    int   // Number of validators sealing with a proxy key instead of their own
+	Exchangers int   // Number of stakers opening an exchanger in the genesis
+	Seed       int64 // Seed of the keys and of the random fault schedules
+
+	BlockPeriod    uint64                   // Minimum seconds between two blocks
+	RequestTimeout uint64                   // Milliseconds of the first round of a sequence
+	EmptyBlock     *params.EmptyBlockConfig // Liveness parameters of the empty blocks
+}
+
+// DefaultConfig is a network of 11 validators, the smallest pool the committee
+// can be drawn from, with empty blocks produced after 10 seconds without a block.
+var DefaultConfig = Config{
+	Validators:     11,
+	Proxies:        2,
+	Exchangers:     6,
+	Seed:           1,
+	BlockPeriod:    1,
+	RequestTimeout: 3000,
+	EmptyBlock: &params.EmptyBlockConfig{
+		Timeout:        newUint64(10),
+		EarlyTimeout:   newUint64(5),
+		GossipInterval: newUint64(1),
+	},
+}
+
+func newUint64(v uint64) *uint64 { return &v }
+
+func (c *Config) check() error {
+	if c.Validators < 11 {
+		return errTooFewValidators
+	}
+	if c.Exchangers < 4 {
+		return errTooFewExchangers
+	}
+	if c.Proxies > c.Validators {
+		return errTooManyProxies
+	}
+	return nil
+}
+
+// Validator is a generated validator of the network.
+type Validator struct {
+	Owner  *ecdsa.PrivateKey // Account pledging the validator balance
+	Signer *ecdsa.PrivateKey // Node key sealing the blocks, the owner unless a proxy is used
+}
+
+// Address returns the account pledging the validator balance.
+func (v *Validator) Address() common.Address {
+	return crypto.PubkeyToAddress(v.Owner.PublicKey)
+}
+
+// SignerAddress returns the address sealing the blocks of the validator.
+func (v *Validator) SignerAddress() common.Address {
+	return crypto.PubkeyToAddress(v.Signer.PublicKey)
+}
+
+// Proxied reports whether the validator seals through a proxy.
+func (v *Validator) Proxied() bool {
+	return v.Owner != v.Signer
+}
+
+// deriveKey derives a key from the seed, so that a network is reproducible.
+func deriveKey(seed int64, kind string, index int) (*ecdsa.PrivateKey, error) {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], uint64(seed))
+	binary.BigEndian.PutUint64(buf[8:], uint64(index))
+	return crypto.ToECDSA(crypto.Keccak256(buf[:], []byte(kind)))
+}
+
+// accounts derives the validators and the exchangers of the network.
+func (c *Config) accounts() ([]*Validator, []*ecdsa.PrivateKey, error) {
+	validators := make([]*Validator, c.Validators)
+	for i := range validators {
+		owner, err := deriveKey(c.Seed, "validator", i)
+		if err != nil {
+			return nil, nil, err
+		}
+		validators[i] = &Validator{Owner: owner, Signer: owner}
+		if i < c.Proxies {
+			if validators[i].Signer, err = deriveKey(c.Seed, "proxy", i); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	exchangers := make([]*ecdsa.PrivateKey, c.Exchangers)
+	for i := range exchangers {
+		key, err := deriveKey(c.Seed, "exchanger", i)
+		if err != nil {
+			return nil, nil, err
+		}
+		exchangers[i] = key
+	}
+	return validators, exchangers, nil
+}
+
+// genesis generates the Wormholes genesis of the network: the validators pledge
+// their balance with their proxy, if any, and the exchangers stake theirs.
+func (c *Config) genesis(validators []*Validator, exchangers []*ecdsa.PrivateKey) (*core.Genesis, error) {
+	config := *params.DevnetChainConfig
+	config.ChainID = big.NewInt(1337 + c.Seed)
+	istanbulConfig := *config.Istanbul
+	istanbulConfig.EmptyBlock = c.EmptyBlock
+	config.Istanbul = &istanbulConfig
+
+	genesis := &core.Genesis{
+		Config:       &config,
+		GasLimit:     8000000,
+		Difficulty:   big.NewInt(1),
+		Mixhash:      types.IstanbulDigest,
+		Alloc:        make(core.GenesisAlloc),
+		Stake:        make(core.GenesisAlloc),
+		Validator:    make(core.GenesisAlloc),
+		Dir:          types.DefaultDir,
+		InjectNumber: types.DefaultNumber,
+		StartIndex:   big.NewInt(0),
+		Royalty:      types.DefaultRoyalty,
+		Creator:      types.DefaultCreator,
+	}
+	signers := make([]common.Address, 0, len(validators))
+	for _, v := range validators {
+		genesis.Alloc[v.Address()] = core.GenesisAccount{Balance: accountBalance}
+		account := core.GenesisAccount{Balance: validatorBalance}
+		if v.Proxied() {
+			account.Proxy = v.SignerAddress().Hex()
+		}
+		genesis.Validator[v.Address()] = account
+		signers = append(signers, v.SignerAddress())
+	}
+	for i, key := range exchangers {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		genesis.Alloc[addr] = core.GenesisAccount{Balance: accountBalance}
+		genesis.Stake[addr] = core.GenesisAccount{
+			Balance:       exchangerBalance,
+			FeeRate:       100,
+			ExchangerName: fmt.Sprintf("exchanger-%d", i),
+			ExchangerUrl:  fmt.Sprintf("https://exchanger-%d.example", i),
+		}
+	}
+	extra, err := rlp.EncodeToBytes(&types.IstanbulExtra{
+		Validators:    signers,
+		Seal:          []byte{},
+		CommittedSeal: [][]byte{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	genesis.ExtraData = append(make([]byte, types.IstanbulExtraVanity), extra...)
+	return genesis, nil
+}
@@ -0,0 +1,131 @@
+package simulation
+
+import (
+	"bytes"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// delayQueueSize is the number of writes a delayed connection buffers before
+// blocking the writer
+const delayQueueSize = 1024
+
+// linkKey identifies the link between two nodes regardless of its direction
+type linkKey [2]enode.ID
+
+func newLinkKey(a, b enode.ID) linkKey {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return linkKey{a, b}
+}
+
+// links holds the latency of the links between the nodes. The latency is read
+// on every write, changing it affects the established connections too.
+type links struct {
+	delays map[linkKey]time.Duration
+	lock   sync.RWMutex
+}
+
+func newLinks() *links {
+	return &links{delays: make(map[linkKey]time.Duration)}
+}
+
+func (l *links) setDelay(a, b enode.ID, delay time.Duration) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if delay <= 0 {
+		delete(l.delays, newLinkKey(a, b))
+		return
+	}
+	l.delays[newLinkKey(a, b)] = delay
+}
+
+func (l *links) delay(a, b enode.ID) time.Duration {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	return l.delays[newLinkKey(a, b)]
+}
+
+func (l *links) reset() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.delays = make(map[linkKey]time.Duration)
+}
+
+// wrap implements adapters.LinkFunc, both ends of the connection delay the
+// messages they send by the latency of the link.
+func (l *links) wrap(src, dst enode.ID, dialer, listener net.Conn) (net.Conn, net.Conn) {
+	delay := func() time.Duration { return l.delay(src, dst) }
+	return newDelayConn(dialer, delay), newDelayConn(listener, delay)
+}
+
+type delayedWrite struct {
+	data []byte
+	due  time.Time
+}
+
+// delayConn is a connection delivering every write after the latency of its
+// link, in the order of the writes.
+type delayConn struct {
+	net.Conn
+	delay func() time.Duration
+	queue chan delayedWrite
+	quit  chan struct{}
+	once  sync.Once
+}
+
+func newDelayConn(conn net.Conn, delay func() time.Duration) *delayConn {
+	c := &delayConn{
+		Conn:  conn,
+		delay: delay,
+		queue: make(chan delayedWrite, delayQueueSize),
+		quit:  make(chan struct{}),
+	}
+	go c.loop()
+	return c
+}
+
+func (c *delayConn) loop() {
+	for {
+		select {
+		case w := <-c.queue:
+			if wait := time.Until(w.due); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-c.quit:
+					return
+				}
+			}
+			if _, err := c.Conn.Write(w.data); err != nil {
+				c.Close()
+				return
+			}
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// Write queues the data, it's written to the underlying connection after the
+// latency of the link.
+func (c *delayConn) Write(b []byte) (int, error) {
+	w := delayedWrite{data: append([]byte(nil), b...), due: time.Now().Add(c.delay())}
+	select {
+	case c.queue <- w:
+		return len(b), nil
+	case <-c.quit:
+		return 0, net.ErrClosed
+	}
+}
+
+func (c *delayConn) Close() error {
+	c.once.Do(func() { close(c.quit) })
+	return c.Conn.Close()
+}
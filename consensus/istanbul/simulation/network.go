@@ -0,0 +1,339 @@
+// Package simulation runs a Wormholes network of in-process validator nodes,
+// injects network faults in it and checks the consensus keeps its guarantees.
+//
+// The keys, the genesis and the random fault schedules are derived from the
+// seed of the configuration, so a failing run can be replayed with the same
+// seed. The block timestamps follow the wall clock, the block hashes differ
+// between the runs.
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/downloader"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/miniredis"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/simulations"
+	"github.com/ethereum/go-ethereum/p2p/simulations/adapters"
+)
+
+// serviceName is the name of the Wormholes service run by every node
+const serviceName = "wormholes"
+
+var (
+	// errUnknownNode is returned if a node index is out of range.
+	errUnknownNode = errors.New("unknown node")
+
+	// errNotStarted is returned if the network is used before being started.
+	errNotStarted = errors.New("network not started")
+)
+
+// The consensus logs into the miniredis channel, it has to be drained once per
+// process or the validators block on it.
+var drainLogsOnce sync.Once
+
+// Node is a validator node of the network.
+type Node struct {
+	*Validator
+	ID    enode.ID
+	Index int
+
+	sim *adapters.SimNode
+}
+
+// Ethereum returns the Wormholes service of the node.
+func (n *Node) Ethereum() *eth.Ethereum {
+	service, _ := n.sim.Service(serviceName).(*eth.Ethereum)
+	return service
+}
+
+// Head returns the head block of the node.
+func (n *Node) Head() *types.Block {
+	return n.Ethereum().BlockChain().CurrentBlock()
+}
+
+// Network is a simulated network with a node per validator, all the nodes are
+// connected to each other unless a fault separates them.
+type Network struct {
+	config  Config
+	genesis *core.Genesis
+	nodes   []*Node
+
+	adapter *adapters.SimAdapter
+	net     *simulations.Network
+	links   *links
+
+	offline map[int]bool // Nodes disconnected from all the others
+	groups  map[int]int  // Side of the partition of every node, nil if not partitioned
+	started bool
+	lock    sync.Mutex
+}
+
+// New creates a network of a node per validator of the configuration, the
+// nodes don't run until the network is started.
+func New(config Config) (*Network, error) {
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+	validators, exchangers, err := config.accounts()
+	if err != nil {
+		return nil, err
+	}
+	genesis, err := config.genesis(validators, exchangers)
+	if err != nil {
+		return nil, err
+	}
+	n := &Network{
+		config:  config,
+		genesis: genesis,
+		links:   newLinks(),
+		offline: make(map[int]bool),
+	}
+	n.adapter = adapters.NewSimAdapter(adapters.LifecycleConstructors{serviceName: n.newService})
+	n.adapter.SetLink(n.links.wrap)
+	n.net = simulations.NewNetwork(n.adapter, &simulations.NetworkConfig{DefaultService: serviceName})
+
+	for i, v := range validators {
+		conf := adapters.RandomNodeConfig()
+		conf.ID = enode.PubkeyToIDV4(&v.Signer.PublicKey)
+		conf.PrivateKey = v.Signer
+		conf.Name = fmt.Sprintf("validator-%02d", i)
+		conf.Lifecycles = []string{serviceName}
+
+		simNode, err := n.net.NewNodeWithConfig(conf)
+		if err != nil {
+			n.net.Shutdown()
+			return nil, err
+		}
+		n.nodes = append(n.nodes, &Node{
+			Validator: v,
+			ID:        simNode.ID(),
+			Index:     i,
+			sim:       simNode.Node.(*adapters.SimNode),
+		})
+	}
+	return n, nil
+}
+
+// newService creates the Wormholes service of a node. The node key is the
+// signer of the validator, the engine seals with it.
+func (n *Network) newService(ctx *adapters.ServiceContext, stack *node.Node) (node.Lifecycle, error) {
+	config := ethconfig.Defaults
+	config.Genesis = n.genesis
+	config.NetworkId = n.genesis.Config.ChainID.Uint64()
+	config.SyncMode = downloader.FullSync
+	config.Istanbul.BlockPeriod = n.config.BlockPeriod
+	config.Istanbul.RequestTimeout = n.config.RequestTimeout
+	return eth.New(stack, &config)
+}
+
+// Genesis returns the generated genesis of the network.
+func (n *Network) Genesis() *core.Genesis {
+	return n.genesis
+}
+
+// Nodes returns the nodes of the network, in the order of the validators.
+func (n *Network) Nodes() []*Node {
+	return n.nodes
+}
+
+// Node returns the node of the i-th validator.
+func (n *Network) Node(i int) (*Node, error) {
+	if i < 0 || i >= len(n.nodes) {
+		return nil, fmt.Errorf("%w: %d", errUnknownNode, i)
+	}
+	return n.nodes[i], nil
+}
+
+// Start starts all the nodes, connects them to each other and starts sealing.
+func (n *Network) Start() error {
+	drainLogsOnce.Do(func() { miniredis.Newminiredis(false) })
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if err := n.net.StartAll(); err != nil {
+		return err
+	}
+	n.started = true
+	if err := n.applyTopology(); err != nil {
+		return err
+	}
+	for _, node := range n.nodes {
+		if err := node.Ethereum().StartMining(1); err != nil {
+			return err
+		}
+	}
+	log.Info("Started simulated network", "validators", len(n.nodes), "proxies", n.config.Proxies, "exchangers", n.config.Exchangers)
+	return nil
+}
+
+func (n *Network) isStarted() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.started
+}
+
+// Close stops all the nodes of the network.
+func (n *Network) Close() {
+	n.net.Shutdown()
+}
+
+// Offline disconnects the nodes from all the others, their validators don't
+// take part to the consensus until they are back online.
+func (n *Network) Offline(nodes ...int) error {
+	return n.updateTopology(func() error {
+		for _, i := range nodes {
+			if _, err := n.Node(i); err != nil {
+				return err
+			}
+			n.offline[i] = true
+		}
+		return nil
+	})
+}
+
+// Online reconnects offline nodes, they sync the blocks they missed from the
+// others.
+func (n *Network) Online(nodes ...int) error {
+	return n.updateTopology(func() error {
+		for _, i := range nodes {
+			if _, err := n.Node(i); err != nil {
+				return err
+			}
+			delete(n.offline, i)
+		}
+		return nil
+	})
+}
+
+// Partition splits the network, the nodes of a group are only connected to the
+// nodes of the same group. The nodes not in any group form the last side.
+func (n *Network) Partition(groups ...[]int) error {
+	return n.updateTopology(func() error {
+		sides := make(map[int]int)
+		for side, group := range groups {
+			for _, i := range group {
+				if _, err := n.Node(i); err != nil {
+					return err
+				}
+				sides[i] = side + 1
+			}
+		}
+		n.groups = sides
+		return nil
+	})
+}
+
+// Heal removes the partition and the latencies of the links, the offline nodes
+// stay offline.
+func (n *Network) Heal() error {
+	n.links.reset()
+	return n.updateTopology(func() error {
+		n.groups = nil
+		return nil
+	})
+}
+
+// Delay delays the messages the node sends and receives by latency, a zero
+// latency removes the delay.
+func (n *Network) Delay(node int, latency time.Duration) error {
+	target, err := n.Node(node)
+	if err != nil {
+		return err
+	}
+	for _, other := range n.nodes {
+		if other != target {
+			n.links.setDelay(target.ID, other.ID, latency)
+		}
+	}
+	return nil
+}
+
+// updateTopology updates the faults and connects or disconnects the nodes
+// accordingly.
+func (n *Network) updateTopology(update func() error) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if err := update(); err != nil {
+		return err
+	}
+	if !n.started {
+		return nil
+	}
+	return n.applyTopology()
+}
+
+// linked reports whether the faults let the two nodes connect.
+func (n *Network) linked(a, b int) bool {
+	if n.offline[a] || n.offline[b] {
+		return false
+	}
+	return n.groups == nil || n.groups[a] == n.groups[b]
+}
+
+// applyTopology connects the linked nodes and disconnects the others.
+func (n *Network) applyTopology() error {
+	for i, a := range n.nodes {
+		for j := i + 1; j < len(n.nodes); j++ {
+			b := n.nodes[j]
+			conn := n.net.GetConn(a.ID, b.ID)
+			up := conn != nil && conn.Up
+			switch linked := n.linked(i, j); {
+			case linked && !up:
+				if err := n.connect(a, b); err != nil {
+					return err
+				}
+			case !linked && conn != nil:
+				if err := n.disconnect(a, b); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// connect connects two nodes, waiting for the dial ban of the simulation if
+// they were connected recently.
+func (n *Network) connect(a, b *Node) error {
+	for {
+		err := n.net.Connect(a.ID, b.ID)
+		switch {
+		case err == nil:
+			return nil
+		case strings.Contains(err.Error(), "already connected"):
+			return nil
+		case strings.Contains(err.Error(), "recently attempted"):
+			time.Sleep(simulations.DialBanTimeout)
+		default:
+			return err
+		}
+	}
+}
+
+// disconnect removes the nodes from the peers of each other. A connection may
+// still be dialing, so both sides forget the other even if it isn't up.
+func (n *Network) disconnect(a, b *Node) error {
+	for _, pair := range [][2]*Node{{a, b}, {b, a}} {
+		client, err := pair[0].sim.Client()
+		if err != nil {
+			return err
+		}
+		if err := client.Call(nil, "admin_removePeer", string(n.net.GetNode(pair[1].ID).Addr())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package simulation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+)
+
+func TestGenesis(t *testing.T) {
+	config := DefaultConfig
+	validators, exchangers, err := config.accounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := config.genesis(validators, exchangers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(genesis.Validator) != config.Validators || len(genesis.Stake) != config.Exchangers {
+		t.Fatalf("pool size mismatch: have %d validators and %d exchangers", len(genesis.Validator), len(genesis.Stake))
+	}
+	proxies := 0
+	for _, v := range validators {
+		if v.Proxied() {
+			proxies++
+			if have := genesis.Validator[v.Address()].Proxy; have != v.SignerAddress().Hex() {
+				t.Errorf("proxy mismatch of %x: have %s, want %x", v.Address(), have, v.SignerAddress())
+			}
+		}
+	}
+	if proxies != config.Proxies {
+		t.Fatalf("proxies mismatch: have %d, want %d", proxies, config.Proxies)
+	}
+	db := rawdb.NewMemoryDatabase()
+	block := genesis.MustCommit(db)
+	if pool, err := rawdb.ReadValidatorPool(db, block.Hash(), 0); err != nil || len(pool.Validators) != config.Validators {
+		t.Fatalf("validator pool not committed")
+	}
+
+	// The same seed derives the same network
+	again, _, err := config.accounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(again[0].Address(), validators[0].Address()) {
+		t.Fatalf("accounts not derived from the seed")
+	}
+	if _, err := New(Config{Validators: 7, Exchangers: 4}); !errors.Is(err, errTooFewValidators) {
+		t.Fatalf("error mismatch: have %v, want %v", err, errTooFewValidators)
+	}
+}
+
+func TestRandomSchedule(t *testing.T) {
+	schedule := RandomSchedule(1, 11, 20, time.Second)
+	if !reflect.DeepEqual(schedule, RandomSchedule(1, 11, 20, time.Second)) {
+		t.Fatalf("schedule not derived from the seed")
+	}
+	for _, fault := range schedule {
+		if len(fault.Nodes) == 0 || len(fault.Nodes) > 3 {
+			t.Errorf("fault %v affects %d nodes", fault, len(fault.Nodes))
+		}
+		if fault.Duration < time.Second || fault.Duration > 2*time.Second {
+			t.Errorf("fault %v lasts %v", fault, fault.Duration)
+		}
+	}
+}
+
+func TestNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping network simulation in short mode")
+	}
+	network, err := New(DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer network.Close()
+	if err := network.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := network.WaitHeight(3, 30*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	// Five validators offline stall the committee, the others vote empty blocks
+	// and the chain goes on
+	if err := network.Offline(6, 7, 8, 9, 10); err != nil {
+		t.Fatal(err)
+	}
+	number, err := network.WaitEmptyBlock(0, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := network.WaitHeight(number+1, time.Minute, 0, 1, 2, 3, 4, 5); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := network.CheckConsistency(0, 1, 2, 3, 4, 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := network.Online(6, 7, 8, 9, 10); err != nil {
+		t.Fatal(err)
+	}
+	head := network.Nodes()[0].Head().NumberU64()
+	if err := network.WaitHeight(head+2, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	// A schedule of partitions, offline validators and delays keeps the network
+	// live and consistent
+	head = network.Nodes()[0].Head().NumberU64()
+	if err := network.Run(RandomSchedule(DefaultConfig.Seed, DefaultConfig.Validators, 3, 2*time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err := network.WaitHeight(head+3, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	height, err := network.CheckConsistency()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty, _ := network.EmptyBlocks(0, 1, height); len(empty) == 0 {
+		t.Fatalf("no empty block in %d blocks", height)
+	}
+}
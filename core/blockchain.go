@@ -40,6 +40,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
@@ -96,6 +97,10 @@ const (
 
 	WriteStakersFrequency = 10000
 
+	// laggingWeightDivisor divides the weight of the committee members missing
+	// the commit quorum of a block when the next committee is drawn
+	laggingWeightDivisor = 2
+
 	// BlockChainVersion ensures that an incompatible database forces a resync from scratch.
 	//
 	// Changelog:
@@ -1671,6 +1676,16 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	bc.WriteOfficialNFTPool(block.Header(), state.OfficialNFTPool)
 	// write NominatedOfficialNFT
 	bc.WriteNominatedOfficialNFT(block.Header(), state.NominatedOfficialNFT)
+	// write OfficialNFTProposals
+	if state.OfficialNFTProposals != nil {
+		bc.WriteOfficialNFTProposals(block.Header(), state.OfficialNFTProposals)
+	} else {
+		bc.WriteOfficialNFTProposals(block.Header(), bc.ReadOfficialNFTProposals(bc.GetHeaderByHash(block.ParentHash())))
+	}
+
+	// update the index of open exchangers, it must be done before the
+	// ExchangerTokenPool is cleared below
+	bc.WriteExchangerPool(block.Header(), bc.updateExchangerPool(block, state))
 
 	// modify Pledge list
 	//exchangerPool := bc.ReadStakePool(bc.GetHeaderByHash(block.Header().ParentHash))
@@ -1703,24 +1718,8 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 		return NonStatTy, err
 	}
 	log.Info("caver|validator-before", "no", block.Header().Number, "len", validatorPool.Len(), "state.PledgedTokenPool", len(state.PledgedTokenPool))
-	validatorsCoe := make(map[common.Address]uint8)
-	if len(state.PledgedTokenPool) > 0 {
-		for _, v := range state.PledgedTokenPool {
-			if v.Flag {
-				validatorPool.AddValidator(v.Address, v.Amount, v.ProxyAddress)
-			} else {
-				validatorPool.RemoveValidator(v.Address, v.Amount)
-			}
-		}
-		state.PledgedTokenPool = state.PledgedTokenPool[:0]
-	}
-
-	// Recalculate the weight, which needs to be calculated after the list is determined
-	for _, account := range validatorPool.Validators {
-		coefficient := state.GetValidatorCoefficient(account.Addr)
-		validatorsCoe[account.Addr] = coefficient
-		validatorPool.CalculateAddressRangeV2(account.Addr, account.Balance, big.NewInt(int64(coefficient)))
-	}
+	validatorsCoe := applyValidatorPledges(validatorPool, state)
+	state.PledgedTokenPool = state.PledgedTokenPool[:0]
 	bc.cmu.Lock()
 	bc.coefficients[block.NumberU64()] = validatorsCoe
 	bc.cmu.Unlock()
@@ -2078,76 +2077,26 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals bool) (int, er
 		// Process block using the parent state as reference point
 		substart := time.Now()
 
-		var mintDeep *types.MintDeep
-		//var exchangeList *types.SNFTExchangeList
-		if parent.Number.Uint64() > 0 {
-			mintDeep, err = bc.ReadMintDeep(parent)
-			if err != nil {
-				log.Error("Failed get mintdeep ", "err", err)
-				return it.index, err
-			}
-			//exchangeList, _ = bc.ReadSNFTExchangePool(parent)
-			//if exchangeList == nil {
-			//	exchangeList = &types.SNFTExchangeList{
-			//		SNFTExchanges: make([]*types.SNFTExchange, 0),
-			//	}
-			//}
-
-		} else {
-			mintDeep = new(types.MintDeep)
-			//mintDeep.OfficialMint = big.NewInt(1)
-			//
-			//mintDeep.UserMint = big.NewInt(0)
-			//maskB, _ := big.NewInt(0).SetString("8000000000000000000000000000000000000000", 16)
-			//mintDeep.UserMint.Add(big.NewInt(1), maskB)
-			mintDeep.UserMint = big.NewInt(1)
-
-			mintDeep.OfficialMint = big.NewInt(0)
-			maskB, _ := big.NewInt(0).SetString("8000000000000000000000000000000000000000", 16)
-			mintDeep.OfficialMint.Add(big.NewInt(0), maskB)
-
-			//exchangeList = &types.SNFTExchangeList{
-			//	SNFTExchanges: make([]*types.SNFTExchange, 0),
-			//}
-		}
-		statedb.MintDeep = mintDeep
-		//statedb.SNFTExchangePool = exchangeList
-		log.Info("caver|MintDeep", "no", parent.Number.Text(10), "OfficialMint", statedb.MintDeep.OfficialMint.Text(16),
-			"UserMint", statedb.MintDeep.UserMint.Text(16))
-		officialNFTList, _ := bc.ReadOfficialNFTPool(parent)
-		statedb.OfficialNFTPool = officialNFTList
-
-		var nominatedOfficialNFT *types.NominatedOfficialNFT
-		if parent.Number.Uint64() > 0 {
-			nominatedOfficialNFT, err = bc.ReadNominatedOfficialNFT(parent)
-			if err != nil {
-				statedb.NominatedOfficialNFT = nil
-			} else {
-				statedb.NominatedOfficialNFT = nominatedOfficialNFT
-			}
-		} else {
-			nominatedOfficialNFT = new(types.NominatedOfficialNFT)
-			nominatedOfficialNFT.Dir = types.DefaultDir
-			nominatedOfficialNFT.StartIndex = new(big.Int).Set(statedb.OfficialNFTPool.MaxIndex())
-			nominatedOfficialNFT.Number = types.DefaultNumber
-			nominatedOfficialNFT.Royalty = types.DefaultRoyalty
-			nominatedOfficialNFT.Creator = types.DefaultCreator
-			nominatedOfficialNFT.Address = common.Address{}
-			statedb.NominatedOfficialNFT = nominatedOfficialNFT
-		}
-
-		valList, err := bc.ReadValidatorPool(parent)
+		valList, err := bc.LoadWormholesPools(statedb, parent)
 		if err != nil {
-			log.Error("insertChain: invalid validator list", "err", err)
 			return it.index, err
 		}
-		statedb.ValidatorPool = valList.Validators
 
 		emptyBlockErr := bc.VerifyEmptyBlock(block, statedb, valList)
 		if emptyBlockErr != nil {
 			log.Error("insertChain: invalid validators of empty block", "emptyBlockErr", emptyBlockErr)
 			return it.index, emptyBlockErr
 		}
+		if err := VerifyExtraLimits(bc.chainConfig, block.Header(), valList); err != nil {
+			log.Error("insertChain: oversized extra-data", "no", block.Number(), "err", err)
+			return it.index, err
+		}
+		if verifier, ok := bc.engine.(aggregatedSealVerifier); ok {
+			if err := verifier.VerifyAggregatedSeals(block.Header(), valList); err != nil {
+				log.Error("insertChain: invalid aggregated seals", "no", block.Number(), "err", err)
+				return it.index, err
+			}
+		}
 
 		receipts, logs, usedGas, err := bc.processor.Process(block, statedb, bc.vmConfig)
 		if err != nil {
@@ -2181,6 +2130,14 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals bool) (int, er
 			}
 			return it.index, err
 		}
+		if bc.chainConfig.IsCommittee(block.Number()) {
+			if err := bc.verifyValidatorCheckpoint(block.Header(), parent, statedb); err != nil {
+				log.Error("insertChain: invalid validator checkpoint", "no", block.Number(), "err", err)
+				bc.reportBlock(block, receipts, err)
+				atomic.StoreUint32(&followupInterrupt, 1)
+				return it.index, err
+			}
+		}
 		proctime := time.Since(start)
 
 		// Update the metrics touched during block validation
@@ -2254,6 +2211,13 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals bool) (int, er
 	return it.index, err
 }
 
+// aggregatedSealVerifier is implemented by the consensus engines whose headers
+// carry aggregated bls seals, the seals are checked against the bls keys of
+// the validator list of the parent block.
+type aggregatedSealVerifier interface {
+	VerifyAggregatedSeals(header *types.Header, validatorList *types.ValidatorList) error
+}
+
 func (bc *BlockChain) VerifyEmptyBlock(block *types.Block, statedb *state.StateDB, list *types.ValidatorList) error {
 	// if the block is empty block, validate it
 	if block.Coinbase() == common.HexToAddress("0x0000000000000000000000000000000000000000") && block.NumberU64() > 0 {
@@ -2265,58 +2229,115 @@ func (bc *BlockChain) VerifyEmptyBlock(block *types.Block, statedb *state.StateD
 				"header.Time", block.Time())
 			return consensus.ErrFutureBlock
 		}
-		if block.Difficulty().Uint64() != 24 {
+		difficulty := new(big.Int).SetUint64(bc.chainConfig.Istanbul.EmptyBlockAt(block.Number()).GetDifficulty())
+		if block.Difficulty().Cmp(difficulty) != 0 {
 			return errors.New("invalid difficulty of empty block")
 		}
-		istanbulExtra, checkerr := types.ExtractIstanbulExtra(block.Header())
-		if checkerr != nil {
-			log.Error("BlockChain.VerifyEmptyBlock()", "VerifyHeadersAndcheckerr checkerr:", checkerr)
-			return checkerr
+		if err := VerifyEmptyBlockVotes(bc.chainConfig, block.Header(), list, statedb.GetValidatorCoefficient); err != nil {
+			log.Error("BlockChain.VerifyEmptyBlock()", "err", err)
+			return err
 		}
+	}
+	return nil
+}
 
-		var allWeightBalance = big.NewInt(0)
-		var voteBalance *big.Int
-		var coe uint8
-
-		//averageCoefficient := bc.GetAverageCoefficient(statedb)
+// VerifyExtraLimits checks the lengths of the lists of the extra-data of the
+// header after the extra version fork, list is the validator pool of its
+// parent. The engines check the limits known from the header alone.
+func VerifyExtraLimits(config *params.ChainConfig, header *types.Header, list *types.ValidatorList) error {
+	if !config.IsExtraVersion(header.Number) || header.Number.Sign() == 0 {
+		return nil
+	}
+	if list == nil || list.Len() == 0 {
+		return errors.New("unknown validator pool of the parent")
+	}
+	extra, err := types.ExtractWormholesExtra(header)
+	if err != nil {
+		return err
+	}
+	limits := types.IstanbulExtraLimits{Committee: types.IstanbulCommitteeSize, Pool: list.Len()}
+	return extra.CheckLimits(limits, header.Coinbase == (common.Address{}))
+}
 
-		for _, validator := range statedb.ValidatorPool {
-			coe = statedb.GetValidatorCoefficient(validator.Addr)
-			voteBalance = new(big.Int).Mul(validator.Balance, big.NewInt(int64(coe)))
-			allWeightBalance.Add(allWeightBalance, voteBalance)
-		}
-		allWeightBalance50 := new(big.Int).Mul(big.NewInt(50), allWeightBalance)
-		allWeightBalance50 = new(big.Int).Div(allWeightBalance50, big.NewInt(100))
+// VerifyEmptyBlockVotes checks that the voters of the empty block weigh more
+// than the configured percentage of the validators in list, the validator pool
+// of its parent, weighted with their coefficients.
+func VerifyEmptyBlockVotes(config *params.ChainConfig, header *types.Header, list *types.ValidatorList, coefficient func(common.Address) uint8) error {
+	emptyBlockConfig := config.Istanbul.EmptyBlockAt(header.Number)
+	istanbulExtra, err := types.ExtractWormholesExtra(header)
+	if err != nil {
+		return err
+	}
 
-		var validators []common.Address
-		for _, emptyBlockMessage := range istanbulExtra.EmptyBlockMessages[1:] {
-			msg := &types.EmptyMsg{}
-			sender, err := msg.RecoverAddress(emptyBlockMessage)
-			if err != nil {
-				return err
-			}
-			validators = append(validators, sender)
-		}
+	var allWeightBalance = big.NewInt(0)
+	for _, validator := range list.Validators {
+		voteBalance := new(big.Int).Mul(validator.Balance, big.NewInt(int64(coefficient(validator.Addr))))
+		allWeightBalance.Add(allWeightBalance, voteBalance)
+	}
+	allWeightBalance50 := new(big.Int).Mul(new(big.Int).SetUint64(emptyBlockConfig.GetVotePercent()), allWeightBalance)
+	allWeightBalance50 = new(big.Int).Div(allWeightBalance50, big.NewInt(100))
 
-		var blockWeightBalance = big.NewInt(0)
-		for _, v := range validators {
-			//coe = statedb.GetValidatorCoefficient(list.GetValidatorAddr(v))
-			//voteBalance = new(big.Int).Mul(list.StakeBalance(v), big.NewInt(int64(coe)))
-			voteBalance = new(big.Int).Mul(list.StakeBalance(v), big.NewInt(types.DEFAULT_VALIDATOR_COEFFICIENT))
-			//voteBalance.Div(voteBalance, big.NewInt(10))
-			blockWeightBalance.Add(blockWeightBalance, voteBalance)
+	if len(istanbulExtra.EmptyBlockMessages) == 0 {
+		return errors.New("empty block without messages")
+	}
+	var validators []common.Address
+	for _, emptyBlockMessage := range istanbulExtra.EmptyBlockMessages[1:] {
+		msg := &types.EmptyMsg{}
+		sender, err := msg.RecoverAddress(emptyBlockMessage)
+		if err != nil {
+			return err
 		}
-		if blockWeightBalance.Cmp(allWeightBalance50) > 0 {
-			return nil
-		} else {
-			log.Error("BlockChain.VerifyEmptyBlock(), verify validators of empty block error ",
-				"blockWeightBalance", blockWeightBalance, "allWeightBalance50", allWeightBalance50)
-			return errors.New("verify validators of empty block error")
+		validators = append(validators, sender)
+	}
+	if istanbulExtra.EmptyBlockAggregatedSeal != nil {
+		voters, err := verifyEmptyBlockAggregatedSeal(config, header, istanbulExtra.EmptyBlockAggregatedSeal, list)
+		if err != nil {
+			return err
 		}
+		validators = append(validators, voters...)
+	}
+
+	var blockWeightBalance = big.NewInt(0)
+	for _, v := range validators {
+		voteBalance := new(big.Int).Mul(list.StakeBalance(v), big.NewInt(types.DEFAULT_VALIDATOR_COEFFICIENT))
+		blockWeightBalance.Add(blockWeightBalance, voteBalance)
+	}
+	if blockWeightBalance.Cmp(allWeightBalance50) <= 0 {
+		log.Error("BlockChain.VerifyEmptyBlock(), verify validators of empty block error ",
+			"blockWeightBalance", blockWeightBalance, "allWeightBalance50", allWeightBalance50)
+		return errors.New("verify validators of empty block error")
 	}
 	return nil
 }
 
+// verifyEmptyBlockAggregatedSeal checks the aggregated bls signature of the
+// votes for an empty block, the bitmap of the seal refers to the validators of
+// list. It returns the voters.
+func verifyEmptyBlockAggregatedSeal(config *params.ChainConfig, header *types.Header, seal *types.AggregatedSeal, list *types.ValidatorList) ([]common.Address, error) {
+	if !config.IsBLS(header.Number) {
+		return nil, errors.New("aggregated votes of empty block before bls fork")
+	}
+	indices, err := seal.Indices(list.Len())
+	if err != nil {
+		return nil, err
+	}
+	voters := make([]common.Address, 0, len(indices))
+	pubKeys := make([][]byte, 0, len(indices))
+	for _, i := range indices {
+		validator := list.Validators[i]
+		if validator.BLSPubKey == nil {
+			return nil, errors.New("voter of empty block without bls key")
+		}
+		voters = append(voters, validator.Addr)
+		pubKeys = append(pubKeys, validator.BLSPubKey)
+	}
+	if !bls.VerifyAggregate(pubKeys, types.EmptyBlockVoteHash(header.Number), seal.Signature) {
+		log.Error("BlockChain.VerifyEmptyBlock(), invalid aggregated votes", "no", header.Number)
+		return nil, errors.New("invalid aggregated votes of empty block")
+	}
+	return voters, nil
+}
+
 func (w *BlockChain) GetAverageCoefficient(statedb *state.StateDB) uint64 {
 	var total = big.NewInt(0)
 	var maxTotal = big.NewInt(0)
@@ -2942,6 +2963,53 @@ func (bc *BlockChain) WriteDBStakerPool(header *types.Header, dbStakePool *types
 	}
 }
 
+// updateExchangerPool returns the index of open exchangers after block, the
+// exchangers which were opened, closed or changed their stake in the block are
+// looked up in state, and the trade volume brokered in the block is added.
+func (bc *BlockChain) updateExchangerPool(block *types.Block, state *state.StateDB) *types.ExchangerList {
+	exchangerPool := bc.ReadExchangerPool(bc.GetHeaderByHash(block.ParentHash()))
+	if exchangerPool == nil {
+		// the parent was written before the index existed, rebuild it from
+		// the stakers which are all the exchangers holding a stake.
+		exchangerPool = new(types.ExchangerList)
+		for _, staker := range bc.stakerPool.Stakers {
+			if state.GetExchangerFlag(staker.Address()) {
+				exchangerPool.AddExchanger(staker.Address(), state.GetOpenExchangerTime(staker.Address()))
+			}
+		}
+	}
+	for _, v := range state.ExchangerTokenPool {
+		if state.GetExchangerFlag(v.Address) {
+			exchangerPool.AddExchanger(v.Address, block.Number())
+		} else {
+			exchangerPool.RemoveExchanger(v.Address)
+		}
+	}
+	for _, v := range state.ExchangerVolumePool {
+		exchangerPool.AddVolume(v.Address, v.Amount)
+	}
+	state.ExchangerVolumePool = state.ExchangerVolumePool[:0]
+	return exchangerPool
+}
+
+// ReadExchangerPool returns the index of open exchangers at header, or nil if
+// it isn't stored.
+func (bc *BlockChain) ReadExchangerPool(header *types.Header) *types.ExchangerList {
+	if header == nil {
+		return nil
+	}
+	exchangerPool, _ := rawdb.ReadExchangerPool(bc.db, header.Hash(), header.Number.Uint64())
+	return exchangerPool
+}
+
+func (bc *BlockChain) WriteExchangerPool(header *types.Header, exchangerPool *types.ExchangerList) {
+	poolBatch := bc.db.NewBatch()
+	rawdb.WriteExchangerPool(poolBatch, header.Hash(), header.Number.Uint64(), exchangerPool)
+	if err := poolBatch.Write(); err != nil {
+		log.Crit("Failed to write exchangerPool disk", "err", err)
+	}
+}
+
 func (bc *BlockChain) WriteValidatorPool(header *types.Header, validatorPool *types.ValidatorList) {
 	poolBatch := bc.db.NewBatch()
 	rawdb.WriteValidatorPool(poolBatch, header.Hash(), header.Number.Uint64(), validatorPool)
@@ -2950,6 +3018,67 @@ func (bc *BlockChain) WriteValidatorPool(header *types.Header, validatorPool *ty
 	}
 }
 
+// applyValidatorPledges applies the pledges of the block executed on state to
+// validatorPool, the pool of its parent, and recalculates the address ranges
+// of the validators. It returns the coefficients the ranges are weighted with.
+func applyValidatorPledges(validatorPool *types.ValidatorList, state *state.StateDB) map[common.Address]uint8 {
+	for _, v := range state.PledgedTokenPool {
+		if len(v.BLSPubKey) > 0 {
+			validatorPool.SetBLSPubKey(v.Address, v.BLSPubKey)
+			continue
+		}
+		if v.Flag {
+			validatorPool.AddValidator(v.Address, v.Amount, v.ProxyAddress)
+		} else {
+			validatorPool.RemoveValidator(v.Address, v.Amount)
+		}
+	}
+
+	// Recalculate the weight, which needs to be calculated after the list is determined
+	validatorsCoe := make(map[common.Address]uint8)
+	for _, account := range validatorPool.Validators {
+		coefficient := state.GetValidatorCoefficient(account.Addr)
+		validatorsCoe[account.Addr] = coefficient
+		validatorPool.CalculateAddressRangeV2(account.Addr, account.Balance, big.NewInt(int64(coefficient)))
+	}
+	return validatorsCoe
+}
+
+// NextValidatorCheckpoint returns the checkpoint of the validator pool left by
+// the block on top of parent executed on state, without modifying state.
+func (bc *BlockChain) NextValidatorCheckpoint(parent *types.Header, state *state.StateDB) (*types.ValidatorCheckpoint, error) {
+	validatorPool, err := bc.ReadValidatorPool(parent)
+	if err != nil {
+		return nil, err
+	}
+	coefficients := applyValidatorPledges(validatorPool, state)
+	return types.NewValidatorCheckpoint(validatorPool, coefficients), nil
+}
+
+// verifyValidatorCheckpoint checks the validator checkpoint the header commits
+// to against the validator pool left by the block executed on state.
+func (bc *BlockChain) verifyValidatorCheckpoint(header *types.Header, parent *types.Header, state *state.StateDB) error {
+	extra, err := types.ExtractWormholesExtra(header)
+	if err != nil {
+		return err
+	}
+	checkpoint, err := bc.NextValidatorCheckpoint(parent, state)
+	if err != nil {
+		return err
+	}
+	if hash := checkpoint.Hash(); extra.ValidatorsHash != hash {
+		return fmt.Errorf("invalid validators hash (remote: %x local: %x)", extra.ValidatorsHash, hash)
+	}
+	if header.Number.Uint64()%params.ValidatorCheckpointInterval == 0 {
+		if extra.Checkpoint == nil || extra.Checkpoint.Hash() != extra.ValidatorsHash {
+			return errors.New("invalid validator checkpoint")
+		}
+	} else if extra.Checkpoint != nil {
+		return errors.New("unexpected validator checkpoint")
+	}
+	return nil
+}
+
 func (bc *BlockChain) ReadValidatorPool(header *types.Header) (*types.ValidatorList, error) {
 	if header == nil {
 		return nil, errors.New("ReadValidatorPool : invalid header")
@@ -2991,22 +3120,23 @@ func (bc *BlockChain) Random11ValidatorFromPool(header *types.Header) (*types.Va
 		return nil, err
 	}
 
-	// Obtain random landing points according to the surrounding chain algorithm
-	randomHash := GetRandomDrop(validatorList, header)
-	if randomHash == (common.Hash{}) {
-		log.Error("Random11ValidatorFromPool : invalid random hash", "no", bc.CurrentHeader().Number.Uint64())
+	// Get the weights of all validators
+	weights, err := bc.committeeWeights(header, validatorList)
+	if err != nil {
+		log.Error("Random11ValidatorFromPool invalid weights", "no", header.Number.Uint64(), "err", err)
 		return nil, err
 	}
-	log.Info("Random11ValidatorFromPool : drop", "no", header.Number.Uint64(), "randomHash", randomHash.Hex(), "header.hash", header.Hash().Hex())
 
-	// Get the weights of all validators
+	return SelectCommittee(validatorList, weights, header)
+}
+
+// committeeWeights returns the weights the validators of validatorList, the
+// validator pool left by header, are drawn with into the next committee
+func (bc *BlockChain) committeeWeights(header *types.Header, validatorList *types.ValidatorList) ([]uint8, error) {
 	db, err := bc.StateAt(header.Root)
 	if err != nil {
-		log.Error("Random11ValidatorFromPool invalid root", "no", header.Number.Uint64())
-		return nil, errors.New("Random11ValidatorFromPool invalid root")
+		return nil, errors.New("committeeWeights invalid root")
 	}
-
-	// Get all validator weights
 	var weights []uint8
 	bc.cmu.Lock()
 	if validatorsCoe, ok := bc.coefficients[header.Number.Uint64()]; ok {
@@ -3020,8 +3150,95 @@ func (bc *BlockChain) Random11ValidatorFromPool(header *types.Header) (*types.Va
 	}
 	bc.cmu.Unlock()
 
-	var validators []common.Address
-	validators, err = validatorList.RandomValidatorV4(11, randomHash, weights)
+	if bc.chainConfig.IsLagging(header.Number) && header.Number.Sign() > 0 {
+		parent := bc.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+		if parent == nil {
+			return nil, consensus.ErrUnknownAncestor
+		}
+		signer, ok := bc.engine.(RewardSigner)
+		if !ok {
+			return nil, errors.New("committeeWeights: engine can't recover the reward seals")
+		}
+		lagging, err := LaggingValidators(validatorList, parent, header, signer)
+		if err != nil {
+			return nil, err
+		}
+		DeprioritizeLagging(validatorList, weights, lagging)
+	}
+	return weights, nil
+}
+
+// RewardSigner is implemented by the consensus engines whose headers prove the
+// committers of the last normal block with copies of its committed seals.
+type RewardSigner interface {
+	// RewardSigners returns the committers of preHeader proven by the reward
+	// seals of header.
+	RewardSigners(preHeader, header *types.Header) ([]common.Address, error)
+}
+
+// LaggingValidators returns the members of the committee of parent whose
+// committed seals are not copied into the reward seals of header, the block
+// after it. Unlike the message latencies every node observes on its own, the
+// reward seals are agreed on and so can weigh in the committee selection. All
+// the seals count, not only the quorum rewarded out of them.
+func LaggingValidators(validatorList *types.ValidatorList, parent, header *types.Header, signer RewardSigner) (map[common.Address]bool, error) {
+	lagging := make(map[common.Address]bool)
+	// Empty blocks have neither a committee nor rewards
+	if parent.Coinbase == (common.Address{}) || header.Coinbase == (common.Address{}) {
+		return lagging, nil
+	}
+	parentExtra, err := types.ExtractWormholesExtra(parent)
+	if err != nil {
+		return nil, err
+	}
+	committers, err := signer.RewardSigners(parent, header)
+	if err != nil {
+		return nil, err
+	}
+	// The committee and the seals are made of the proxies of the validators
+	// that have one
+	committed := make(map[common.Address]bool, len(committers))
+	for _, addr := range committers {
+		committed[validatorList.GetValidatorAddr(addr)] = true
+	}
+	for _, member := range parentExtra.Validators {
+		addr := validatorList.GetValidatorAddr(member)
+		if addr != (common.Address{}) && !committed[addr] {
+			lagging[addr] = true
+		}
+	}
+	return lagging, nil
+}
+
+// DeprioritizeLagging divides the weights of the lagging validators of
+// validatorList by laggingWeightDivisor and recalculates their address ranges.
+func DeprioritizeLagging(validatorList *types.ValidatorList, weights []uint8, lagging map[common.Address]bool) {
+	for i, v := range validatorList.Validators {
+		if !lagging[v.Addr] {
+			continue
+		}
+		weights[i] /= laggingWeightDivisor
+		if weights[i] == 0 {
+			weights[i] = 1
+		}
+		validatorList.CalculateAddressRangeV2(v.Addr, v.Balance, big.NewInt(int64(weights[i])))
+	}
+}
+
+// SelectCommittee draws the committee of the block after header out of
+// validatorList, the validator pool left by header, with the stake of the
+// validators weighted with weights. The proxies of the validators are returned
+// in their place as they sign for them.
+func SelectCommittee(validatorList *types.ValidatorList, weights []uint8, header *types.Header) (*types.ValidatorList, error) {
+	// Obtain random landing points according to the surrounding chain algorithm
+	randomHash := GetRandomDrop(validatorList, header)
+	if randomHash == (common.Hash{}) {
+		log.Error("Random11ValidatorFromPool : invalid random hash", "no", header.Number.Uint64())
+		return nil, errors.New("Random11ValidatorFromPool invalid random hash")
+	}
+	log.Info("Random11ValidatorFromPool : drop", "no", header.Number.Uint64(), "randomHash", randomHash.Hex(), "header.hash", header.Hash().Hex())
+
+	validators, err := validatorList.RandomValidatorV4(11, randomHash, weights)
 	if err != nil {
 		log.Error("Random11ValidatorFromPool err", "err", err.Error())
 		return nil, errors.New("Random11ValidatorFromPool failed pick validators")
@@ -3074,25 +3291,11 @@ func (bc *BlockChain) Random11ValidatorWithOutProxy(header *types.Header) (*type
 	log.Info("Random11ValidatorWithOutProxy : drop", "no", header.Number.Uint64(), "randomHash", randomHash.Hex(), "header.hash", header.Hash().Hex())
 
 	// Get the weights of all validators
-	db, err := bc.StateAt(header.Root)
+	weights, err := bc.committeeWeights(header, validatorList)
 	if err != nil {
-		log.Error("Random11ValidatorWithOutProxy invalid root", "no", header.Number.Uint64())
-		return nil, errors.New("Random11ValidatorWithOutProxy invalid root")
-	}
-
-	// Get all validator weights
-	var weights []uint8
-	bc.cmu.Lock()
-	if validatorsCoe, ok := bc.coefficients[header.Number.Uint64()]; ok {
-		for _, v := range validatorList.Validators {
-			weights = append(weights, validatorsCoe[v.Addr])
-		}
-	} else {
-		for _, v := range validatorList.Validators {
-			weights = append(weights, db.GetCoefficient(v.Addr))
-		}
+		log.Error("Random11ValidatorWithOutProxy invalid weights", "no", header.Number.Uint64(), "err", err)
+		return nil, err
 	}
-	bc.cmu.Unlock()
 
 	var validators []common.Address
 	validators, err = validatorList.RandomValidatorV4(11, randomHash, weights)
@@ -3123,6 +3326,80 @@ func (bc *BlockChain) RandomNValidatorFromEleven(amount int, elevenValidator *ty
 	//return elevenValidator.RandomValidatorV2(amount, parentHash)
 }
 
+// LoadWormholesPools loads the wormholes pools stored at header into statedb,
+// they are the pools the transactions of the children of header execute on.
+// The validator pool at header is returned.
+func (bc *BlockChain) LoadWormholesPools(statedb *state.StateDB, parent *types.Header) (*types.ValidatorList, error) {
+	var err error
+	var mintDeep *types.MintDeep
+	//var exchangeList *types.SNFTExchangeList
+	if parent.Number.Uint64() > 0 {
+		mintDeep, err = bc.ReadMintDeep(parent)
+		if err != nil {
+			log.Error("Failed get mintdeep ", "err", err)
+			return nil, err
+		}
+		//exchangeList, _ = bc.ReadSNFTExchangePool(parent)
+		//if exchangeList == nil {
+		//	exchangeList = &types.SNFTExchangeList{
+		//		SNFTExchanges: make([]*types.SNFTExchange, 0),
+		//	}
+		//}
+
+	} else {
+		mintDeep = new(types.MintDeep)
+		//mintDeep.OfficialMint = big.NewInt(1)
+		//
+		//mintDeep.UserMint = big.NewInt(0)
+		//maskB, _ := big.NewInt(0).SetString("8000000000000000000000000000000000000000", 16)
+		//mintDeep.UserMint.Add(big.NewInt(1), maskB)
+		mintDeep.UserMint = big.NewInt(1)
+
+		mintDeep.OfficialMint = big.NewInt(0)
+		maskB, _ := big.NewInt(0).SetString("8000000000000000000000000000000000000000", 16)
+		mintDeep.OfficialMint.Add(big.NewInt(0), maskB)
+
+		//exchangeList = &types.SNFTExchangeList{
+		//	SNFTExchanges: make([]*types.SNFTExchange, 0),
+		//}
+	}
+	statedb.MintDeep = mintDeep
+	//statedb.SNFTExchangePool = exchangeList
+	log.Info("caver|MintDeep", "no", parent.Number.Text(10), "OfficialMint", statedb.MintDeep.OfficialMint.Text(16),
+		"UserMint", statedb.MintDeep.UserMint.Text(16))
+	officialNFTList, _ := bc.ReadOfficialNFTPool(parent)
+	statedb.OfficialNFTPool = officialNFTList
+
+	var nominatedOfficialNFT *types.NominatedOfficialNFT
+	if parent.Number.Uint64() > 0 {
+		nominatedOfficialNFT, err = bc.ReadNominatedOfficialNFT(parent)
+		if err != nil {
+			statedb.NominatedOfficialNFT = nil
+		} else {
+			statedb.NominatedOfficialNFT = nominatedOfficialNFT
+		}
+	} else {
+		nominatedOfficialNFT = new(types.NominatedOfficialNFT)
+		nominatedOfficialNFT.Dir = types.DefaultDir
+		nominatedOfficialNFT.StartIndex = new(big.Int).Set(statedb.OfficialNFTPool.MaxIndex())
+		nominatedOfficialNFT.Number = types.DefaultNumber
+		nominatedOfficialNFT.Royalty = types.DefaultRoyalty
+		nominatedOfficialNFT.Creator = types.DefaultCreator
+		nominatedOfficialNFT.Address = common.Address{}
+		statedb.NominatedOfficialNFT = nominatedOfficialNFT
+	}
+
+	statedb.OfficialNFTProposals = bc.ReadOfficialNFTProposals(parent)
+
+	valList, err := bc.ReadValidatorPool(parent)
+	if err != nil {
+		log.Error("LoadWormholesPools: invalid validator list", "no", parent.Number, "err", err)
+		return nil, err
+	}
+	statedb.ValidatorPool = valList.Validators
+	return valList, nil
+}
+
 // WriteMintDeep writes mintdeep to chaindb
 func (bc *BlockChain) WriteMintDeep(header *types.Header, mintDeep *types.MintDeep) {
 	mintDeepBatch := bc.db.NewBatch()
@@ -3172,6 +3449,27 @@ func (bc *BlockChain) ReadNominatedOfficialNFT(header *types.Header) (*types.Nom
 	return rawdb.ReadNominatedOfficialNFT(bc.db, header.Hash(), header.Number.Uint64())
 }
 
+func (bc *BlockChain) WriteOfficialNFTProposals(header *types.Header, proposals *types.OfficialNFTProposalList) {
+	poolBatch := bc.db.NewBatch()
+	rawdb.WriteOfficialNFTProposals(poolBatch, header.Hash(), header.Number.Uint64(), proposals)
+	if err := poolBatch.Write(); err != nil {
+		log.Crit("Failed to write OfficialNFTProposals disk", "err", err)
+	}
+}
+
+// ReadOfficialNFTProposals returns the official nft proposals at header, an
+// empty list is returned if none are stored.
+func (bc *BlockChain) ReadOfficialNFTProposals(header *types.Header) *types.OfficialNFTProposalList {
+	if header == nil {
+		return types.NewOfficialNFTProposalList()
+	}
+	proposals, err := rawdb.ReadOfficialNFTProposals(bc.db, header.Hash(), header.Number.Uint64())
+	if err != nil {
+		return types.NewOfficialNFTProposalList()
+	}
+	return proposals
+}
+
 func (bc *BlockChain) QueryMinerProxy(ctx context.Context, number int64, minerAddress *common.Address) (*types.ValidatorList, error) {
 	log.Info("QueryMinerProxy", "number", number, "minerAddress", minerAddress.Hex())
 	vList, err := bc.ReadValidatorPool(bc.GetHeaderByNumber(uint64(number)))
@@ -111,6 +111,17 @@ func (b *BlockGen) AddTxWithChain(bc *BlockChain, tx *types.Transaction) {
 	b.receipts = append(b.receipts, receipt)
 }
 
+// LoadWormholesPools loads the wormholes pools of the parent block from bc
+// into the state of the generated block, so that the wormholes transactions
+// added afterwards and the official rewards of the engine can be executed.
+//
+// LoadWormholesPools panics if the pools of the parent block can not be read.
+func (b *BlockGen) LoadWormholesPools(bc *BlockChain) {
+	if _, err := bc.LoadWormholesPools(b.statedb, b.parent.Header()); err != nil {
+		panic(err)
+	}
+}
+
 // GetBalance returns the balance of the given address at the generated block.
 func (b *BlockGen) GetBalance(addr common.Address) *big.Int {
 	return b.statedb.GetBalance(addr)
@@ -25,6 +25,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/log"
 	"golang.org/x/crypto/sha3"
 
@@ -38,7 +39,7 @@ var ErrRecoverAddress = errors.New("recover ExchangerAuth error")
 var ErrNotMatchAddress = errors.New("recovered address not match exchanger owner")
 
 const InjectRewardRate = 1000 // InjectRewardRate is 10%
-var InjectRewardAddress = common.HexToAddress("0xFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF")
+var InjectRewardAddress = types.InjectRewardAddress
 var DiscardAddress = common.HexToAddress("0x0000000000000000000000000000000000000000")
 
 const VALIDATOR_COEFFICIENT = 70
@@ -135,6 +136,8 @@ func NewEVMBlockContext(header *types.Header, chain ChainContext, author *common
 		VerifyExchangerBalance:             VerifyExchangerBalance,
 		GetNftAddressAndLevel:              GetNftAddressAndLevel,
 		VoteOfficialNFT:                    VoteOfficialNFT,
+		ProposeOfficialNFT:                 ProposeOfficialNFT,
+		VoteOfficialNFTProposal:            VoteOfficialNFTProposal,
 		ElectNominatedOfficialNFT:          ElectNominatedOfficialNFT,
 		NextIndex:                          NextIndex,
 		VoteOfficialNFTByApprovedExchanger: VoteOfficialNFTByApprovedExchanger,
@@ -146,6 +149,16 @@ func NewEVMBlockContext(header *types.Header, chain ChainContext, author *common
 		//GetNFTPledgedBlockNumber:    GetNFTPledgedBlockNumber,
 		RecoverValidatorCoefficient:           RecoverValidatorCoefficient,
 		BatchForcedSaleSNFTByApproveExchanger: BatchForcedSaleSNFTByApproveExchanger,
+		UpdateNFTMetaURL:                      UpdateNFTMetaURL,
+		FreezeNFTMetaURL:                      FreezeNFTMetaURL,
+		UpdateExchanger:                       UpdateExchanger,
+		SetCollectionFeeRate:                  SetCollectionFeeRate,
+		AddAllowedCreator:                     AddAllowedCreator,
+		RemoveAllowedCreator:                  RemoveAllowedCreator,
+		FractionalizeSNFT:                     FractionalizeSNFT,
+		RedeemSNFT:                            RedeemSNFT,
+		TransferSNFTShares:                    TransferSNFTShares,
+		RegisterBLSPubKey:                     RegisterBLSPubKey,
 	}
 }
 
@@ -251,7 +264,7 @@ func TransferNFT(db vm.StateDB, nftAddr string, newOwner common.Address, blocknu
 
 	level2 := db.GetNFTMergeLevel(address)
 	if level != int(level2) {
-		return errors.New("not exist nft")
+		return vm.ErrNotExistNft
 	}
 
 	//pledgedFlag := db.GetPledgedFlag(address)
@@ -414,6 +427,66 @@ func GetNFTMetaURL(db vm.StateDB, addr common.Address) string {
 	return db.GetNFTMetaURL(addr)
 }
 
+func UpdateNFTMetaURL(db vm.StateDB, nftAddr common.Address, metaurl string, blocknumber *big.Int) {
+	db.UpdateNFTMetaURL(nftAddr, metaurl, blocknumber)
+}
+
+func FreezeNFTMetaURL(db vm.StateDB, nftAddr common.Address, blocknumber *big.Int) {
+	db.FreezeNFTMetaURL(nftAddr, blocknumber)
+}
+
+func UpdateExchanger(db vm.StateDB, addr common.Address, update *types.ExchangerUpdate, blocknumber *big.Int, noticeinterval uint64) {
+	db.UpdateExchanger(addr, update, blocknumber, noticeinterval)
+}
+
+func SetCollectionFeeRate(db vm.StateDB, addr common.Address, creator common.Address, feerate uint16) {
+	db.SetCollectionFeeRate(addr, creator, feerate)
+}
+
+func AddAllowedCreator(db vm.StateDB, addr common.Address, creator common.Address) bool {
+	return db.AddAllowedCreator(addr, creator)
+}
+
+func RemoveAllowedCreator(db vm.StateDB, addr common.Address, creator common.Address) bool {
+	return db.RemoveAllowedCreator(addr, creator)
+}
+
+func FractionalizeSNFT(db vm.StateDB, owner common.Address, nftAddr common.Address) {
+	db.FractionalizeSNFT(owner, nftAddr)
+}
+
+func RedeemSNFT(db vm.StateDB, holder common.Address, nftAddr common.Address, blocknumber *big.Int) bool {
+	return db.RedeemSNFT(holder, nftAddr, blocknumber)
+}
+
+func TransferSNFTShares(db vm.StateDB, from common.Address, to common.Address, nftAddr common.Address, amount *big.Int) bool {
+	return db.TransferSNFTShares(from, to, nftAddr, amount)
+}
+
+// RegisterBLSPubKey registers the bls public key of the validator address
+// after checking the proof of possession of the key.
+func RegisterBLSPubKey(db vm.StateDB, address common.Address, pubKey []byte, proof []byte) error {
+	if !bls.VerifyPossession(pubKey, proof) {
+		return vm.ErrInvalidBLSProof
+	}
+	return db.RegisterBLSPubKey(address, pubKey)
+}
+
+// GetExchangerFeeRate returns the fee rate the exchanger charges for the nfts of
+// creator, and an error if the exchanger doesn't broker nfts of creator. Before
+// the exchanger settings fork the fee rate of the exchanger applies to all nfts.
+func GetExchangerFeeRate(db vm.StateDB, exchanger common.Address, creator common.Address, blocknumber *big.Int, exchangerSettings bool) (uint16, error) {
+	if !exchangerSettings {
+		return db.GetFeeRate(exchanger), nil
+	}
+	if !db.IsAllowedCreator(exchanger, creator) {
+		log.Error("GetExchangerFeeRate()", "exchanger", exchanger.String(), "creator", creator.String(),
+			"error", vm.ErrCreatorNotAllowed)
+		return 0, vm.ErrCreatorNotAllowed
+	}
+	return db.GetExchangerFeeRate(exchanger, creator, blocknumber), nil
+}
+
 func IsExistNFT(db vm.StateDB, addr common.Address) bool {
 	return db.IsExistNFT(addr)
 }
@@ -480,7 +553,8 @@ func BuyNFTBySellerOrExchanger(
 	caller common.Address,
 	to common.Address,
 	wormholes *types.Wormholes,
-	amount *big.Int) error {
+	amount *big.Int,
+	exchangerSettings bool) error {
 
 	//1. recover buyer's address
 	msg := wormholes.Buyer.Amount +
@@ -516,14 +590,14 @@ func BuyNFTBySellerOrExchanger(
 	if blocknumber.Cmp(buyerBlockNumber) > 0 {
 		log.Error("BuyNFTBySellerOrExchanger(), buyer's data is expired!",
 			"buyerBlockNumber", buyerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("buyer's data is expired!")
+		return vm.ErrBuyerDataExpired
 	}
 	//3. check buyer's address and to, return error if they are not same.
 	if to != buyer {
 		toS := to.String()
 		buyerS := buyer.String()
 		log.Error("BuyNFTBySellerOrExchanger(), to of the tx is not buyer!", "to", toS, "buyer", buyerS)
-		return errors.New("to of the tx is not buyer!")
+		return vm.ErrNotBuyer
 	}
 	//4. return error if the amount that sender send is not equal buyer's amount.
 	if !strings.HasPrefix(wormholes.Buyer.Amount, "0x") &&
@@ -539,7 +613,7 @@ func BuyNFTBySellerOrExchanger(
 	if amount.Cmp(buyerAmount) != 0 {
 		log.Error("BuyNFTBySellerOrExchanger(), tx amount error",
 			"buyerAmount", buyerAmount.Text(16), "amount", amount.Text(16))
-		return errors.New("tx amount error")
+		return vm.ErrTxAmount
 	}
 
 	//5. check if the sender is or not the owner of nft.
@@ -553,7 +627,7 @@ func BuyNFTBySellerOrExchanger(
 	if int(level2) != level {
 		log.Error("BuyNFTBySellerOrExchanger()", "wormholes.Type", wormholes.Type, "nft address", wormholes.Buyer.NFTAddress,
 			"input nft level", level, "real nft level", level2)
-		return errors.New("not exist nft")
+		return vm.ErrNotExistNft
 	}
 	//pledgedFlag := db.GetPledgedFlag(nftAddress)
 	//if pledgedFlag {
@@ -564,13 +638,13 @@ func BuyNFTBySellerOrExchanger(
 	emptyAddress := common.Address{}
 	if nftOwner == emptyAddress {
 		log.Error("BuyNFTBySellerOrExchanger(), Get nft owner error!")
-		return errors.New("Get nft owner error!")
+		return vm.ErrGetNFTOwner
 	}
 	buyerBalance := db.GetBalance(buyer)
 	if buyerBalance.Cmp(amount) < 0 {
 		log.Error("BuyNFTBySellerOrExchanger(), insufficient balance",
 			"buyerBalance", buyerBalance.Text(16), "amount", amount.Text(16))
-		return errors.New("insufficient balance")
+		return vm.ErrInsufficientBuyerBalance
 	}
 	//5.1 check nft has exclusive exchanger, if have，need to check exclusive exchanger and sender when sender is a exchanger，
 	//return error if they are not same
@@ -611,13 +685,16 @@ func BuyNFTBySellerOrExchanger(
 		}
 	} else {
 		log.Error("BuyNFTBySellerOrExchanger(), no right to sell nft")
-		return errors.New("no right to sell nft")
+		return vm.ErrNoRightToSell
 	}
 
 	unitAmount := new(big.Int).Div(amount, new(big.Int).SetInt64(10000))
-	feeRate := db.GetFeeRate(beneficiaryExchanger)
-	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	creator := db.GetNFTCreator(nftAddress)
+	feeRate, err := GetExchangerFeeRate(db, beneficiaryExchanger, creator, blocknumber, exchangerSettings)
+	if err != nil {
+		return err
+	}
+	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	royalty := db.GetNFTRoyalty(nftAddress)
 	royaltyAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(royalty)))
 	feeAmount := new(big.Int).Add(exchangerAmount, royaltyAmount)
@@ -633,6 +710,7 @@ func BuyNFTBySellerOrExchanger(
 	injectRewardAmount := new(big.Int).Div(mulRewardRate, new(big.Int).SetInt64(10000))
 	exchangerAmount = new(big.Int).Sub(exchangerAmount, injectRewardAmount)
 	db.AddBalance(beneficiaryExchanger, exchangerAmount)
+	db.AddExchangerVolume(beneficiaryExchanger, amount)
 	db.AddBalance(InjectRewardAddress, injectRewardAmount)
 
 	return nil
@@ -698,7 +776,8 @@ func BuyNFTByBuyer(
 	caller common.Address,
 	to common.Address,
 	wormholes *types.Wormholes,
-	amount *big.Int) error {
+	amount *big.Int,
+	exchangerSettings bool) error {
 
 	//1. recover buyer's address
 	msg := wormholes.Seller1.Amount +
@@ -733,7 +812,7 @@ func BuyNFTByBuyer(
 	if blocknumber.Cmp(sellerBlockNumber) > 0 {
 		log.Error("BuyNFTByBuyer(), seller's data is expired!",
 			"sellerBlockNumber", sellerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("seller's data is expired!")
+		return vm.ErrSellerDataExpired
 	}
 	//3. check seller's address and to, return error if they are not same.
 	//nftAddress := common.HexToAddress(wormholes.Seller1.NFTAddress)
@@ -746,7 +825,7 @@ func BuyNFTByBuyer(
 	if int(level2) != level {
 		log.Error("BuyNFTByBuyer()", "wormholes.Type", wormholes.Type, "nft address", wormholes.Seller1.NFTAddress,
 			"input nft level", level, "real nft level", level2)
-		return errors.New("not exist nft")
+		return vm.ErrNotExistNft
 	}
 	//pledgedFlag := db.GetPledgedFlag(nftAddress)
 	//if pledgedFlag {
@@ -757,7 +836,7 @@ func BuyNFTByBuyer(
 	emptyAddress := common.Address{}
 	if nftOwner == emptyAddress {
 		log.Error("BuyNFTByBuyer(), Get nft owner error!")
-		return errors.New("Get nft owner error!")
+		return vm.ErrGetNFTOwner
 	}
 	if nftOwner != seller {
 		log.Error("BuyNFTByBuyer(), don't have the nft!",
@@ -778,14 +857,14 @@ func BuyNFTByBuyer(
 	if amount.Cmp(sellerAmount) != 0 {
 		log.Error("BuyNFTByBuyer(), tx amount error",
 			"sellerAmount", sellerAmount.Text(16), "amount", amount.Text(16))
-		return errors.New("tx amount error")
+		return vm.ErrTxAmount
 	}
 	//5. check if the buyer has sufficient balance.
 	buyerBalance := db.GetBalance(caller)
 	if buyerBalance.Cmp(amount) < 0 {
 		log.Error("BuyNFTByBuyer(), insufficient balance",
 			"buyerBalance", buyerBalance.Text(16), "amount", amount.Text(16))
-		return errors.New("insufficient balance")
+		return vm.ErrInsufficientBuyerBalance
 	}
 
 	//6. check if the nft has exclusive exchanger.
@@ -799,9 +878,12 @@ func BuyNFTByBuyer(
 	}
 
 	unitAmount := new(big.Int).Div(amount, new(big.Int).SetInt64(10000))
-	feeRate := db.GetFeeRate(beneficiaryExchanger)
-	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	creator := db.GetNFTCreator(nftAddress)
+	feeRate, err := GetExchangerFeeRate(db, beneficiaryExchanger, creator, blocknumber, exchangerSettings)
+	if err != nil {
+		return err
+	}
+	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	royalty := db.GetNFTRoyalty(nftAddress)
 	royaltyAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(royalty)))
 	feeAmount := new(big.Int).Add(exchangerAmount, royaltyAmount)
@@ -817,6 +899,7 @@ func BuyNFTByBuyer(
 	injectRewardAmount := new(big.Int).Div(mulRewardRate, new(big.Int).SetInt64(10000))
 	exchangerAmount = new(big.Int).Sub(exchangerAmount, injectRewardAmount)
 	db.AddBalance(beneficiaryExchanger, exchangerAmount)
+	db.AddExchangerVolume(beneficiaryExchanger, amount)
 	db.AddBalance(InjectRewardAddress, injectRewardAmount)
 
 	return nil
@@ -829,7 +912,8 @@ func BuyAndMintNFTByBuyer(
 	caller common.Address,
 	to common.Address,
 	wormholes *types.Wormholes,
-	amount *big.Int) error {
+	amount *big.Int,
+	exchangerSettings bool) error {
 
 	//1. recover seller's address.
 	msg := wormholes.Seller2.Amount +
@@ -866,7 +950,7 @@ func BuyAndMintNFTByBuyer(
 	if blocknumber.Cmp(sellerBlockNumber) > 0 {
 		log.Error("BuyAndMintNFTByBuyer(), seller's data is expired!",
 			"sellerBlockNumber", sellerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("seller's data is expired!")
+		return vm.ErrSellerDataExpired
 	}
 	//3. check seller's address and to, return error if they are not same.
 	if !strings.HasPrefix(wormholes.Seller2.Amount, "0x") &&
@@ -882,14 +966,14 @@ func BuyAndMintNFTByBuyer(
 	if amount.Cmp(sellerAmount) != 0 {
 		log.Error("BuyAndMintNFTByBuyer(), tx amount error",
 			"sellerAmount", sellerAmount.Text(16), "amount", amount.Text(16))
-		return errors.New("tx amount error")
+		return vm.ErrTxAmount
 	}
 	//4. check if the buyer has sufficient balance.
 	buyerBalance := db.GetBalance(caller)
 	if buyerBalance.Cmp(amount) < 0 {
 		log.Error("BuyAndMintNFTByBuyer(), insufficient balance",
 			"buyerBalance", buyerBalance.Text(16), "amount", amount.Text(16))
-		return errors.New("insufficient balance")
+		return vm.ErrInsufficientBuyerBalance
 	}
 
 	exchanger := common.HexToAddress(wormholes.Seller2.Exchanger)
@@ -930,7 +1014,10 @@ func BuyAndMintNFTByBuyer(
 	}
 
 	unitAmount := new(big.Int).Div(amount, new(big.Int).SetInt64(10000))
-	feeRate := db.GetFeeRate(exchanger)
+	feeRate, err := GetExchangerFeeRate(db, exchanger, seller, blocknumber, exchangerSettings)
+	if err != nil {
+		return err
+	}
 	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	//creator := db.GetNFTCreator(nftAddress)
 	//royalty := db.GetNFTRoyalty(nftAddress)
@@ -947,6 +1034,7 @@ func BuyAndMintNFTByBuyer(
 	injectRewardAmount := new(big.Int).Div(mulRewardRate, new(big.Int).SetInt64(10000))
 	exchangerAmount = new(big.Int).Sub(exchangerAmount, injectRewardAmount)
 	db.AddBalance(exchanger, exchangerAmount)
+	db.AddExchangerVolume(exchanger, amount)
 	db.AddBalance(InjectRewardAddress, injectRewardAmount)
 
 	return nil
@@ -959,7 +1047,8 @@ func BuyAndMintNFTByExchanger(
 	caller common.Address,
 	to common.Address,
 	wormholes *types.Wormholes,
-	amount *big.Int) error {
+	amount *big.Int,
+	exchangerSettings bool) error {
 	//1. recover buyer and seller's address
 	buyerMsg := wormholes.Buyer.Amount +
 		wormholes.Buyer.Exchanger +
@@ -1003,7 +1092,7 @@ func BuyAndMintNFTByExchanger(
 	if to != buyer {
 		log.Error("BuyAndMintNFTByExchanger(), to of the tx is not buyer!",
 			"to", to.String(), "buyer", buyer.String())
-		return errors.New("to of the tx is not buyer!")
+		return vm.ErrNotBuyer
 	}
 
 	//2. compare current block number and BlockNumber, return error if current block number is greater than BlockNumber.
@@ -1021,7 +1110,7 @@ func BuyAndMintNFTByExchanger(
 	if blocknumber.Cmp(buyerBlockNumber) > 0 {
 		log.Error("BuyAndMintNFTByExchanger(), buyer's data is expired!",
 			"buyerBlockNumber", buyerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("buyer's data is expired!")
+		return vm.ErrBuyerDataExpired
 	}
 
 	if !strings.HasPrefix(wormholes.Seller2.BlockNumber, "0x") &&
@@ -1038,7 +1127,7 @@ func BuyAndMintNFTByExchanger(
 	if blocknumber.Cmp(sellerBlockNumber) > 0 {
 		log.Error("BuyAndMintNFTByExchanger(), seller's data is expired!",
 			"sellerBlockNumber", sellerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("seller's data is expired!")
+		return vm.ErrSellerDataExpired
 	}
 	//3. return error if the amount that sender send is not equal buyer and sender's amount.
 	if !strings.HasPrefix(wormholes.Buyer.Amount, "0x") &&
@@ -1068,7 +1157,7 @@ func BuyAndMintNFTByExchanger(
 	if amount.Cmp(buyerAmount) != 0 || amount.Cmp(sellerAmount) < 0 {
 		log.Error("BuyAndMintNFTByExchanger(), amount error",
 			"buyerAmount", buyerAmount.Text(16), "sellerAmount", sellerAmount.Text(16), "amount", amount.Text(16))
-		return errors.New("tx amount error")
+		return vm.ErrTxAmount
 	}
 
 	//4. check if the buyer has sufficient balance.
@@ -1079,7 +1168,7 @@ func BuyAndMintNFTByExchanger(
 	if buyerBalance.Cmp(amount) < 0 {
 		log.Error("BuyAndMintNFTByExchanger(), insufficient balance",
 			"buyerBalance", buyerBalance.Text(16), "amount", amount.Text(16))
-		return errors.New("insufficient balance")
+		return vm.ErrInsufficientBuyerBalance
 	}
 	//5. check if the buyer and seller's exchanger is same with sender address,
 	//return error if they are not.
@@ -1133,7 +1222,10 @@ func BuyAndMintNFTByExchanger(
 	}
 
 	unitAmount := new(big.Int).Div(amount, new(big.Int).SetInt64(10000))
-	feeRate := db.GetFeeRate(caller)
+	feeRate, err := GetExchangerFeeRate(db, caller, seller, blocknumber, exchangerSettings)
+	if err != nil {
+		return err
+	}
 	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	//creator := db.GetNFTCreator(nftAddress)
 	//royalty := db.GetNFTRoyalty(nftAddress)
@@ -1150,6 +1242,7 @@ func BuyAndMintNFTByExchanger(
 	injectRewardAmount := new(big.Int).Div(mulRewardRate, new(big.Int).SetInt64(10000))
 	exchangerAmount = new(big.Int).Sub(exchangerAmount, injectRewardAmount)
 	db.AddBalance(caller, exchangerAmount)
+	db.AddExchangerVolume(caller, amount)
 	db.AddBalance(InjectRewardAddress, injectRewardAmount)
 
 	return nil
@@ -1162,7 +1255,8 @@ func BuyNFTByApproveExchanger(
 	caller common.Address,
 	to common.Address,
 	wormholes *types.Wormholes,
-	amount *big.Int) error {
+	amount *big.Int,
+	exchangerSettings bool) error {
 
 	//1. recover buyer's address
 	msg := wormholes.Buyer.Amount +
@@ -1220,7 +1314,7 @@ func BuyNFTByApproveExchanger(
 	if blocknumber.Cmp(buyerBlockNumber) > 0 {
 		log.Error("BuyNFTByApproveExchanger(), buyer's data is expired!",
 			"buyerBlockNumber", buyerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("buyer's data is expired!")
+		return vm.ErrBuyerDataExpired
 	}
 
 	if !strings.HasPrefix(wormholes.ExchangerAuth.BlockNumber, "0x") &&
@@ -1237,7 +1331,7 @@ func BuyNFTByApproveExchanger(
 	if blocknumber.Cmp(exchangerBlockNumber) > 0 {
 		log.Error("BuyNFTByApproveExchanger(), exchanger's data is expired!",
 			"exchangerBlockNumber", exchangerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("exchanger's data is expired!")
+		return vm.ErrExchangerDataExpired
 	}
 
 	//3. check buyer's address and to address as well as exchanger_auth.to and sender ,
@@ -1245,14 +1339,14 @@ func BuyNFTByApproveExchanger(
 	if to != buyer {
 		log.Error("BuyNFTByApproveExchanger(), to of the tx is not buyer!",
 			"to", to.String(), "buyer", buyer.String())
-		return errors.New("to of the tx is not buyer!")
+		return vm.ErrNotBuyer
 	}
 
 	approvedAddr := common.HexToAddress(wormholes.ExchangerAuth.To)
 	if approvedAddr != caller {
 		log.Error("BuyNFTByApproveExchanger(), from of the tx is not approved!",
 			"caller", caller.String(), "wormholes.ExchangerAuth.To", wormholes.ExchangerAuth.To)
-		return errors.New("from of the tx is not approved!")
+		return vm.ErrNotApproved
 	}
 
 	//4. return error if the amount that sender send is not equal buyer's amount.
@@ -1269,7 +1363,7 @@ func BuyNFTByApproveExchanger(
 	if amount.Cmp(buyerAmount) != 0 {
 		log.Error("BuyNFTByApproveExchanger(), tx amount error",
 			"buyerAmount", buyerAmount.Text(16), "amount", amount.Text(16))
-		return errors.New("tx amount error")
+		return vm.ErrTxAmount
 	}
 	// 5.
 	//nftAddress := common.HexToAddress(wormholes.Buyer.NFTAddress)
@@ -1282,7 +1376,7 @@ func BuyNFTByApproveExchanger(
 	if int(level2) != level {
 		log.Error("BuyNFTByApproveExchanger()", "wormholes.Type", wormholes.Type, "nft address", wormholes.Buyer.NFTAddress,
 			"input nft level", level, "real nft level", level2)
-		return errors.New("not exist nft")
+		return vm.ErrNotExistNft
 	}
 	sellerNftAddress, _, err := GetNftAddressAndLevel(wormholes.Seller1.NFTAddress)
 	if err != nil {
@@ -1292,7 +1386,7 @@ func BuyNFTByApproveExchanger(
 	if nftAddress != sellerNftAddress {
 		log.Error("BuyNFTByApproveExchanger(), the nft address is not same from buyer and seller!",
 			"buyerNftAddress", nftAddress.String(), "sellerNftAddress", sellerNftAddress.String())
-		return errors.New("the nft address is not same from buyer and seller!")
+		return vm.ErrNFTAddressMismatch
 	}
 	//pledgedFlag := db.GetPledgedFlag(nftAddress)
 	//if pledgedFlag {
@@ -1303,14 +1397,14 @@ func BuyNFTByApproveExchanger(
 	emptyAddress := common.Address{}
 	if nftOwner == emptyAddress {
 		log.Error("BuyNFTByApproveExchanger(), Get nft owner error!", "nftAddress", nftAddress.String())
-		return errors.New("Get nft owner error!")
+		return vm.ErrGetNFTOwner
 	}
 	buyerBalance := db.GetBalance(buyer)
 	//5.1 check if the buyer has sufficient balance.
 	if buyerBalance.Cmp(amount) < 0 {
 		log.Error("BuyNFTByApproveExchanger(), insufficient balance",
 			"buyerBalance", buyerBalance.Text(16), "amount", amount.Text(16))
-		return errors.New("insufficient balance")
+		return vm.ErrInsufficientBuyerBalance
 	}
 
 	var beneficiaryExchanger common.Address
@@ -1329,13 +1423,16 @@ func BuyNFTByApproveExchanger(
 		beneficiaryExchanger = originalExchanger
 	} else {
 		log.Error("BuyNFTByApproveExchanger(), no right to sell nft!")
-		return errors.New("no right to sell nft")
+		return vm.ErrNoRightToSell
 	}
 
 	unitAmount := new(big.Int).Div(amount, new(big.Int).SetInt64(10000))
-	feeRate := db.GetFeeRate(beneficiaryExchanger)
-	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	creator := db.GetNFTCreator(nftAddress)
+	feeRate, err := GetExchangerFeeRate(db, beneficiaryExchanger, creator, blocknumber, exchangerSettings)
+	if err != nil {
+		return err
+	}
+	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	royalty := db.GetNFTRoyalty(nftAddress)
 	royaltyAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(royalty)))
 	feeAmount := new(big.Int).Add(exchangerAmount, royaltyAmount)
@@ -1351,6 +1448,7 @@ func BuyNFTByApproveExchanger(
 	injectRewardAmount := new(big.Int).Div(mulRewardRate, new(big.Int).SetInt64(10000))
 	exchangerAmount = new(big.Int).Sub(exchangerAmount, injectRewardAmount)
 	db.AddBalance(beneficiaryExchanger, exchangerAmount)
+	db.AddExchangerVolume(beneficiaryExchanger, amount)
 	db.AddBalance(InjectRewardAddress, injectRewardAmount)
 
 	return nil
@@ -1363,7 +1461,8 @@ func BuyAndMintNFTByApprovedExchanger(
 	caller common.Address,
 	to common.Address,
 	wormholes *types.Wormholes,
-	amount *big.Int) error {
+	amount *big.Int,
+	exchangerSettings bool) error {
 	//1. recover buyer, seller's address
 	buyerMsg := wormholes.Buyer.Amount +
 		wormholes.Buyer.Exchanger +
@@ -1428,14 +1527,14 @@ func BuyAndMintNFTByApprovedExchanger(
 	if approvedAddr != caller {
 		log.Error("BuyAndMintNFTByApprovedExchanger(), from of the tx is not approved!",
 			"caller", caller.String(), "wormholes.ExchangerAuth.To", wormholes.ExchangerAuth.To)
-		return errors.New("from of the tx is not approved!")
+		return vm.ErrNotApproved
 	}
 
 	// check buyer's address and to, return error if they are not same.
 	if to != buyer {
 		log.Error("BuyAndMintNFTByApprovedExchanger(), to of the tx is not buyer!",
 			"to", to.String(), "buyer", buyer.String())
-		return errors.New("to of the tx is not buyer!")
+		return vm.ErrNotBuyer
 	}
 
 	//2. compare current block number and BlockNumber,
@@ -1454,7 +1553,7 @@ func BuyAndMintNFTByApprovedExchanger(
 	if blocknumber.Cmp(buyerBlockNumber) > 0 {
 		log.Error("BuyAndMintNFTByApprovedExchanger(), buyer's data is expired!",
 			"buyerBlockNumber", buyerBlockNumber.Text(16), "buyerBlockNumber", buyerBlockNumber.Text(16))
-		return errors.New("buyer's data is expired!")
+		return vm.ErrBuyerDataExpired
 	}
 
 	if !strings.HasPrefix(wormholes.Seller2.BlockNumber, "0x") &&
@@ -1471,7 +1570,7 @@ func BuyAndMintNFTByApprovedExchanger(
 	if blocknumber.Cmp(sellerBlockNumber) > 0 {
 		log.Error("BuyAndMintNFTByApprovedExchanger(), seller's data is expired!",
 			"sellerBlockNumber", sellerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("seller's data is expired!")
+		return vm.ErrSellerDataExpired
 	}
 
 	if !strings.HasPrefix(wormholes.ExchangerAuth.BlockNumber, "0x") &&
@@ -1488,7 +1587,7 @@ func BuyAndMintNFTByApprovedExchanger(
 	if blocknumber.Cmp(exchangerBlockNumber) > 0 {
 		log.Error("BuyAndMintNFTByApprovedExchanger(), exchanger's data is expired!",
 			"exchangerBlockNumber", exchangerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("exchanger's data is expired!")
+		return vm.ErrExchangerDataExpired
 	}
 
 	//3. check the amount that sender send and buyer and seller's amount,
@@ -1520,7 +1619,7 @@ func BuyAndMintNFTByApprovedExchanger(
 	if amount.Cmp(buyerAmount) != 0 || amount.Cmp(sellerAmount) < 0 {
 		log.Error("BuyAndMintNFTByApprovedExchanger(), tx amount error",
 			"buyerAmount", buyerAmount.Text(16), "sellerAmount", sellerAmount.Text(16), "amount", amount.Text(16))
-		return errors.New("tx amount error")
+		return vm.ErrTxAmount
 	}
 
 	//4. check if the buyer has sufficient balance.
@@ -1531,7 +1630,7 @@ func BuyAndMintNFTByApprovedExchanger(
 	if buyerBalance.Cmp(amount) < 0 {
 		log.Error("BuyAndMintNFTByApprovedExchanger(), insufficient balance",
 			"buyerBalance", buyerBalance.Text(16), "amount", amount.Text(16))
-		return errors.New("insufficient balance")
+		return vm.ErrInsufficientBuyerBalance
 	}
 
 	//5. check the signature of buyer and seller's exchanger and originalexchanger,
@@ -1592,7 +1691,10 @@ func BuyAndMintNFTByApprovedExchanger(
 	}
 
 	unitAmount := new(big.Int).Div(amount, new(big.Int).SetInt64(10000))
-	feeRate := db.GetFeeRate(originalExchanger)
+	feeRate, err := GetExchangerFeeRate(db, originalExchanger, seller, blocknumber, exchangerSettings)
+	if err != nil {
+		return err
+	}
 	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	//creator := db.GetNFTCreator(nftAddress)
 	//royalty := db.GetNFTRoyalty(nftAddress)
@@ -1609,6 +1711,7 @@ func BuyAndMintNFTByApprovedExchanger(
 	injectRewardAmount := new(big.Int).Div(mulRewardRate, new(big.Int).SetInt64(10000))
 	exchangerAmount = new(big.Int).Sub(exchangerAmount, injectRewardAmount)
 	db.AddBalance(originalExchanger, exchangerAmount)
+	db.AddExchangerVolume(originalExchanger, amount)
 	db.AddBalance(InjectRewardAddress, injectRewardAmount)
 
 	return nil
@@ -1621,7 +1724,8 @@ func BuyNFTByExchanger(
 	caller common.Address,
 	to common.Address,
 	wormholes *types.Wormholes,
-	amount *big.Int) error {
+	amount *big.Int,
+	exchangerSettings bool) error {
 
 	//1. recover buyer and seller1's address
 	buyerMsg := wormholes.Buyer.Amount +
@@ -1678,7 +1782,7 @@ func BuyNFTByExchanger(
 	if blocknumber.Cmp(buyerBlockNumber) > 0 {
 		log.Error("BuyNFTByExchanger(), buyer's data is expired!",
 			"buyerBlockNumber", buyerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("buyer's data is expired!")
+		return vm.ErrBuyerDataExpired
 	}
 
 	if !strings.HasPrefix(wormholes.Seller1.BlockNumber, "0x") &&
@@ -1695,13 +1799,13 @@ func BuyNFTByExchanger(
 	if blocknumber.Cmp(sellerBlockNumber) > 0 {
 		log.Error("BuyNFTByExchanger(), seller's data is expired!",
 			"sellerBlockNumber", sellerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("seller's data is expired!")
+		return vm.ErrSellerDataExpired
 	}
 	//3. check buyer's address and to, return error if they are not same.
 	if to != buyer {
 		log.Error("BuyNFTByExchanger(), to of the tx is not buyer!",
 			"to", to.String(), "buyer", buyer.String())
-		return errors.New("to of the tx is not buyer!")
+		return vm.ErrNotBuyer
 	}
 	//4. return error if the amount that sender send is not equal buyer and sender's amount.
 	if !strings.HasPrefix(wormholes.Buyer.Amount, "0x") &&
@@ -1749,7 +1853,7 @@ func BuyNFTByExchanger(
 	if int(level2) != level {
 		log.Error("BuyNFTByExchanger()", "wormholes.Type", wormholes.Type, "buyer nft address", wormholes.Buyer.NFTAddress,
 			"input nft level", level, "real nft level", level2)
-		return errors.New("not exist nft")
+		return vm.ErrNotExistNft
 	}
 	//sellerNftAddress := common.HexToAddress(wormholes.Seller1.NFTAddress)
 	sellerNftAddress, level, err := GetNftAddressAndLevel(wormholes.Seller1.NFTAddress)
@@ -1761,12 +1865,12 @@ func BuyNFTByExchanger(
 	if int(level2) != level {
 		log.Error("BuyNFTByExchanger()", "wormholes.Type", wormholes.Type, "seller nft address", wormholes.Seller1.NFTAddress,
 			"input nft level", level, "real nft level", level2)
-		return errors.New("not exist nft")
+		return vm.ErrNotExistNft
 	}
 	if buyerNftAddress != sellerNftAddress {
 		log.Error("BuyNFTByExchanger(), the nft address is not same from buyer and seller!",
 			"buyerNftAddress", buyerNftAddress.String(), "sellerNftAddress", sellerNftAddress.String())
-		return errors.New("the nft address is not same from buyer and seller!")
+		return vm.ErrNFTAddressMismatch
 	}
 	//pledgedFlag := db.GetPledgedFlag(buyerNftAddress)
 	//if pledgedFlag {
@@ -1779,13 +1883,13 @@ func BuyNFTByExchanger(
 	emptyAddress := common.Address{}
 	if nftOwner == emptyAddress {
 		log.Error("BuyNFTByExchanger(), Get nft owner error!")
-		return errors.New("Get nft owner error!")
+		return vm.ErrGetNFTOwner
 	}
 	buyerBalance := db.GetBalance(buyer)
 	if buyerBalance.Cmp(amount) < 0 {
 		log.Error("BuyNFTByExchanger(), insufficient balance!",
 			"buyerBalance", buyerBalance.Text(16), "amount", amount.Text(16))
-		return errors.New("insufficient balance")
+		return vm.ErrInsufficientBuyerBalance
 	}
 
 	if nftOwner != seller {
@@ -1817,9 +1921,12 @@ func BuyNFTByExchanger(
 	}
 
 	unitAmount := new(big.Int).Div(amount, new(big.Int).SetInt64(10000))
-	feeRate := db.GetFeeRate(beneficiaryExchanger)
-	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	creator := db.GetNFTCreator(sellerNftAddress)
+	feeRate, err := GetExchangerFeeRate(db, beneficiaryExchanger, creator, blocknumber, exchangerSettings)
+	if err != nil {
+		return err
+	}
+	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	royalty := db.GetNFTRoyalty(sellerNftAddress)
 	royaltyAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(royalty)))
 	feeAmount := new(big.Int).Add(exchangerAmount, royaltyAmount)
@@ -1835,6 +1942,7 @@ func BuyNFTByExchanger(
 	injectRewardAmount := new(big.Int).Div(mulRewardRate, new(big.Int).SetInt64(10000))
 	exchangerAmount = new(big.Int).Sub(exchangerAmount, injectRewardAmount)
 	db.AddBalance(beneficiaryExchanger, exchangerAmount)
+	db.AddExchangerVolume(beneficiaryExchanger, amount)
 	db.AddBalance(InjectRewardAddress, injectRewardAmount)
 
 	return nil
@@ -1863,6 +1971,14 @@ func VoteOfficialNFT(db vm.StateDB, nominatedOfficialNFT *types.NominatedOfficia
 	return db.VoteOfficialNFT(nominatedOfficialNFT, blocknumber)
 }
 
+func ProposeOfficialNFT(db vm.StateDB, nominatedOfficialNFT *types.NominatedOfficialNFT, blocknumber *big.Int) error {
+	return db.ProposeOfficialNFT(nominatedOfficialNFT, blocknumber)
+}
+
+func VoteOfficialNFTProposal(db vm.StateDB, voter common.Address, id uint64, blocknumber *big.Int) error {
+	return db.VoteOfficialNFTProposal(voter, id, blocknumber)
+}
+
 func ElectNominatedOfficialNFT(db vm.StateDB, blocknumber *big.Int) {
 	db.ElectNominatedOfficialNFT(blocknumber)
 }
@@ -1877,7 +1993,8 @@ func VoteOfficialNFTByApprovedExchanger(
 	caller common.Address,
 	to common.Address,
 	wormholes *types.Wormholes,
-	amount *big.Int) error {
+	amount *big.Int,
+	proposalVoting bool) error {
 
 	var number uint64 = 4096
 	var royalty uint16 = 1000 // default 10%
@@ -1905,7 +2022,7 @@ func VoteOfficialNFTByApprovedExchanger(
 	if approvedAddr != caller {
 		log.Error("BuyAndMintNFTByApprovedExchanger(), from of the tx is not approved!",
 			"caller", caller.String(), "wormholes.ExchangerAuth.To", wormholes.ExchangerAuth.To)
-		return errors.New("from of the tx is not approved!")
+		return vm.ErrNotApproved
 	}
 
 	if !strings.HasPrefix(wormholes.ExchangerAuth.BlockNumber, "0x") &&
@@ -1922,7 +2039,11 @@ func VoteOfficialNFTByApprovedExchanger(
 	if blocknumber.Cmp(exchangerBlockNumber) > 0 {
 		log.Error("BuyAndMintNFTByApprovedExchanger(), exchanger's data is expired!",
 			"exchangerBlockNumber", exchangerBlockNumber.Text(16), "blocknumber", blocknumber.Text(16))
-		return errors.New("exchanger's data is expired!")
+		return vm.ErrExchangerDataExpired
+	}
+
+	if proposalVoting && wormholes.ProposalID != 0 {
+		return db.VoteOfficialNFTProposal(originalExchanger, wormholes.ProposalID, blocknumber)
 	}
 
 	startIndex := db.NextIndex()
@@ -1948,6 +2069,9 @@ func VoteOfficialNFTByApprovedExchanger(
 		},
 	}
 
+	if proposalVoting {
+		return db.ProposeOfficialNFT(&nominatedNFT, blocknumber)
+	}
 	return db.VoteOfficialNFT(&nominatedNFT, blocknumber)
 }
 
@@ -2021,7 +2145,8 @@ func BatchBuyNFTByApproveExchanger(
 	caller common.Address,
 	to common.Address,
 	wormholes *types.Wormholes,
-	amount *big.Int) error {
+	amount *big.Int,
+	exchangerSettings bool) error {
 
 	emptyAddress := common.Address{}
 
@@ -2102,7 +2227,7 @@ func BatchBuyNFTByApproveExchanger(
 	if amount.Cmp(buyerAmount) != 0 {
 		log.Error("BatchBuyNFTByApproveExchanger(), tx amount error",
 			"buyerAmount", buyerAmount.Text(16), "amount", amount.Text(16))
-		return errors.New("tx amount error")
+		return vm.ErrTxAmount
 	}
 
 	buyMsg := wormholes.Buyer.Amount +
@@ -2138,7 +2263,7 @@ func BatchBuyNFTByApproveExchanger(
 	if amount.Cmp(sellerAmount) < 0 {
 		log.Error("BatchBuyNFTByApproveExchanger(), tx amount error",
 			"sellerAmount", sellerAmount.Text(16), "amount", amount.Text(16))
-		return errors.New("tx amount error")
+		return vm.ErrTxAmount
 	}
 
 	SellMsg := wormholes.Seller1.Amount +
@@ -2196,14 +2321,14 @@ func BatchBuyNFTByApproveExchanger(
 	if to != buyer {
 		log.Error("BatchBuyNFTByApproveExchanger(), to of the tx is not buyer!",
 			"to", to.String(), "buyer", buyer.String())
-		return errors.New("to of the tx is not buyer!")
+		return vm.ErrNotBuyer
 	}
 
 	approvedAddr := common.HexToAddress(wormholes.ExchangerAuth.To)
 	if approvedAddr != caller {
 		log.Error("BatchBuyNFTByApproveExchanger(), from of the tx is not approved!",
 			"caller", caller.String(), "wormholes.ExchangerAuth.To", wormholes.ExchangerAuth.To)
-		return errors.New("from of the tx is not approved!")
+		return vm.ErrNotApproved
 	}
 
 	// 5.
@@ -2217,7 +2342,7 @@ func BatchBuyNFTByApproveExchanger(
 	if int(level2) != level {
 		log.Error("BatchBuyNFTByApproveExchanger()", "wormholes.Type", wormholes.Type, "nft address", wormholes.Buyer.NFTAddress,
 			"input nft level", level, "real nft level", level2)
-		return errors.New("not exist nft")
+		return vm.ErrNotExistNft
 	}
 
 	sellerNftAddress, _, err := GetNftAddressAndLevel(wormholes.Seller1.NFTAddress)
@@ -2228,7 +2353,7 @@ func BatchBuyNFTByApproveExchanger(
 	if nftAddress != sellerNftAddress {
 		log.Error("BatchBuyNFTByApproveExchanger(), the nft address is not same from buyer and seller!",
 			"buyerNftAddress", nftAddress.String(), "sellerNftAddress", sellerNftAddress.String())
-		return errors.New("the nft address is not same from buyer and seller!")
+		return vm.ErrNFTAddressMismatch
 	}
 
 	//pledgedFlag := db.GetPledgedFlag(nftAddress)
@@ -2239,7 +2364,7 @@ func BatchBuyNFTByApproveExchanger(
 	nftOwner := db.GetNFTOwner16(nftAddress)
 	if nftOwner == emptyAddress {
 		log.Error("BatchBuyNFTByApproveExchanger(), Get nft owner error!", "nftAddress", nftAddress.String())
-		return errors.New("Get nft owner error!")
+		return vm.ErrGetNFTOwner
 	}
 	if nftOwner != seller {
 		log.Error("BatchBuyNFTByApproveExchanger(), seller isn't owner of the nft!", "nftAddress", nftAddress.String(),
@@ -2252,7 +2377,7 @@ func BatchBuyNFTByApproveExchanger(
 	if buyerBalance.Cmp(amount) < 0 {
 		log.Error("BatchBuyNFTByApproveExchanger(), insufficient balance",
 			"buyerBalance", buyerBalance.Text(16), "amount", amount.Text(16))
-		return errors.New("insufficient balance")
+		return vm.ErrInsufficientBuyerBalance
 	}
 
 	var beneficiaryExchanger common.Address
@@ -2269,9 +2394,12 @@ func BatchBuyNFTByApproveExchanger(
 	beneficiaryExchanger = originalExchanger
 
 	unitAmount := new(big.Int).Div(amount, new(big.Int).SetInt64(10000))
-	feeRate := db.GetFeeRate(beneficiaryExchanger)
-	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	creator := db.GetNFTCreator(nftAddress)
+	feeRate, err := GetExchangerFeeRate(db, beneficiaryExchanger, creator, blocknumber, exchangerSettings)
+	if err != nil {
+		return err
+	}
+	exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 	royalty := db.GetNFTRoyalty(nftAddress)
 	royaltyAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(royalty)))
 	feeAmount := new(big.Int).Add(exchangerAmount, royaltyAmount)
@@ -2287,6 +2415,7 @@ func BatchBuyNFTByApproveExchanger(
 	injectRewardAmount := new(big.Int).Div(mulRewardRate, new(big.Int).SetInt64(10000))
 	exchangerAmount = new(big.Int).Sub(exchangerAmount, injectRewardAmount)
 	db.AddBalance(beneficiaryExchanger, exchangerAmount)
+	db.AddExchangerVolume(beneficiaryExchanger, amount)
 	db.AddBalance(InjectRewardAddress, injectRewardAmount)
 
 	return nil
@@ -2501,7 +2630,8 @@ func BatchForcedSaleSNFTByApproveExchanger(
 	caller common.Address,
 	to common.Address,
 	wormholes *types.Wormholes,
-	amount *big.Int) error {
+	amount *big.Int,
+	exchangerSettings bool) error {
 
 	emptyAddress := common.Address{}
 
@@ -2592,7 +2722,7 @@ func BatchForcedSaleSNFTByApproveExchanger(
 	if buyerBalance.Cmp(totalAmount) < 0 {
 		log.Error("BatchForcedSaleSNFTByApproveExchanger(), insufficient balance",
 			"buyerBalance", buyerBalance.Text(16), "amount", totalAmount.Text(16))
-		return errors.New("insufficient balance")
+		return vm.ErrInsufficientBuyerBalance
 	}
 
 	var originalExchanger common.Address
@@ -2636,14 +2766,14 @@ func BatchForcedSaleSNFTByApproveExchanger(
 	if to != buyer {
 		log.Error("BatchForcedSaleSNFTByApproveExchanger(), to of the tx is not buyer!",
 			"to", to.String(), "buyer", buyer.String())
-		return errors.New("to of the tx is not buyer!")
+		return vm.ErrNotBuyer
 	}
 
 	approvedAddr := common.HexToAddress(wormholes.ExchangerAuth.To)
 	if approvedAddr != caller {
 		log.Error("BatchForcedSaleSNFTByApproveExchanger(), from of the tx is not approved!",
 			"caller", caller.String(), "wormholes.ExchangerAuth.To", wormholes.ExchangerAuth.To)
-		return errors.New("from of the tx is not approved!")
+		return vm.ErrNotApproved
 	}
 
 	var beneficiaryExchanger common.Address
@@ -2665,11 +2795,16 @@ func BatchForcedSaleSNFTByApproveExchanger(
 	}
 
 	unitAmount := new(big.Int).Div(amount, new(big.Int).SetInt64(10000))
-	feeRate := db.GetFeeRate(beneficiaryExchanger)
 	for _, nftAddr := range nftAddrs {
 		nftOwner := db.GetNFTOwner16(nftAddr)
 		creator := db.GetNFTCreator(nftAddr)
 		royalty := db.GetNFTRoyalty(nftAddr)
+		// Forced sales of official snfts are not subject to the creator
+		// allowlist of the exchanger.
+		feeRate := db.GetFeeRate(beneficiaryExchanger)
+		if exchangerSettings {
+			feeRate = db.GetExchangerFeeRate(beneficiaryExchanger, creator, blocknumber)
+		}
 		exchangerAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(feeRate)))
 		royaltyAmount := new(big.Int).Mul(unitAmount, new(big.Int).SetUint64(uint64(royalty)))
 		feeAmount := new(big.Int).Add(exchangerAmount, royaltyAmount)
@@ -2688,6 +2823,7 @@ func BatchForcedSaleSNFTByApproveExchanger(
 		injectRewardAmount := new(big.Int).Div(mulRewardRate, new(big.Int).SetInt64(10000))
 		exchangerAmount = new(big.Int).Sub(exchangerAmount, injectRewardAmount)
 		db.AddBalance(beneficiaryExchanger, exchangerAmount)
+		db.AddExchangerVolume(beneficiaryExchanger, amount)
 		db.AddBalance(InjectRewardAddress, injectRewardAmount)
 		log.Info("BatchForcedSaleSNFTByApproveExchanger()",
 			"nft address", nftAddr.String(),
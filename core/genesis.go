@@ -225,6 +225,9 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 	if err := newcfg.CheckConfigForkOrder(); err != nil {
 		return newcfg, common.Hash{}, err
 	}
+	if err := newcfg.Istanbul.CheckConfig(); err != nil {
+		return newcfg, common.Hash{}, err
+	}
 	storedcfg := rawdb.ReadChainConfig(db, stored)
 	if storedcfg == nil {
 		log.Warn("Found genesis block without chain config")
@@ -351,6 +354,9 @@ func (g *Genesis) Commit(db ethdb.Database) (*types.Block, error) {
 	if err := config.CheckConfigForkOrder(); err != nil {
 		return nil, err
 	}
+	if err := config.Istanbul.CheckConfig(); err != nil {
+		return nil, err
+	}
 	rawdb.WriteTd(db, block.Hash(), block.NumberU64(), g.Difficulty)
 	rawdb.WriteBlock(db, block)
 	rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), nil)
@@ -364,6 +370,7 @@ func (g *Genesis) Commit(db ethdb.Database) (*types.Block, error) {
 		//stakerList    types.StakerList
 		stakerList    types.DBStakerList
 		validatorList types.ValidatorList
+		exchangerList types.ExchangerList
 	)
 
 	for addr, account := range g.Stake {
@@ -373,6 +380,7 @@ func (g *Genesis) Commit(db ethdb.Database) (*types.Block, error) {
 		dbStaker.DeleteFlag = false
 		stakerList.DBStakers = append(stakerList.DBStakers, &dbStaker)
 		//stakerList.AddStaker(addr, account.Balance)
+		exchangerList.AddExchanger(addr, big.NewInt(0))
 	}
 
 	for addr, account := range g.Validator {
@@ -390,6 +398,7 @@ func (g *Genesis) Commit(db ethdb.Database) (*types.Block, error) {
 	//rawdb.WriteStakePool(db, block.Hash(), block.NumberU64(), &stakerList)
 	rawdb.WriteDBStakerPool(db, block.Hash(), block.NumberU64(), &stakerList)
 	rawdb.WriteValidatorPool(db, block.Hash(), block.NumberU64(), &validatorList)
+	rawdb.WriteExchangerPool(db, block.Hash(), block.NumberU64(), &exchangerList)
 
 	officialNFT := types.InjectedOfficialNFT{
 		Dir:        g.Dir,
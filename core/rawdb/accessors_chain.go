@@ -978,3 +978,29 @@ func ReadNominatedOfficialNFT(db ethdb.Reader, hash common.Hash, number uint64)
 	//}
 	return NominatedOfficialNFT, nil
 }
+
+// WriteOfficialNFTProposals stores the official nft proposals into the database.
+func WriteOfficialNFTProposals(db ethdb.KeyValueWriter, hash common.Hash, number uint64, proposals *types.OfficialNFTProposalList) {
+	data, err := rlp.EncodeToBytes(proposals)
+	if err != nil {
+		log.Crit("Failed to RLP OfficialNFTProposals", "err", err)
+	}
+
+	if err := db.Put(officialNFTProposalsKey(number, hash), data); err != nil {
+		log.Crit("Failed to store OfficialNFTProposals", "err", err)
+	}
+}
+
+// ReadOfficialNFTProposals retrieves the official nft proposals corresponding to the hash.
+func ReadOfficialNFTProposals(db ethdb.Reader, hash common.Hash, number uint64) (*types.OfficialNFTProposalList, error) {
+	data, err := db.Get(officialNFTProposalsKey(number, hash))
+	if err != nil {
+		return nil, err
+	}
+	proposals := new(types.OfficialNFTProposalList)
+	if err := rlp.Decode(bytes.NewReader(data), proposals); err != nil {
+		log.Error("Invalid OfficialNFTProposals RLP", "hash", hash, "err", err)
+		return nil, err
+	}
+	return proposals, nil
+}
@@ -18,6 +18,7 @@ package rawdb
 
 import (
 	"bytes"
+	"encoding/binary"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -155,6 +156,109 @@ func WriteBloomBits(db ethdb.KeyValueWriter, bit uint, section uint64, head comm
 	}
 }
 
+// ReadValidatorStats retrieves the participation of the validators aggregated
+// over the given section.
+func ReadValidatorStats(db ethdb.KeyValueReader, section uint64) []*types.ValidatorStats {
+	data, _ := db.Get(validatorStatsKey(section))
+	if len(data) == 0 {
+		return nil
+	}
+	var stats []*types.ValidatorStats
+	if err := rlp.DecodeBytes(data, &stats); err != nil {
+		log.Error("Invalid validator stats RLP", "section", section, "err", err)
+		return nil
+	}
+	return stats
+}
+
+// WriteValidatorStats stores the participation of the validators aggregated
+// over the given section.
+func WriteValidatorStats(db ethdb.KeyValueWriter, section uint64, stats []*types.ValidatorStats) {
+	data, err := rlp.EncodeToBytes(stats)
+	if err != nil {
+		log.Crit("Failed to encode validator stats", "err", err)
+	}
+	if err := db.Put(validatorStatsKey(section), data); err != nil {
+		log.Crit("Failed to store validator stats", "err", err)
+	}
+}
+
+// ReadOnlineAttestation retrieves the online validator attestation the node
+// collected at the given height.
+func ReadOnlineAttestation(db ethdb.KeyValueReader, height uint64) *types.OnlineAttestation {
+	data, _ := db.Get(onlineAttestationKey(height))
+	if len(data) == 0 {
+		return nil
+	}
+	attestation := new(types.OnlineAttestation)
+	if err := rlp.DecodeBytes(data, attestation); err != nil {
+		log.Error("Invalid online attestation RLP", "height", height, "err", err)
+		return nil
+	}
+	return attestation
+}
+
+// WriteOnlineAttestation stores the online validator attestation the node
+// collected at its height.
+func WriteOnlineAttestation(db ethdb.KeyValueWriter, attestation *types.OnlineAttestation) {
+	data, err := rlp.EncodeToBytes(attestation)
+	if err != nil {
+		log.Crit("Failed to encode online attestation", "err", err)
+	}
+	if err := db.Put(onlineAttestationKey(attestation.Height), data); err != nil {
+		log.Crit("Failed to store online attestation", "err", err)
+	}
+}
+
+// ReadOnlineAttestationTail retrieves the oldest height whose online validator
+// attestation has not been pruned, zero if nothing was pruned.
+func ReadOnlineAttestationTail(db ethdb.KeyValueReader) uint64 {
+	data, _ := db.Get(onlineAttestationTailKey)
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
+
+// WriteOnlineAttestationTail stores the oldest height whose online validator
+// attestation has not been pruned.
+func WriteOnlineAttestationTail(db ethdb.KeyValueWriter, height uint64) {
+	if err := db.Put(onlineAttestationTailKey, encodeBlockNumber(height)); err != nil {
+		log.Crit("Failed to store the online attestation tail", "err", err)
+	}
+}
+
+// DeleteOnlineAttestations removes the online validator attestations of the
+// heights in the range [from, to).
+func DeleteOnlineAttestations(db ethdb.Database, from uint64, to uint64) {
+	it := db.NewIterator(onlineAttestationPrefix, encodeBlockNumber(from))
+	defer it.Release()
+
+	batch := db.NewBatch()
+	end := onlineAttestationKey(to)
+	for it.Next() {
+		if bytes.Compare(it.Key(), end) >= 0 {
+			break
+		}
+		if len(it.Key()) != len(onlineAttestationPrefix)+8 {
+			continue
+		}
+		batch.Delete(it.Key())
+		if batch.ValueSize() > ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				log.Crit("Failed to delete online attestations", "err", err)
+			}
+			batch.Reset()
+		}
+	}
+	if it.Error() != nil {
+		log.Crit("Failed to iterate online attestations", "err", it.Error())
+	}
+	if err := batch.Write(); err != nil {
+		log.Crit("Failed to delete online attestations", "err", err)
+	}
+}
+
 // DeleteBloombits removes all compressed bloom bits vector belonging to the
 // given section range and bit index.
 func DeleteBloombits(db ethdb.Database, bit uint, from uint64, to uint64) {
@@ -177,3 +177,30 @@ func TestDeleteBloomBits(t *testing.T) {
 	check(1, 1, params.MainnetGenesisHash, true)
 	check(1, 1, params.RinkebyGenesisHash, true)
 }
+
+func TestOnlineAttestationStorage(t *testing.T) {
+	db := NewMemoryDatabase()
+	for height := uint64(1); height <= 4; height++ {
+		WriteOnlineAttestation(db, &types.OnlineAttestation{
+			Height:     height,
+			Validators: []common.Address{common.HexToAddress("0x01")},
+			Proposer:   common.HexToAddress("0x02"),
+			Voters:     []common.Address{common.HexToAddress("0x03"), common.HexToAddress("0x04")},
+			Weight:     big.NewInt(int64(height)),
+			Messages:   [][]byte{{0x01}, {0x02}},
+		})
+	}
+	attestation := ReadOnlineAttestation(db, 2)
+	if attestation == nil {
+		t.Fatalf("attestation not found")
+	}
+	if attestation.Height != 2 || attestation.Weight.Int64() != 2 || len(attestation.Voters) != 2 || !bytes.Equal(attestation.Messages[1], []byte{0x02}) {
+		t.Fatalf("attestation mismatch: %+v", attestation)
+	}
+	DeleteOnlineAttestations(db, 0, 3)
+	for height := uint64(1); height <= 4; height++ {
+		if exist := ReadOnlineAttestation(db, height) != nil; exist != (height >= 3) {
+			t.Fatalf("attestation %d: have existence %v, want %v", height, exist, height >= 3)
+		}
+	}
+}
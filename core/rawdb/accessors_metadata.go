@@ -227,3 +227,28 @@ func ReadValidatorPool(db ethdb.Reader, hash common.Hash, number uint64) (*types
 	}
 	return validatorList, nil
 }
+
+func WriteExchangerPool(db ethdb.KeyValueWriter, hash common.Hash, number uint64, exchangerList *types.ExchangerList) {
+	data, err := rlp.EncodeToBytes(exchangerList)
+	if err != nil {
+		log.Crit("Failed to RLP exchangerPool", "err", err)
+	}
+
+	if err := db.Put(ExchangerPoolKey(number, hash), data); err != nil {
+		log.Crit("Failed to store exchangerPool", "err", err)
+	}
+}
+
+func ReadExchangerPool(db ethdb.Reader, hash common.Hash, number uint64) (*types.ExchangerList, error) {
+	data, err := db.Get(ExchangerPoolKey(number, hash))
+	if err != nil {
+		return nil, err
+	}
+
+	exchangerList := new(types.ExchangerList)
+	if err := rlp.Decode(bytes.NewReader(data), exchangerList); err != nil {
+		log.Error("Invalid exchangerPool RLP", "hash", hash, "err", err)
+		return nil, err
+	}
+	return exchangerList, nil
+}
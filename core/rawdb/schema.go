@@ -30,6 +30,7 @@ var (
 	stakePoolKeyPrefix     = []byte("stake-pool")
 	dbStakerPoolKeyPrefix  = []byte("db-stake-pool")
 	validatorPoolKeyPrefix = []byte("validator-pool")
+	exchangerPoolKeyPrefix = []byte("exchanger-pool")
 	// databaseVersionKey tracks the current database version.
 	databaseVersionKey = []byte("DatabaseVersion")
 
@@ -72,6 +73,10 @@ var (
 	// fastTxLookupLimitKey tracks the transaction lookup limit during fast sync.
 	fastTxLookupLimitKey = []byte("FastTransactionLookupLimit")
 
+	// onlineAttestationTailKey tracks the oldest height whose online validator
+	// attestation has not been pruned.
+	onlineAttestationTailKey = []byte("OnlineAttestationTail")
+
 	// badBlockKey tracks the list of bad blocks seen by local
 	badBlockKey = []byte("InvalidBlock")
 
@@ -97,12 +102,18 @@ var (
 	configPrefix   = []byte("ethereum-config-") // config prefix for the db
 
 	// Chain index prefixes (use `i` + single byte to avoid mixing data types).
-	BloomBitsIndexPrefix = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
+	BloomBitsIndexPrefix      = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
+	ValidatorStatsIndexPrefix = []byte("iV") // ValidatorStatsIndexPrefix is the data table of the validator stats indexer to track its progress
+
+	validatorStatsPrefix = []byte("validator-stats-") // validatorStatsPrefix + section (uint64 big endian) -> validator stats
+
+	onlineAttestationPrefix = []byte("validator-online-") // onlineAttestationPrefix + height (uint64 big endian) -> online validator attestation
 
 	mintDeepPrefix             = []byte("mint-deep-")
 	snftExchangePoolPrefix     = []byte("snft-exchange-pool-")
 	officialNFTPrefix          = []byte("official-nft-")
 	nominatedOfficialNFTPrefix = []byte("nominated-official-nft-")
+	officialNFTProposalsPrefix = []byte("official-nft-proposals-")
 
 	preimageCounter    = metrics.NewRegisteredCounter("db/preimage/total", nil)
 	preimageHitCounter = metrics.NewRegisteredCounter("db/preimage/hits", nil)
@@ -205,6 +216,16 @@ func storageSnapshotsKey(accountHash common.Hash) []byte {
 	return append(SnapshotStoragePrefix, accountHash.Bytes()...)
 }
 
+// validatorStatsKey = validatorStatsPrefix + section (uint64 big endian)
+func validatorStatsKey(section uint64) []byte {
+	return append(append([]byte{}, validatorStatsPrefix...), encodeBlockNumber(section)...)
+}
+
+// onlineAttestationKey = onlineAttestationPrefix + height (uint64 big endian)
+func onlineAttestationKey(height uint64) []byte {
+	return append(append([]byte{}, onlineAttestationPrefix...), encodeBlockNumber(height)...)
+}
+
 // bloomBitsKey = bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash
 func bloomBitsKey(bit uint, section uint64, hash common.Hash) []byte {
 	key := append(append(bloomBitsPrefix, make([]byte, 10)...), hash.Bytes()...)
@@ -251,6 +272,11 @@ func ValidatorPoolKey(number uint64, hash common.Hash) []byte {
 	return append(append(validatorPoolKeyPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
 }
 
+// ExchangerPoolKey = exchangerPoolKeyPrefix + num (uint64 big endian) + hash
+func ExchangerPoolKey(number uint64, hash common.Hash) []byte {
+	return append(append(exchangerPoolKeyPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
 // mintDeepKey = mintDeepPrefix + num (uint64 big endian) + hash
 func MintDeepKey(number uint64, hash common.Hash) []byte {
 	return append(append(mintDeepPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
@@ -270,3 +296,8 @@ func officialNFTPoolKey(number uint64, hash common.Hash) []byte {
 func nominatedOfficialNFTPoolKey(number uint64, hash common.Hash) []byte {
 	return append(append(nominatedOfficialNFTPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
 }
+
+// officialNFTProposalsKey = officialNFTProposalsPrefix + num (uint64 big endian) + hash
+func officialNFTProposalsKey(number uint64, hash common.Hash) []byte {
+	return append(append(officialNFTProposalsPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
@@ -19,10 +19,12 @@ package state
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
@@ -57,6 +59,87 @@ type DumpAccount struct {
 	Address   *common.Address        `json:"address,omitempty"` // Address only present in iterative (line-by-line) mode
 	SecureKey hexutil.Bytes          `json:"key,omitempty"`     // If we don't have address, we can output the key
 
+	// Wormholes fields, left out while they hold their default value
+	PledgedBalance     string                   `json:"pledgedBalance,omitempty"`
+	PledgedBlockNumber string                   `json:"pledgedBlockNumber,omitempty"`
+	ExchangerFlag      bool                     `json:"exchangerFlag,omitempty"`
+	BlockNumber        string                   `json:"blockNumber,omitempty"`
+	ExchangerBalance   string                   `json:"exchangerBalance,omitempty"`
+	VoteBlockNumber    string                   `json:"voteBlockNumber,omitempty"`
+	VoteWeight         string                   `json:"voteWeight,omitempty"`
+	Coefficient        uint8                    `json:"coefficient,omitempty"`
+	FeeRate            uint16                   `json:"feeRate,omitempty"`
+	ExchangerName      string                   `json:"exchangerName,omitempty"`
+	ExchangerURL       string                   `json:"exchangerURL,omitempty"`
+	ApproveAddressList []common.Address         `json:"approveAddressList,omitempty"`
+	NFT                *DumpAccountNFT          `json:"nft,omitempty"`
+	Extra              hexutil.Bytes            `json:"extra,omitempty"`
+	ExchangerSettings  *types.ExchangerSettings `json:"exchangerSettings,omitempty"`
+	SNFTShares         *types.SNFTShares        `json:"snftShares,omitempty"`
+}
+
+// DumpAccountNFT represents the nft held by an account in the state.
+type DumpAccountNFT struct {
+	Name              string         `json:"name,omitempty"`
+	Symbol            string         `json:"symbol,omitempty"`
+	Owner             common.Address `json:"owner"`
+	NFTApproveAddress common.Address `json:"nftApproveAddress"`
+	MergeLevel        uint8          `json:"mergeLevel"`
+	MergeNumber       uint32         `json:"mergeNumber"`
+	Creator           common.Address `json:"creator"`
+	Royalty           uint16         `json:"royalty"`
+	Exchanger         common.Address `json:"exchanger"`
+	MetaURL           string         `json:"metaURL"`
+	MetaURLFrozen     bool           `json:"metaURLFrozen"`
+}
+
+// dumpBig formats a big integer for a dump, empty if it is unset or zero.
+func dumpBig(v *big.Int) string {
+	if v == nil || v.Sign() == 0 {
+		return ""
+	}
+	return v.String()
+}
+
+// newDumpAccount returns the dump of an account without its code and storage.
+func newDumpAccount(data *Account) DumpAccount {
+	account := DumpAccount{
+		Balance:            data.Balance.String(),
+		Nonce:              data.Nonce,
+		Root:               data.Root[:],
+		CodeHash:           data.CodeHash,
+		PledgedBalance:     dumpBig(data.PledgedBalance),
+		PledgedBlockNumber: dumpBig(data.PledgedBlockNumber),
+		ExchangerFlag:      data.ExchangerFlag,
+		BlockNumber:        dumpBig(data.BlockNumber),
+		ExchangerBalance:   dumpBig(data.ExchangerBalance),
+		VoteBlockNumber:    dumpBig(data.VoteBlockNumber),
+		VoteWeight:         dumpBig(data.VoteWeight),
+		Coefficient:        data.Coefficient,
+		FeeRate:            data.FeeRate,
+		ExchangerName:      data.ExchangerName,
+		ExchangerURL:       data.ExchangerURL,
+		ApproveAddressList: data.ApproveAddressList,
+		Extra:              data.Extra,
+		ExchangerSettings:  data.ExchangerSettings,
+		SNFTShares:         data.SNFTShares,
+	}
+	if data.Owner != (common.Address{}) {
+		account.NFT = &DumpAccountNFT{
+			Name:              data.Name,
+			Symbol:            data.Symbol,
+			Owner:             data.Owner,
+			NFTApproveAddress: data.NFTApproveAddressList,
+			MergeLevel:        data.MergeLevel,
+			MergeNumber:       data.MergeNumber,
+			Creator:           data.Creator,
+			Royalty:           data.Royalty,
+			Exchanger:         data.Exchanger,
+			MetaURL:           data.MetaURL,
+			MetaURLFrozen:     data.MetaURLFrozen,
+		}
+	}
+	return account
 }
 
 // Dump represents the full dump in a collected format, as one large map.
@@ -99,20 +182,12 @@ type iterativeDump struct {
 
 // OnAccount implements DumpCollector interface
 func (d iterativeDump) OnAccount(addr common.Address, account DumpAccount) {
-	dumpAccount := &DumpAccount{
-		Balance:   account.Balance,
-		Nonce:     account.Nonce,
-		Root:      account.Root,
-		CodeHash:  account.CodeHash,
-		Code:      account.Code,
-		Storage:   account.Storage,
-		SecureKey: account.SecureKey,
-		Address:   nil,
-	}
+	dumpAccount := account
+	dumpAccount.Address = nil
 	if addr != (common.Address{}) {
 		dumpAccount.Address = &addr
 	}
-	d.Encode(dumpAccount)
+	d.Encode(&dumpAccount)
 }
 
 // OnRoot implements DumpCollector interface
@@ -144,13 +219,8 @@ func (s *StateDB) DumpToCollector(c DumpCollector, conf *DumpConfig) (nextKey []
 		if err := rlp.DecodeBytes(it.Value, &data); err != nil {
 			panic(err)
 		}
-		account := DumpAccount{
-			Balance:   data.Balance.String(),
-			Nonce:     data.Nonce,
-			Root:      data.Root[:],
-			CodeHash:  data.CodeHash,
-			SecureKey: it.Key,
-		}
+		account := newDumpAccount(&data)
+		account.SecureKey = it.Key
 		addrBytes := s.trie.GetKey(it.Key)
 		if addrBytes == nil {
 			// Preimage missing
@@ -232,3 +302,33 @@ func (s *StateDB) IteratorDump(opts *DumpConfig) IteratorDump {
 	iterator.Next = s.DumpToCollector(iterator, opts)
 	return *iterator
 }
+
+// DumpAccount returns the dump of a single account, or false if the account
+// doesn't exist.
+func (s *StateDB) DumpAccount(addr common.Address, conf *DumpConfig) (DumpAccount, bool) {
+	if conf == nil {
+		conf = new(DumpConfig)
+	}
+	obj := s.getStateObject(addr)
+	if obj == nil {
+		return DumpAccount{}, false
+	}
+	account := newDumpAccount(&obj.data)
+	account.Address = &addr
+	if !conf.SkipCode {
+		account.Code = obj.Code(s.db)
+	}
+	if !conf.SkipStorage {
+		account.Storage = make(map[common.Hash]string)
+		storageIt := trie.NewIterator(obj.getTrie(s.db).NodeIterator(nil))
+		for storageIt.Next() {
+			_, content, _, err := rlp.Split(storageIt.Value)
+			if err != nil {
+				log.Error("Failed to decode the value returned by iterator", "error", err)
+				continue
+			}
+			account.Storage[common.BytesToHash(s.trie.GetKey(storageIt.Key))] = common.Bytes2Hex(content)
+		}
+	}
+	return account, true
+}
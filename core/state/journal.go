@@ -20,6 +20,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 // journalEntry is a modification entry in the state change journal that can be
@@ -156,6 +157,23 @@ type (
 		oldNFTApproveAddressList common.Address
 	}
 
+	exchangerVolumeChange struct{}
+
+	officialNFTProposalsChange struct {
+		prev          *types.OfficialNFTProposalList
+		prevNominated *types.NominatedOfficialNFT
+	}
+
+	exchangerSettingsChange struct {
+		address *common.Address
+		prev    *types.ExchangerSettings
+	}
+
+	snftSharesChange struct {
+		address *common.Address
+		prev    *types.SNFTShares
+	}
+
 	openExchangerChange struct {
 		address          *common.Address
 		oldExchangerFlag bool
@@ -181,6 +199,16 @@ type (
 		oldMetaURL   string
 	}
 
+	nftMetaURLChange struct {
+		address *common.Address
+		prev    string
+	}
+
+	nftMetaURLFrozenChange struct {
+		address *common.Address
+		prev    bool
+	}
+
 	pledgedBalanceChange struct {
 		account *common.Address
 		prev    *big.Int
@@ -401,6 +429,39 @@ func (ch openExchangerChange) dirtied() *common.Address {
 	return ch.address
 }
 
+func (ch exchangerVolumeChange) revert(s *StateDB) {
+	s.ExchangerVolumePool = s.ExchangerVolumePool[:len(s.ExchangerVolumePool)-1]
+}
+
+func (ch exchangerVolumeChange) dirtied() *common.Address {
+	return nil
+}
+
+func (ch officialNFTProposalsChange) revert(s *StateDB) {
+	s.OfficialNFTProposals = ch.prev
+	s.NominatedOfficialNFT = ch.prevNominated
+}
+
+func (ch officialNFTProposalsChange) dirtied() *common.Address {
+	return nil
+}
+
+func (ch exchangerSettingsChange) revert(s *StateDB) {
+	s.getStateObject(*ch.address).setExchangerSettings(ch.prev)
+}
+
+func (ch exchangerSettingsChange) dirtied() *common.Address {
+	return ch.address
+}
+
+func (ch snftSharesChange) revert(s *StateDB) {
+	s.getStateObject(*ch.address).setSNFTShares(ch.prev)
+}
+
+func (ch snftSharesChange) dirtied() *common.Address {
+	return ch.address
+}
+
 func (ch nftInfoChange) revert(s *StateDB) {
 	s.getStateObject(*ch.address).setJournalNFTInfo(
 		ch.oldName,
@@ -420,6 +481,22 @@ func (ch nftInfoChange) dirtied() *common.Address {
 	return ch.address
 }
 
+func (ch nftMetaURLChange) revert(s *StateDB) {
+	s.getStateObject(*ch.address).setMetaURL(ch.prev)
+}
+
+func (ch nftMetaURLChange) dirtied() *common.Address {
+	return ch.address
+}
+
+func (ch nftMetaURLFrozenChange) revert(s *StateDB) {
+	s.getStateObject(*ch.address).setMetaURLFrozen(ch.prev)
+}
+
+func (ch nftMetaURLFrozenChange) dirtied() *common.Address {
+	return ch.address
+}
+
 func (ch pledgedBalanceChange) revert(s *StateDB) {
 	s.getStateObject(*ch.account).setPledgedBalance(ch.prev)
 }
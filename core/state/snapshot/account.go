@@ -21,6 +21,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -57,7 +58,9 @@ type Account struct {
 	// Indicates the reward method chosen by the miner
 	//RewardFlag uint8 // 0:SNFT 1:ERB default:0
 	AccountNFT
-	Extra []byte
+	Extra             []byte
+	ExchangerSettings *types.ExchangerSettings `rlp:"optional"`
+	SNFTShares        *types.SNFTShares        `rlp:"optional"`
 }
 type AccountNFT struct {
 	//Account
@@ -74,10 +77,11 @@ type AccountNFT struct {
 	//PledgedFlag           bool
 	//NFTPledgedBlockNumber *big.Int
 
-	Creator   common.Address
-	Royalty   uint16
-	Exchanger common.Address
-	MetaURL   string
+	Creator       common.Address
+	Royalty       uint16
+	Exchanger     common.Address
+	MetaURL       string
+	MetaURLFrozen bool `rlp:"optional"`
 }
 
 // SlimAccount converts a state.Account content into a slim snapshot account
@@ -112,7 +116,10 @@ func SlimAccount(nonce uint64,
 	creator common.Address,
 	royalty uint16,
 	exchanger common.Address,
-	metaurl string) Account {
+	metaurl string,
+	metaurlfrozen bool,
+	exchangersettings *types.ExchangerSettings,
+	snftshares *types.SNFTShares) Account {
 	//func SlimAccount(nonce uint64, balance *big.Int, root common.Hash, codehash []byte) Account {
 	slim := Account{
 		Nonce:              nonce,
@@ -139,12 +146,15 @@ func SlimAccount(nonce uint64,
 			MergeNumber: mergenumber,
 			//PledgedFlag:           pledgedflag,
 			//NFTPledgedBlockNumber: nftpledgedblocknumber,
-			Creator:   creator,
-			Royalty:   royalty,
-			Exchanger: exchanger,
-			MetaURL:   metaurl,
+			Creator:       creator,
+			Royalty:       royalty,
+			Exchanger:     exchanger,
+			MetaURL:       metaurl,
+			MetaURLFrozen: metaurlfrozen,
 		},
 		//RewardFlag: rewardFlag,
+		ExchangerSettings: exchangersettings,
+		SNFTShares:        snftshares,
 	}
 	slim.ApproveAddressList = append(slim.ApproveAddressList, approveaddresslist...)
 	//slim.NFTApproveAddressList = append(slim.NFTApproveAddressList, nftapproveaddresslist...)
@@ -196,7 +206,10 @@ func SlimAccountRLP(nonce uint64,
 	creator common.Address,
 	royalty uint16,
 	exchanger common.Address,
-	metaurl string) []byte {
+	metaurl string,
+	metaurlfrozen bool,
+	exchangersettings *types.ExchangerSettings,
+	snftshares *types.SNFTShares) []byte {
 	data, err := rlp.EncodeToBytes(SlimAccount(nonce,
 		balance,
 		root,
@@ -229,6 +242,9 @@ func SlimAccountRLP(nonce uint64,
 		royalty,
 		exchanger,
 		metaurl,
+		metaurlfrozen,
+		exchangersettings,
+		snftshares,
 		//rewardFlag
 	))
 	//func SlimAccountRLP(nonce uint64, balance *big.Int, root common.Hash, codehash []byte) []byte {
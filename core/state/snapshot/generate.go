@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/ethdb/memorydb"
@@ -620,10 +621,11 @@ func (dl *diskLayer) generate(stats *generatorStats) {
 			//PledgedFlag           bool
 			//NFTPledgedBlockNumber *big.Int
 
-			Creator   common.Address
-			Royalty   uint16
-			Exchanger common.Address
-			MetaURL   string
+			Creator       common.Address
+			Royalty       uint16
+			Exchanger     common.Address
+			MetaURL       string
+			MetaURLFrozen bool `rlp:"optional"`
 		}
 		var acc struct {
 			Nonce    uint64
@@ -651,7 +653,9 @@ func (dl *diskLayer) generate(stats *generatorStats) {
 			//RewardFlag uint8
 			AccountNFT
 			//Owner common.Address
-			Extra []byte
+			Extra             []byte
+			ExchangerSettings *types.ExchangerSettings `rlp:"optional"`
+			SNFTShares        *types.SNFTShares        `rlp:"optional"`
 		}
 		if err := rlp.DecodeBytes(val, &acc); err != nil {
 			log.Crit("Invalid account encountered during snapshot creation", "err", err)
@@ -700,7 +704,10 @@ func (dl *diskLayer) generate(stats *generatorStats) {
 					acc.Creator,
 					acc.Royalty,
 					acc.Exchanger,
-					acc.MetaURL)
+					acc.MetaURL,
+					acc.MetaURLFrozen,
+					acc.ExchangerSettings,
+					acc.SNFTShares)
 				//data := SlimAccountRLP(acc.Nonce, acc.Balance, acc.Root, acc.CodeHash)
 				// *** modify to support nft transaction 20211217 end ***
 				dataLen = len(data)
@@ -26,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -118,7 +119,10 @@ func (s *stateObject) empty() bool {
 		bytes.Equal(s.data.Creator.Bytes(), common.Address{}.Bytes()) &&
 		s.data.Royalty == 0 &&
 		bytes.Equal(s.data.Exchanger.Bytes(), common.Address{}.Bytes()) &&
-		s.data.MetaURL == ""
+		s.data.MetaURL == "" &&
+		!s.data.MetaURLFrozen &&
+		s.data.ExchangerSettings == nil &&
+		s.data.SNFTShares == nil
 }
 
 // Account is the Ethereum consensus representation of accounts.
@@ -154,6 +158,12 @@ type Account struct {
 	//RewardFlag uint8 // 0:SNFT 1:ERB default:1
 	AccountNFT
 	Extra []byte
+	// ExchangerSettings is the configuration managed by the exchanger
+	// after it is opened, nil if the exchanger has never changed it.
+	ExchangerSettings *types.ExchangerSettings `rlp:"optional"`
+	// SNFTShares holds the supply of a fractionalized snft and the
+	// shares of fractionalized snfts held by the account.
+	SNFTShares *types.SNFTShares `rlp:"optional"`
 }
 
 // *** modify to support nft transaction 20211215 begin ***
@@ -186,6 +196,9 @@ type AccountNFT struct {
 	Royalty   uint16
 	Exchanger common.Address
 	MetaURL   string
+	// MetaURLFrozen is set once the creator freezes the metadata,
+	// MetaURL can't be updated any more after that.
+	MetaURLFrozen bool `rlp:"optional"`
 }
 
 // *** modify to support nft transaction 20211215 end ***
@@ -818,6 +831,9 @@ func (s *stateObject) CloseExchanger() {
 		return
 	}
 	s.SetExchangerInfo(false, big.NewInt(0), 0, "", "")
+	if s.data.ExchangerSettings != nil {
+		s.SetExchangerSettings(nil)
+	}
 }
 
 func (s *stateObject) SetExchangerInfo(exchangerflag bool, blocknumber *big.Int, feerate uint16, exchangername string, exchangerurl string) {
@@ -845,6 +861,127 @@ func (s *stateObject) setExchangerInfo(exchangerflag bool, blocknumber *big.Int,
 	s.data.ExchangerURL = exchangerurl
 }
 
+// UpdateExchanger changes the fee rate, name and url of the exchanger which
+// are set in update. A lower fee rate takes effect immediately, a higher one
+// after noticeinterval blocks.
+func (s *stateObject) UpdateExchanger(update *types.ExchangerUpdate, blocknumber *big.Int, noticeinterval uint64) {
+	settings := s.data.ExchangerSettings.Copy()
+	currentFeeRate := s.data.FeeRate
+	if settings.PendingFeeRateEffective(blocknumber) {
+		currentFeeRate = settings.PendingFeeRate
+		settings.PendingFeeRate = 0
+		settings.PendingFeeRateBlock = nil
+	}
+	if update.FeeRate != nil {
+		if feerate := *update.FeeRate; feerate > currentFeeRate {
+			settings.PendingFeeRate = feerate
+			settings.PendingFeeRateBlock = new(big.Int).Add(blocknumber, new(big.Int).SetUint64(noticeinterval))
+		} else {
+			currentFeeRate = feerate
+			settings.PendingFeeRate = 0
+			settings.PendingFeeRateBlock = nil
+		}
+	}
+	exchangername, exchangerurl := s.data.ExchangerName, s.data.ExchangerURL
+	if update.Name != nil {
+		exchangername = *update.Name
+	}
+	if update.URL != nil {
+		exchangerurl = *update.URL
+	}
+	s.SetExchangerInfo(s.data.ExchangerFlag, s.data.BlockNumber, currentFeeRate, exchangername, exchangerurl)
+	s.SetExchangerSettings(settings)
+}
+
+func (s *stateObject) SetCollectionFeeRate(creator common.Address, feerate uint16) {
+	settings := s.data.ExchangerSettings.Copy()
+	settings.SetCollectionFeeRate(creator, feerate)
+	s.SetExchangerSettings(settings)
+}
+
+func (s *stateObject) AddAllowedCreator(creator common.Address) bool {
+	settings := s.data.ExchangerSettings.Copy()
+	if !settings.AddAllowedCreator(creator) {
+		return false
+	}
+	s.SetExchangerSettings(settings)
+	return true
+}
+
+func (s *stateObject) RemoveAllowedCreator(creator common.Address) bool {
+	settings := s.data.ExchangerSettings.Copy()
+	if !settings.RemoveAllowedCreator(creator) {
+		return false
+	}
+	s.SetExchangerSettings(settings)
+	return true
+}
+
+// SetExchangerSettings replaces the exchanger settings, the settings must not
+// be modified afterwards because the journal keeps a reference to them.
+func (s *stateObject) SetExchangerSettings(settings *types.ExchangerSettings) {
+	s.db.journal.append(exchangerSettingsChange{
+		address: &s.address,
+		prev:    s.data.ExchangerSettings,
+	})
+	s.setExchangerSettings(settings)
+}
+
+func (s *stateObject) setExchangerSettings(settings *types.ExchangerSettings) {
+	if settings.IsEmpty() {
+		settings = nil
+	}
+	s.data.ExchangerSettings = settings
+}
+
+func (s *stateObject) GetExchangerSettings() *types.ExchangerSettings {
+	return s.data.ExchangerSettings
+}
+
+// SetSNFTShareSupply marks the snft as fractionalized into supply shares,
+// a supply of 0 releases it.
+func (s *stateObject) SetSNFTShareSupply(supply *big.Int) {
+	shares := s.data.SNFTShares.Copy()
+	shares.Supply = new(big.Int).Set(supply)
+	s.SetSNFTShares(shares)
+}
+
+func (s *stateObject) AddSNFTShares(nftaddress common.Address, amount *big.Int) {
+	shares := s.data.SNFTShares.Copy()
+	shares.AddBalance(nftaddress, amount)
+	s.SetSNFTShares(shares)
+}
+
+func (s *stateObject) SubSNFTShares(nftaddress common.Address, amount *big.Int) bool {
+	shares := s.data.SNFTShares.Copy()
+	if !shares.SubBalance(nftaddress, amount) {
+		return false
+	}
+	s.SetSNFTShares(shares)
+	return true
+}
+
+// SetSNFTShares replaces the snft shares, the shares must not be modified
+// afterwards because the journal keeps a reference to them.
+func (s *stateObject) SetSNFTShares(shares *types.SNFTShares) {
+	s.db.journal.append(snftSharesChange{
+		address: &s.address,
+		prev:    s.data.SNFTShares,
+	})
+	s.setSNFTShares(shares)
+}
+
+func (s *stateObject) setSNFTShares(shares *types.SNFTShares) {
+	if shares.IsEmpty() {
+		shares = nil
+	}
+	s.data.SNFTShares = shares
+}
+
+func (s *stateObject) GetSNFTShares() *types.SNFTShares {
+	return s.data.SNFTShares
+}
+
 func (s *stateObject) CleanNFT() {
 	//if s.data.NFTPledgedBlockNumber == nil {
 	//	s.data.NFTPledgedBlockNumber = big.NewInt(0)
@@ -1097,6 +1234,34 @@ func (s *stateObject) GetMetaURL() string {
 	return s.data.MetaURL
 }
 
+func (s *stateObject) SetMetaURL(metaURL string) {
+	s.db.journal.append(nftMetaURLChange{
+		address: &s.address,
+		prev:    s.data.MetaURL,
+	})
+	s.setMetaURL(metaURL)
+}
+
+func (s *stateObject) setMetaURL(metaURL string) {
+	s.data.MetaURL = metaURL
+}
+
+func (s *stateObject) GetMetaURLFrozen() bool {
+	return s.data.MetaURLFrozen
+}
+
+func (s *stateObject) SetMetaURLFrozen(frozen bool) {
+	s.db.journal.append(nftMetaURLFrozenChange{
+		address: &s.address,
+		prev:    s.data.MetaURLFrozen,
+	})
+	s.setMetaURLFrozen(frozen)
+}
+
+func (s *stateObject) setMetaURLFrozen(frozen bool) {
+	s.data.MetaURLFrozen = frozen
+}
+
 func (s *stateObject) PledgedBalance() *big.Int {
 	if s.data.PledgedBalance == nil {
 		return big.NewInt(0)
@@ -136,8 +136,12 @@ type StateDB struct {
 	//SNFTExchangePool     *types.SNFTExchangeList
 	PledgedTokenPool     []*types.PledgedToken
 	ExchangerTokenPool   []*types.PledgedToken
+	// ExchangerVolumePool records the trades brokered by exchangers in the block
+	ExchangerVolumePool  []*types.ExchangerVolume
 	OfficialNFTPool      *types.InjectedOfficialNFTList
 	NominatedOfficialNFT *types.NominatedOfficialNFT
+	// OfficialNFTProposals holds the official nft proposals not elected yet
+	OfficialNFTProposals *types.OfficialNFTProposalList
 
 	ValidatorPool []*types.Validator
 }
@@ -529,7 +533,10 @@ func (s *StateDB) updateStateObject(obj *stateObject) {
 			obj.data.Creator,
 			obj.data.Royalty,
 			obj.data.Exchanger,
-			obj.data.MetaURL)
+			obj.data.MetaURL,
+			obj.data.MetaURLFrozen,
+			obj.data.ExchangerSettings,
+			obj.data.SNFTShares)
 		//s.snapAccounts[obj.addrHash] = snapshot.SlimAccountRLP(obj.data.Nonce, obj.data.Balance, obj.data.Root, obj.data.CodeHash)
 	}
 }
@@ -597,7 +604,9 @@ func (s *StateDB) getDeletedStateObject(addr common.Address) *stateObject {
 				ExchangerName:      acc.ExchangerName,
 				ExchangerURL:       acc.ExchangerURL,
 				//NFTBalance:         acc.NFTBalance,
-				Extra: acc.Extra,
+				Extra:             acc.Extra,
+				ExchangerSettings: acc.ExchangerSettings,
+				SNFTShares:        acc.SNFTShares,
 				//RewardFlag:         acc.RewardFlag,
 				// *** modify to support nft transaction 20211217 begin ***
 				AccountNFT: AccountNFT{
@@ -610,10 +619,11 @@ func (s *StateDB) getDeletedStateObject(addr common.Address) *stateObject {
 					MergeNumber: acc.MergeNumber,
 					//PledgedFlag:           acc.PledgedFlag,
 					//NFTPledgedBlockNumber: acc.NFTPledgedBlockNumber,
-					Creator:   acc.Creator,
-					Royalty:   acc.Royalty,
-					Exchanger: acc.Exchanger,
-					MetaURL:   acc.MetaURL,
+					Creator:       acc.Creator,
+					Royalty:       acc.Royalty,
+					Exchanger:     acc.Exchanger,
+					MetaURL:       acc.MetaURL,
+					MetaURLFrozen: acc.MetaURLFrozen,
 				},
 				// *** modify to support nft transaction 20211217 end ***
 			}
@@ -839,6 +849,7 @@ func (s *StateDB) Copy() *StateDB {
 		//SNFTExchangePool:     new(types.SNFTExchangeList),
 		PledgedTokenPool:     make([]*types.PledgedToken, 0),
 		ExchangerTokenPool:   make([]*types.PledgedToken, 0),
+		ExchangerVolumePool:  make([]*types.ExchangerVolume, 0),
 		OfficialNFTPool:      new(types.InjectedOfficialNFTList),
 		NominatedOfficialNFT: new(types.NominatedOfficialNFT),
 	}
@@ -973,6 +984,7 @@ func (s *StateDB) Copy() *StateDB {
 			pledgedToken.Amount = new(big.Int).Set(v.Amount)
 			pledgedToken.Flag = v.Flag
 			pledgedToken.ProxyAddress = v.ProxyAddress
+			pledgedToken.BLSPubKey = common.CopyBytes(v.BLSPubKey)
 			state.PledgedTokenPool = append(state.PledgedTokenPool, &pledgedToken)
 		}
 	}
@@ -986,6 +998,13 @@ func (s *StateDB) Copy() *StateDB {
 			state.ExchangerTokenPool = append(state.ExchangerTokenPool, &exchangerToken)
 		}
 	}
+
+	for _, v := range s.ExchangerVolumePool {
+		state.ExchangerVolumePool = append(state.ExchangerVolumePool, &types.ExchangerVolume{
+			Address: v.Address,
+			Amount:  new(big.Int).Set(v.Amount),
+		})
+	}
 	if s.NominatedOfficialNFT != nil {
 		state.NominatedOfficialNFT.Dir = s.NominatedOfficialNFT.Dir
 		state.NominatedOfficialNFT.StartIndex = new(big.Int).Set(s.NominatedOfficialNFT.StartIndex)
@@ -994,14 +1013,18 @@ func (s *StateDB) Copy() *StateDB {
 		state.NominatedOfficialNFT.Creator = s.NominatedOfficialNFT.Creator
 		state.NominatedOfficialNFT.Address = s.NominatedOfficialNFT.Address
 	}
+	if s.OfficialNFTProposals != nil {
+		state.OfficialNFTProposals = s.OfficialNFTProposals.Copy()
+	}
 
 	state.ValidatorPool = make([]*types.Validator, 0)
 	if s.ValidatorPool != nil && len(s.ValidatorPool) < 0 {
 		for _, v := range s.ValidatorPool {
 			a := types.Validator{
-				Addr:    v.Addr,
-				Proxy:   v.Proxy,
-				Balance: v.Balance,
+				Addr:      v.Addr,
+				Proxy:     v.Proxy,
+				Balance:   v.Balance,
+				BLSPubKey: v.BLSPubKey,
 			}
 			state.ValidatorPool = append(state.ValidatorPool, &a)
 		}
@@ -1304,6 +1327,48 @@ func (s *StateDB) SlotInAccessList(addr common.Address, slot common.Hash) (addre
 
 // *** modify to support nft transaction 20211215 begin ***
 
+// ConstructMetadataUpdateLog builds an EIP-4906 style log for a nft whose metaurl changed
+func (s *StateDB) ConstructMetadataUpdateLog(nftAddress common.Address, blockNumber *big.Int) *types.Log {
+	//event hash: MetadataUpdate(uint256 _tokenId)
+	hash1 := common.HexToHash("f8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7")
+
+	log := &types.Log{
+		Address: nftAddress,
+		Topics: []common.Hash{
+			hash1,
+		},
+		Data:        common.LeftPadBytes(nftAddress.Bytes(), 32),
+		BlockNumber: blockNumber.Uint64(),
+	}
+
+	return log
+}
+
+// ConstructPermanentURILog builds the log for a nft whose metaurl has been frozen
+func (s *StateDB) ConstructPermanentURILog(nftAddress common.Address, metaurl string, blockNumber *big.Int) *types.Log {
+	//event hash: PermanentURI(string _value, uint256 indexed _id)
+	hash1 := common.HexToHash("a109ba539900bf1b633f956d63c96fc89b814c7287f7aa50a9216d0b55657207")
+	hash2 := common.BytesToHash(nftAddress.Bytes())
+
+	// abi encoding of a single dynamic string: offset, length, padded data
+	data := make([]byte, 0, 64+(len(metaurl)+31)/32*32)
+	data = append(data, common.LeftPadBytes(big.NewInt(32).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(int64(len(metaurl))).Bytes(), 32)...)
+	data = append(data, common.RightPadBytes([]byte(metaurl), (len(metaurl)+31)/32*32)...)
+
+	log := &types.Log{
+		Address: nftAddress,
+		Topics: []common.Hash{
+			hash1,
+			hash2,
+		},
+		Data:        data,
+		BlockNumber: blockNumber.Uint64(),
+	}
+
+	return log
+}
+
 // ChangeNFTOwner change nft's owner to newOwner.
 //func (s *StateDB) ChangeNFTOwner(nftAddr common.Address, newOwner common.Address) {
 //	stateObject := s.GetOrNewStateObject(nftAddr)
@@ -1749,6 +1814,10 @@ func (s *StateDB) IsCanMergeNFT16(nftAddr common.Address) bool {
 	if nftStateObject == nil {
 		return false
 	}
+	// a fractionalized snft is locked until it is redeemed
+	if nftStateObject.GetSNFTShares().IsFractionalized() {
+		return false
+	}
 	mergeLevel := nftStateObject.GetNFTMergeLevel()
 	if mergeLevel >= QUERYDEPTHLIMIT16 {
 		return false
@@ -2261,7 +2330,11 @@ func GetRewardAmount(blocknumber uint64, initamount *big.Int) *big.Int {
 	return new(big.Int).SetUint64(u)
 }
 
-func (s *StateDB) CreateNFTByOfficial16(validators, exchangers []common.Address, blocknumber *big.Int) {
+// CreateNFTByOfficial16 rewards erb to the validators and snfts to the
+// exchangers. When the injected snfts run low the next official nft collection
+// is elected, by proposal voting if proposalVoting is set and by nomination
+// otherwise.
+func (s *StateDB) CreateNFTByOfficial16(validators, exchangers []common.Address, blocknumber *big.Int, proposalVoting bool) {
 
 	// reward ERB or SNFT to validators
 	log.Info("CreateNFTByOfficial16", "validators len=", len(validators), "blocknumber=", blocknumber.Uint64())
@@ -2346,7 +2419,11 @@ func (s *StateDB) CreateNFTByOfficial16(validators, exchangers []common.Address,
 	}
 
 	if s.OfficialNFTPool.RemainderNum(s.MintDeep.OfficialMint) <= 110 {
-		s.ElectNominatedOfficialNFT(blocknumber)
+		if proposalVoting {
+			s.ElectOfficialNFTProposal(blocknumber)
+		} else {
+			s.ElectNominatedOfficialNFT(blocknumber)
+		}
 	}
 }
 
@@ -2504,6 +2581,99 @@ func (s *StateDB) ExchangeNFTToCurrency(address common.Address,
 	}
 }
 
+// FractionalizeSNFT locks the snft of owner in the SNFTSharesVault and mints
+// SNFTShareSupply shares of it to owner, the value of the snft is removed
+// from the voteweight of owner until the snft is redeemed.
+func (s *StateDB) FractionalizeSNFT(owner common.Address, nftaddress common.Address) {
+	nftStateObject := s.GetOrNewStateObject(nftaddress)
+	ownerStateObject := s.GetOrNewStateObject(owner)
+	if nftStateObject == nil || ownerStateObject == nil {
+		return
+	}
+	initAmount := s.calculateExchangeAmount(nftStateObject.GetNFTMergeLevel(), nftStateObject.GetMergeNumber())
+	amount := s.GetExchangAmount(nftaddress, initAmount)
+	if ownerStateObject.VoteWeight().Cmp(amount) < 0 {
+		log.Error("StateDB.FractionalizeSNFT()", "owner's voteweight less nft's value")
+		amount.Set(ownerStateObject.VoteWeight())
+	}
+	ownerStateObject.SubVoteWeight(amount)
+
+	nftStateObject.ChangeNFTOwner(types.SNFTSharesVault)
+	nftStateObject.SetSNFTShareSupply(types.SNFTShareSupply)
+	ownerStateObject.AddSNFTShares(nftaddress, types.SNFTShareSupply)
+}
+
+// RedeemSNFT burns all shares of the fractionalized snft held by holder and
+// releases the snft to holder, it returns false if holder doesn't hold the
+// whole supply.
+func (s *StateDB) RedeemSNFT(holder common.Address, nftaddress common.Address, blocknumber *big.Int) bool {
+	nftStateObject := s.getStateObject(nftaddress)
+	holderStateObject := s.GetOrNewStateObject(holder)
+	if nftStateObject == nil || holderStateObject == nil {
+		return false
+	}
+	supply := nftStateObject.GetSNFTShares()
+	if !supply.IsFractionalized() {
+		return false
+	}
+	if holderStateObject.GetSNFTShares().Balance(nftaddress).Cmp(supply.Supply) != 0 {
+		return false
+	}
+	holderStateObject.SubSNFTShares(nftaddress, supply.Supply)
+	nftStateObject.SetSNFTShareSupply(big.NewInt(0))
+
+	nftStateObject.ChangeNFTOwner(holder)
+	initAmount := s.calculateExchangeAmount(nftStateObject.GetNFTMergeLevel(), nftStateObject.GetMergeNumber())
+	amount := s.GetExchangAmount(nftaddress, initAmount)
+	// merge nft automatically
+	increaseValue, _ := s.MergeNFT16(nftaddress, blocknumber)
+	holderStateObject.AddVoteWeight(new(big.Int).Add(amount, increaseValue))
+	return true
+}
+
+// TransferSNFTShares moves amount shares of the fractionalized snft from one
+// account to another, it returns false if from holds too few shares.
+func (s *StateDB) TransferSNFTShares(from common.Address, to common.Address, nftaddress common.Address, amount *big.Int) bool {
+	fromStateObject := s.GetOrNewStateObject(from)
+	toStateObject := s.GetOrNewStateObject(to)
+	if fromStateObject == nil || toStateObject == nil {
+		return false
+	}
+	if !fromStateObject.SubSNFTShares(nftaddress, amount) {
+		return false
+	}
+	toStateObject.AddSNFTShares(nftaddress, amount)
+	return true
+}
+
+// GetSNFTShareSupply returns the number of shares of a fractionalized snft,
+// 0 if the snft is not fractionalized.
+func (s *StateDB) GetSNFTShareSupply(nftaddress common.Address) *big.Int {
+	stateObject := s.getStateObject(nftaddress)
+	if stateObject != nil && stateObject.GetSNFTShares().IsFractionalized() {
+		return new(big.Int).Set(stateObject.GetSNFTShares().Supply)
+	}
+	return big.NewInt(0)
+}
+
+// GetSNFTShares returns the shares of the snft nftaddress held by addr.
+func (s *StateDB) GetSNFTShares(addr common.Address, nftaddress common.Address) *big.Int {
+	stateObject := s.getStateObject(addr)
+	if stateObject != nil {
+		return stateObject.GetSNFTShares().Balance(nftaddress)
+	}
+	return big.NewInt(0)
+}
+
+// GetSNFTShareBalances returns all the shares held by addr.
+func (s *StateDB) GetSNFTShareBalances(addr common.Address) []types.SNFTShareBalance {
+	stateObject := s.getStateObject(addr)
+	if stateObject != nil {
+		return stateObject.GetSNFTShares().Copy().Balances
+	}
+	return nil
+}
+
 func (s *StateDB) GetExchangAmount(nftaddress common.Address, initamount *big.Int) *big.Int {
 	nftInt := new(big.Int).SetBytes(nftaddress.Bytes())
 	baseInt, _ := big.NewInt(0).SetString("8000000000000000000000000000000000000000", 16)
@@ -2687,6 +2857,31 @@ func (s *StateDB) MinerConsign(address common.Address, proxy common.Address) err
 	return nil
 }
 
+// RegisterBLSPubKey registers the bls public key of the validator address,
+// the key replaces the previous one when the validator list of the block is
+// written.
+func (s *StateDB) RegisterBLSPubKey(address common.Address, pubKey []byte) error {
+	existAddress := false
+	for _, v := range s.ValidatorPool {
+		if v.Addr == address {
+			existAddress = true
+			break
+		}
+	}
+	if !existAddress {
+		log.Info("RegisterBLSPubKey", "address", address, "err", "not a validator")
+		return errors.New("not a validator")
+	}
+	pledgeToken := types.PledgedToken{
+		Address:   address,
+		Amount:    big.NewInt(0),
+		Flag:      true,
+		BLSPubKey: common.CopyBytes(pubKey),
+	}
+	s.PledgedTokenPool = append(s.PledgedTokenPool, &pledgeToken)
+	return nil
+}
+
 //- cancel pledged token
 //````
 //{
@@ -2803,6 +2998,16 @@ func (s *StateDB) SubExchangerToken(address common.Address, amount *big.Int) {
 	}
 }
 
+// AddExchangerVolume records a trade of amount brokered by the exchanger, it
+// is used to maintain the cumulative trade volume of the exchangers index.
+func (s *StateDB) AddExchangerVolume(address common.Address, amount *big.Int) {
+	s.journal.append(exchangerVolumeChange{})
+	s.ExchangerVolumePool = append(s.ExchangerVolumePool, &types.ExchangerVolume{
+		Address: address,
+		Amount:  new(big.Int).Set(amount),
+	})
+}
+
 func (s *StateDB) SubExchangerBalance(address common.Address, amount *big.Int) {
 	stateObject := s.GetOrNewStateObject(address)
 	if stateObject != nil {
@@ -2894,6 +3099,82 @@ func (s *StateDB) GetExchangerURL(addr common.Address) string {
 	}
 	return ""
 }
+
+// GetExchangerFeeRate returns the fee rate that the exchanger charges for the
+// nfts of creator at blocknumber, taking pending fee rate increases and
+// collection fee rates into account.
+func (s *StateDB) GetExchangerFeeRate(addr common.Address, creator common.Address, blocknumber *big.Int) uint16 {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject != nil {
+		return stateObject.GetExchangerSettings().FeeRate(stateObject.GetFeeRate(), creator, blocknumber)
+	}
+	return 0
+}
+
+func (s *StateDB) IsAllowedCreator(addr common.Address, creator common.Address) bool {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject != nil {
+		return stateObject.GetExchangerSettings().IsAllowedCreator(creator)
+	}
+	return true
+}
+
+func (s *StateDB) GetExchangerSettings(addr common.Address) *types.ExchangerSettings {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject != nil {
+		return stateObject.GetExchangerSettings().Copy()
+	}
+	return nil
+}
+
+//- update exchanger:
+//````
+//{
+//from: exchanger
+//to:0xffff...ffff
+//balance:0
+//data:{
+//version:0
+//type:34
+//fields:the updated fields, any of "fee_rate", "name" and "url"
+//feeRate:the new ratio that exchanger can get
+//name:exchanger name
+//url:exchanger url
+//}
+//}
+//````
+func (s *StateDB) UpdateExchanger(addr common.Address,
+	update *types.ExchangerUpdate,
+	blocknumber *big.Int,
+	noticeinterval uint64) {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.UpdateExchanger(update, blocknumber, noticeinterval)
+	}
+}
+
+func (s *StateDB) SetCollectionFeeRate(addr common.Address, creator common.Address, feerate uint16) {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetCollectionFeeRate(creator, feerate)
+	}
+}
+
+func (s *StateDB) AddAllowedCreator(addr common.Address, creator common.Address) bool {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject != nil {
+		return stateObject.AddAllowedCreator(creator)
+	}
+	return false
+}
+
+func (s *StateDB) RemoveAllowedCreator(addr common.Address, creator common.Address) bool {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject != nil {
+		return stateObject.RemoveAllowedCreator(creator)
+	}
+	return false
+}
 func (s *StateDB) GetApproveAddress(addr common.Address) []common.Address {
 	stateObject := s.GetOrNewStateObject(addr)
 	if stateObject != nil {
@@ -2975,6 +3256,33 @@ func (s *StateDB) GetNFTMetaURL(addr common.Address) string {
 	return ""
 }
 
+// GetNFTMetaURLFrozen returns true if the metadata of the nft has been frozen
+func (s *StateDB) GetNFTMetaURLFrozen(addr common.Address) bool {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject != nil {
+		return stateObject.GetMetaURLFrozen()
+	}
+	return false
+}
+
+// UpdateNFTMetaURL replaces the metaurl of a nft and emits a MetadataUpdate log.
+func (s *StateDB) UpdateNFTMetaURL(nftAddr common.Address, metaurl string, blocknumber *big.Int) {
+	stateObject := s.GetOrNewStateObject(nftAddr)
+	if stateObject != nil {
+		stateObject.SetMetaURL(metaurl)
+		s.AddLog(s.ConstructMetadataUpdateLog(nftAddr, blocknumber))
+	}
+}
+
+// FreezeNFTMetaURL freezes the metaurl of a nft and emits a PermanentURI log.
+func (s *StateDB) FreezeNFTMetaURL(nftAddr common.Address, blocknumber *big.Int) {
+	stateObject := s.GetOrNewStateObject(nftAddr)
+	if stateObject != nil {
+		stateObject.SetMetaURLFrozen(true)
+		s.AddLog(s.ConstructPermanentURILog(nftAddr, stateObject.GetMetaURL(), blocknumber))
+	}
+}
+
 func (s *StateDB) GetMergeNumber(addr common.Address) uint32 {
 	stateObject := s.GetOrNewStateObject(addr)
 	if stateObject != nil {
@@ -3157,9 +3465,8 @@ func (s *StateDB) ElectNominatedOfficialNFT(blocknumber *big.Int) {
 		//s.SubVoteWeight(s.NominatedOfficialNFT.Address, voteWeight)
 		s.SetVoteBlockNumber(s.NominatedOfficialNFT.Address, blocknumber)
 
-		InjectRewardAddress := common.HexToAddress("0xFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF")
-		injectRewardBalance := s.GetBalance(InjectRewardAddress)
-		s.SubBalance(InjectRewardAddress, injectRewardBalance)
+		injectRewardBalance := s.GetBalance(types.InjectRewardAddress)
+		s.SubBalance(types.InjectRewardAddress, injectRewardBalance)
 		s.AddBalance(s.NominatedOfficialNFT.Address, injectRewardBalance)
 
 		////s.NominatedOfficialNFT = nil
@@ -3188,6 +3495,148 @@ func (s *StateDB) ElectNominatedOfficialNFT(blocknumber *big.Int) {
 	s.NominatedOfficialNFT.Address = common.Address{}
 }
 
+// ProposeOfficialNFT opens a proposal of an official nft collection, the
+// proposer casts the first ballot.
+func (s *StateDB) ProposeOfficialNFT(nominatedOfficialNFT *types.NominatedOfficialNFT, blocknumber *big.Int) error {
+	proposals := s.officialNFTProposals()
+	proposer := nominatedOfficialNFT.Address
+	if proposals.GetOpenProposalByProposer(proposer, blocknumber) != nil {
+		return errors.New("proposer has an open proposal")
+	}
+	if len(proposals.Proposals) >= types.MaxOfficialNFTProposals {
+		return errors.New("too many official nft proposals")
+	}
+	if stake := s.GetPledgedBalance(proposer); stake == nil || stake.Sign() <= 0 {
+		return errors.New("pledged balance is 0")
+	}
+
+	proposal := &types.OfficialNFTProposal{
+		InjectedOfficialNFT: types.InjectedOfficialNFT{
+			Dir:        nominatedOfficialNFT.Dir,
+			StartIndex: new(big.Int).Set(nominatedOfficialNFT.StartIndex),
+			Number:     nominatedOfficialNFT.Number,
+			Royalty:    nominatedOfficialNFT.Royalty,
+			Creator:    nominatedOfficialNFT.Creator,
+			Address:    proposer,
+		},
+	}
+	s.journalOfficialNFTProposals()
+	proposals = s.OfficialNFTProposals
+	proposals.AddProposal(proposal, blocknumber)
+	proposal.Vote(proposer)
+	log.Info("ProposeOfficialNFT()", "proposal", proposal.ID, "proposer", proposer.Hex(),
+		"endblock", proposal.EndBlock.Uint64(), "blocknumber", blocknumber.Uint64())
+	s.updateNominatedOfficialNFT(blocknumber)
+	return nil
+}
+
+// VoteOfficialNFTProposal casts the ballot of voter on an open proposal, the
+// ballot is weighted by the pledged balance of voter when it is tallied.
+func (s *StateDB) VoteOfficialNFTProposal(voter common.Address, id uint64, blocknumber *big.Int) error {
+	proposal := s.officialNFTProposals().GetProposal(id)
+	if proposal == nil {
+		return errors.New("not exist official nft proposal")
+	}
+	if !proposal.IsOpen(blocknumber) {
+		return errors.New("official nft proposal is closed")
+	}
+	if stake := s.GetPledgedBalance(voter); stake == nil || stake.Sign() <= 0 {
+		return errors.New("pledged balance is 0")
+	}
+	s.journalOfficialNFTProposals()
+	proposal = s.OfficialNFTProposals.GetProposal(id)
+	proposal.Vote(voter)
+	s.updateNominatedOfficialNFT(blocknumber)
+	return nil
+}
+
+// ElectOfficialNFTProposal injects the closed proposal with the highest tally
+// whose voters pledged the quorum of the validator stake, or the default
+// collection if there is none.
+func (s *StateDB) ElectOfficialNFTProposal(blocknumber *big.Int) {
+	totalStake := big.NewInt(0)
+	for _, validator := range s.ValidatorPool {
+		totalStake.Add(totalStake, validator.Balance)
+	}
+	elected := s.officialNFTProposals().Elect(blocknumber, s.GetPledgedBalance, totalStake)
+	if elected != nil {
+		injectNFT := &types.InjectedOfficialNFT{
+			Dir: elected.Dir,
+			// the collection follows the last injected one, other
+			// proposals may have been injected since it was proposed
+			StartIndex: new(big.Int).Set(s.OfficialNFTPool.MaxIndex()),
+			Number:     elected.Number,
+			Royalty:    elected.Royalty,
+			Creator:    elected.Creator,
+			Address:    elected.Address,
+			VoteWeight: elected.Tally(s.GetPledgedBalance),
+		}
+		log.Info("ElectOfficialNFTProposal()", "proposal", elected.ID, "proposer", elected.Address.Hex(),
+			"tally", injectNFT.VoteWeight, "voters", len(elected.Ballots), "blocknumber", blocknumber.Uint64())
+		s.OfficialNFTPool.InjectedOfficialNFTs = append(s.OfficialNFTPool.InjectedOfficialNFTs, injectNFT)
+		s.SetVoteBlockNumber(elected.Address, blocknumber)
+
+		injectRewardBalance := s.GetBalance(types.InjectRewardAddress)
+		s.SubBalance(types.InjectRewardAddress, injectRewardBalance)
+		s.AddBalance(elected.Address, injectRewardBalance)
+	} else {
+		injectNFT := &types.InjectedOfficialNFT{
+			Dir:        types.DefaultDir,
+			StartIndex: new(big.Int).Set(s.OfficialNFTPool.MaxIndex()),
+			Number:     types.DefaultNumber,
+			Royalty:    types.DefaultRoyalty,
+			Creator:    types.DefaultCreator,
+		}
+		s.OfficialNFTPool.InjectedOfficialNFTs = append(s.OfficialNFTPool.InjectedOfficialNFTs, injectNFT)
+	}
+
+	s.updateNominatedOfficialNFT(blocknumber)
+}
+
+// journalOfficialNFTProposals saves the proposals and the nominated official
+// nft in the journal, so that a reverted vote is undone.
+func (s *StateDB) journalOfficialNFTProposals() {
+	change := officialNFTProposalsChange{
+		prev: s.officialNFTProposals(),
+	}
+	if s.NominatedOfficialNFT != nil {
+		nominated := *s.NominatedOfficialNFT
+		change.prevNominated = &nominated
+	}
+	s.journal.append(change)
+	s.OfficialNFTProposals = change.prev.Copy()
+}
+
+func (s *StateDB) officialNFTProposals() *types.OfficialNFTProposalList {
+	if s.OfficialNFTProposals == nil {
+		s.OfficialNFTProposals = types.NewOfficialNFTProposalList()
+	}
+	return s.OfficialNFTProposals
+}
+
+// updateNominatedOfficialNFT sets NominatedOfficialNFT to the leading open
+// proposal, or to the default collection if no proposal is open.
+func (s *StateDB) updateNominatedOfficialNFT(blocknumber *big.Int) {
+	if s.NominatedOfficialNFT == nil {
+		s.NominatedOfficialNFT = new(types.NominatedOfficialNFT)
+	}
+	if leading := s.officialNFTProposals().Leading(blocknumber, s.GetPledgedBalance); leading != nil {
+		s.NominatedOfficialNFT.Dir = leading.Dir
+		s.NominatedOfficialNFT.StartIndex = new(big.Int).Set(s.OfficialNFTPool.MaxIndex())
+		s.NominatedOfficialNFT.Number = leading.Number
+		s.NominatedOfficialNFT.Royalty = leading.Royalty
+		s.NominatedOfficialNFT.Creator = leading.Creator
+		s.NominatedOfficialNFT.Address = leading.Address
+		return
+	}
+	s.NominatedOfficialNFT.Dir = types.DefaultDir
+	s.NominatedOfficialNFT.StartIndex = new(big.Int).Set(s.OfficialNFTPool.MaxIndex())
+	s.NominatedOfficialNFT.Number = types.DefaultNumber
+	s.NominatedOfficialNFT.Royalty = types.DefaultRoyalty
+	s.NominatedOfficialNFT.Creator = types.DefaultCreator
+	s.NominatedOfficialNFT.Address = common.Address{}
+}
+
 // AddVoteWeight adds amount to the VoteWeight associated with addr.
 func (s *StateDB) AddVoteWeight(addr common.Address, amount *big.Int) {
 	stateObject := s.GetOrNewStateObject(addr)
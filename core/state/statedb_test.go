@@ -33,6 +33,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 // Tests that updating a state trie does not leak any database writes prior to
@@ -1558,3 +1559,243 @@ func TestGetExistAddress2(t *testing.T) {
 	existAddress := state.GetExistAddress(nftAddress, 2)
 	t.Log("exist address=", existAddress.String())
 }
+
+func TestNFTMetaURLUpdateAndFreeze(t *testing.T) {
+	state, _ := New(common.Hash{}, NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	state.MintDeep = new(types.MintDeep)
+	state.MintDeep.UserMint = big.NewInt(1)
+
+	creator := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	nftAddr, ok := state.CreateNFTByUser(common.Address{}, creator, 100, "/ipfs/old")
+	if !ok {
+		t.Fatal("failed to create nft")
+	}
+
+	snap := state.Snapshot()
+	state.UpdateNFTMetaURL(nftAddr, "/ipfs/new", big.NewInt(1))
+	if got := state.GetNFTMetaURL(nftAddr); got != "/ipfs/new" {
+		t.Fatalf("metaurl mismatch: have %s, want %s", got, "/ipfs/new")
+	}
+	state.FreezeNFTMetaURL(nftAddr, big.NewInt(1))
+	if !state.GetNFTMetaURLFrozen(nftAddr) {
+		t.Fatal("metaurl should be frozen")
+	}
+	if logs := state.Logs(); len(logs) != 2 {
+		t.Fatalf("log count mismatch: have %d, want 2", len(logs))
+	}
+
+	state.RevertToSnapshot(snap)
+	if got := state.GetNFTMetaURL(nftAddr); got != "/ipfs/old" {
+		t.Fatalf("metaurl not reverted: have %s, want %s", got, "/ipfs/old")
+	}
+	if state.GetNFTMetaURLFrozen(nftAddr) {
+		t.Fatal("frozen flag not reverted")
+	}
+}
+
+func TestAccountMetaURLFrozenRLPCompat(t *testing.T) {
+	acc := Account{Balance: big.NewInt(1), AccountNFT: AccountNFT{MetaURL: "/ipfs/x"}}
+	plain, err := rlp.EncodeToBytes(&acc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acc.MetaURLFrozen = true
+	frozen, err := rlp.EncodeToBytes(&acc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(plain, frozen) {
+		t.Fatal("frozen flag not encoded")
+	}
+	var dec Account
+	if err := rlp.DecodeBytes(plain, &dec); err != nil {
+		t.Fatal(err)
+	}
+	if dec.MetaURLFrozen {
+		t.Fatal("unfrozen account decoded as frozen")
+	}
+	if err := rlp.DecodeBytes(frozen, &dec); err != nil {
+		t.Fatal(err)
+	}
+	if !dec.MetaURLFrozen {
+		t.Fatal("frozen account decoded as unfrozen")
+	}
+}
+
+func TestExchangerSettings(t *testing.T) {
+	state, _ := New(common.Hash{}, NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	exchanger := common.HexToAddress("0x0000000000000000000000000000000000000e01")
+	creator := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	other := common.HexToAddress("0x0000000000000000000000000000000000000def")
+	state.AddBalance(exchanger, big.NewInt(100))
+	state.OpenExchanger(exchanger, big.NewInt(100), big.NewInt(1), 100, "name", "url")
+
+	// a fee rate increase only takes effect after the notice interval
+	feeRate, url := uint16(200), "newurl"
+	state.UpdateExchanger(exchanger, &types.ExchangerUpdate{FeeRate: &feeRate, URL: &url}, big.NewInt(10), 5)
+	if got := state.GetExchangerFeeRate(exchanger, creator, big.NewInt(14)); got != 100 {
+		t.Fatalf("fee rate mismatch before notice interval: have %d, want 100", got)
+	}
+	if got := state.GetExchangerFeeRate(exchanger, creator, big.NewInt(15)); got != 200 {
+		t.Fatalf("fee rate mismatch after notice interval: have %d, want 200", got)
+	}
+	if state.GetExchangerName(exchanger) != "name" || state.GetExchangerURL(exchanger) != "newurl" {
+		t.Fatal("exchanger name or url not updated")
+	}
+	// a decrease takes effect immediately and drops the pending increase
+	feeRate = 50
+	state.UpdateExchanger(exchanger, &types.ExchangerUpdate{FeeRate: &feeRate}, big.NewInt(11), 5)
+	if got := state.GetExchangerFeeRate(exchanger, creator, big.NewInt(20)); got != 50 {
+		t.Fatalf("fee rate mismatch after decrease: have %d, want 50", got)
+	}
+
+	snap := state.Snapshot()
+	state.SetCollectionFeeRate(exchanger, creator, 10)
+	if got := state.GetExchangerFeeRate(exchanger, creator, big.NewInt(20)); got != 10 {
+		t.Fatalf("collection fee rate mismatch: have %d, want 10", got)
+	}
+	if got := state.GetExchangerFeeRate(exchanger, other, big.NewInt(20)); got != 50 {
+		t.Fatalf("fee rate mismatch for other collection: have %d, want 50", got)
+	}
+	if !state.IsAllowedCreator(exchanger, other) {
+		t.Fatal("empty allowlist should allow all creators")
+	}
+	if !state.AddAllowedCreator(exchanger, creator) || state.AddAllowedCreator(exchanger, creator) {
+		t.Fatal("unexpected result adding allowed creator")
+	}
+	if !state.IsAllowedCreator(exchanger, creator) || state.IsAllowedCreator(exchanger, other) {
+		t.Fatal("allowlist not applied")
+	}
+
+	state.RevertToSnapshot(snap)
+	if settings := state.GetExchangerSettings(exchanger); !settings.IsEmpty() {
+		t.Fatalf("exchanger settings not reverted: %+v", settings)
+	}
+	if got := state.GetExchangerFeeRate(exchanger, creator, big.NewInt(20)); got != 50 {
+		t.Fatalf("fee rate mismatch after revert: have %d, want 50", got)
+	}
+
+	// a zero fee rate and an empty name are updates, not unchanged fields
+	feeRate, name := uint16(0), ""
+	state.UpdateExchanger(exchanger, &types.ExchangerUpdate{FeeRate: &feeRate, Name: &name}, big.NewInt(21), 5)
+	if got := state.GetExchangerFeeRate(exchanger, creator, big.NewInt(21)); got != 0 {
+		t.Fatalf("fee rate mismatch after lowering to zero: have %d, want 0", got)
+	}
+	if state.GetExchangerName(exchanger) != "" || state.GetExchangerURL(exchanger) != "newurl" {
+		t.Fatal("exchanger name or url mismatch after clearing the name")
+	}
+}
+
+func TestSNFTShares(t *testing.T) {
+	state, _ := New(common.Hash{}, NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	owner := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	buyer := common.HexToAddress("0x0000000000000000000000000000000000000a02")
+	nftAddr := common.HexToAddress("0x8000000000000000000000000000000000000100")
+	state.CreateAccount(nftAddr)
+	nftObject := state.getStateObject(nftAddr)
+	nftObject.data.Owner = owner
+	nftObject.data.MergeLevel = 2
+	nftObject.data.MergeNumber = 256
+	state.AddVoteWeight(owner, state.GetExchangAmount(nftAddr, state.calculateExchangeAmount(2, 256)))
+
+	state.FractionalizeSNFT(owner, nftAddr)
+	if state.GetNFTOwner16(nftAddr) != types.SNFTSharesVault {
+		t.Fatal("fractionalized snft not locked")
+	}
+	if state.GetVoteWeight(owner).Sign() != 0 {
+		t.Fatalf("voteweight of owner not removed: %v", state.GetVoteWeight(owner))
+	}
+	if state.GetSNFTShares(owner, nftAddr).Cmp(types.SNFTShareSupply) != 0 {
+		t.Fatal("shares not minted to owner")
+	}
+
+	half := new(big.Int).Div(types.SNFTShareSupply, big.NewInt(2))
+	snap := state.Snapshot()
+	if !state.TransferSNFTShares(owner, buyer, nftAddr, half) {
+		t.Fatal("share transfer failed")
+	}
+	if state.TransferSNFTShares(buyer, owner, nftAddr, types.SNFTShareSupply) {
+		t.Fatal("transferred more shares than held")
+	}
+	if state.RedeemSNFT(owner, nftAddr, big.NewInt(1)) {
+		t.Fatal("redeemed without the whole supply")
+	}
+	state.RevertToSnapshot(snap)
+	if state.GetSNFTShares(buyer, nftAddr).Sign() != 0 {
+		t.Fatal("share transfer not reverted")
+	}
+
+	if !state.RedeemSNFT(owner, nftAddr, big.NewInt(1)) {
+		t.Fatal("redeem failed")
+	}
+	if state.GetNFTOwner16(nftAddr) != owner {
+		t.Fatal("redeemed snft not released")
+	}
+	if state.GetSNFTShareSupply(nftAddr).Sign() != 0 || len(state.GetSNFTShareBalances(owner)) != 0 {
+		t.Fatal("shares not burnt")
+	}
+	if state.GetVoteWeight(owner).Sign() == 0 {
+		t.Fatal("voteweight of owner not restored")
+	}
+}
+
+func TestOfficialNFTProposalElection(t *testing.T) {
+	state, _ := New(common.Hash{}, NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	state.OfficialNFTPool = new(types.InjectedOfficialNFTList)
+
+	proposer := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	voter := common.HexToAddress("0x0000000000000000000000000000000000000a02")
+	validator := common.HexToAddress("0x0000000000000000000000000000000000000a03")
+	for addr, stake := range map[common.Address]int64{proposer: 5, voter: 20, validator: 175} {
+		state.AddBalance(addr, big.NewInt(stake))
+		state.PledgeToken(addr, big.NewInt(stake), common.Address{}, big.NewInt(1))
+		state.AddVoteWeight(addr, big.NewInt(1))
+		state.ValidatorPool = append(state.ValidatorPool, &types.Validator{Addr: addr, Balance: big.NewInt(stake)})
+	}
+	nominated := &types.NominatedOfficialNFT{
+		InjectedOfficialNFT: types.InjectedOfficialNFT{
+			Dir:        "proposed",
+			StartIndex: big.NewInt(0),
+			Number:     types.DefaultNumber,
+			Royalty:    types.DefaultRoyalty,
+			Creator:    proposer.Hex(),
+			Address:    proposer,
+		},
+	}
+	closed := big.NewInt(2 + types.OfficialNFTVotingPeriod)
+
+	// The proposer alone pledged 2.5% of the stake, below the quorum
+	if err := state.ProposeOfficialNFT(nominated, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	state.ElectOfficialNFTProposal(closed)
+	if injected := state.OfficialNFTPool.InjectedOfficialNFTs; len(injected) != 1 || injected[0].Dir != types.DefaultDir {
+		t.Fatalf("default collection not injected: %+v", injected)
+	}
+	if len(state.OfficialNFTProposals.Proposals) != 0 {
+		t.Fatal("proposal without quorum not dropped")
+	}
+
+	// With the ballot of voter 12.5% of the stake voted for the proposal
+	if err := state.ProposeOfficialNFT(nominated, closed); err != nil {
+		t.Fatal(err)
+	}
+	id := state.OfficialNFTProposals.Proposals[0].ID
+	snap := state.Snapshot()
+	if err := state.VoteOfficialNFTProposal(voter, id, closed); err != nil {
+		t.Fatal(err)
+	}
+	state.RevertToSnapshot(snap)
+	if ballots := len(state.OfficialNFTProposals.Proposals[0].Ballots); ballots != 1 {
+		t.Fatalf("reverted ballot kept: have %d ballots, want 1", ballots)
+	}
+	if err := state.VoteOfficialNFTProposal(voter, id, closed); err != nil {
+		t.Fatal(err)
+	}
+	state.ElectOfficialNFTProposal(new(big.Int).Add(closed, closed))
+	if injected := state.OfficialNFTPool.InjectedOfficialNFTs; len(injected) != 2 || injected[1].Dir != "proposed" || injected[1].Address != proposer {
+		t.Fatalf("proposal with quorum not injected: %+v", injected[len(injected)-1])
+	}
+}
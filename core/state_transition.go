@@ -393,6 +393,10 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	london := st.evm.ChainConfig().IsLondon(st.evm.Context.BlockNumber)
 	contractCreation := msg.To() == nil
 
+	// The wormholes types added by a fork have no intrinsic gas before it
+	if wormholes, err := st.GetWormholes(); err == nil && !vm.IsWormholesTypeForked(st.evm.ChainConfig(), wormholes.Type, st.evm.Context.BlockNumber) {
+		return nil, vm.ErrNotExistNFTType
+	}
 	// Check clauses 4-5, subtract intrinsic gas if everything is correct
 	gas, err := IntrinsicGas(st.data, st.msg.AccessList(), contractCreation, homestead, istanbul)
 	if err != nil {
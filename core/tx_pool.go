@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/metrics"
@@ -233,9 +234,10 @@ type TxPool struct {
 	signer      types.Signer
 	mu          sync.RWMutex
 
-	istanbul bool // Fork indicator whether we are in the istanbul stage.
-	eip2718  bool // Fork indicator whether we are using EIP-2718 type transactions.
-	eip1559  bool // Fork indicator whether we are using EIP-1559 type transactions.
+	istanbul bool     // Fork indicator whether we are in the istanbul stage.
+	eip2718  bool     // Fork indicator whether we are using EIP-2718 type transactions.
+	eip1559  bool     // Fork indicator whether we are using EIP-1559 type transactions.
+	next     *big.Int // Number of the next pending block the wormholes types are validated against.
 
 	currentState  *state.StateDB // Current state in the blockchain head
 	pendingNonces *txNoncer      // Pending state tracking virtual nonces
@@ -620,6 +622,9 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 	// cost == V + GP * GL
 	wormholes, err := tx.GetWormholes()
 	if err == nil {
+		if !vm.IsWormholesTypeForked(pool.chainconfig, wormholes.Type, pool.next) {
+			return vm.ErrNotExistNFTType
+		}
 		switch wormholes.Type {
 		case 10:
 			if pool.currentState.GetBalance(from).Cmp(tx.GasFee()) < 0 {
@@ -1547,6 +1552,7 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	pool.istanbul = pool.chainconfig.IsIstanbul(next)
 	pool.eip2718 = pool.chainconfig.IsBerlin(next)
 	pool.eip1559 = pool.chainconfig.IsLondon(next)
+	pool.next = next
 }
 
 // promoteExecutables moves transactions that have become processable from the
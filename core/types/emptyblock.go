@@ -1,6 +1,8 @@
 package types
 
 import (
+	"errors"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -20,14 +22,24 @@ type EmptyMsg struct {
 	Msg       []byte
 	Address   common.Address
 	Signature []byte
+	// BLSSignature is the bls signature of EmptyBlockVoteHash after the bls
+	// fork, the votes of an empty block are aggregated from it
+	BLSSignature []byte `rlp:"optional"`
+}
+
+// EmptyBlockVoteHash returns the message the validators sign with their bls
+// keys to vote for the empty block at height.
+func EmptyBlockVoteHash(height *big.Int) []byte {
+	return crypto.Keccak256([]byte("wormholes-empty-block"), height.Bytes())
 }
 
 func (m *EmptyMsg) PayloadNoSig() ([]byte, error) {
 	return rlp.EncodeToBytes(&EmptyMsg{
-		Code:      m.Code,
-		Msg:       m.Msg,
-		Address:   m.Address,
-		Signature: []byte{},
+		Code:         m.Code,
+		Msg:          m.Msg,
+		Address:      m.Address,
+		Signature:    []byte{},
+		BLSSignature: m.BLSSignature,
 	})
 }
 
@@ -76,6 +88,100 @@ type SignatureData struct {
 	Height *big.Int
 	//Timestamp uint64
 	Round uint64
+	// Index is the position of Vote in the rotation of the round, it is set
+	// after the evidence fork and votes with another index are rejected. A
+	// validator votes once per index, so two votes with the same height, round
+	// and index for different proposers are an equivocation.
+	Index uint64 `rlp:"optional"`
+}
+
+var (
+	ErrInvalidEvidence      = errors.New("invalid empty block evidence")
+	ErrEvidenceSigner       = errors.New("evidence votes signed by different validators")
+	ErrEvidenceSameVote     = errors.New("evidence votes for the same proposer")
+	ErrEvidenceVoteMismatch = errors.New("evidence votes for different slots")
+)
+
+// EmptyBlockEvidence proves that Offender signed two votes for different
+// proposers of the empty block at the same height, round and index
+type EmptyBlockEvidence struct {
+	Offender common.Address
+	Height   *big.Int
+	Round    uint64
+	Index    uint64
+	First    []byte // signed EmptyMsg payload of the first vote
+	Second   []byte // signed EmptyMsg payload of the conflicting vote
+}
+
+// NewEmptyBlockEvidence returns the evidence of the conflicting votes first
+// and second, it fails if they are no equivocation.
+func NewEmptyBlockEvidence(first, second []byte) (*EmptyBlockEvidence, error) {
+	ev := &EmptyBlockEvidence{First: first, Second: second}
+	a, err := decodeEvidenceVote(first)
+	if err != nil {
+		return nil, err
+	}
+	ev.Offender, ev.Height, ev.Round, ev.Index = a.signer, a.data.Height, a.data.Round, a.data.Index
+	if err := ev.Verify(); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// Hash returns the identifier of the evidence, it doesn't depend on the
+// order of the votes.
+func (ev *EmptyBlockEvidence) Hash() common.Hash {
+	first, second := ev.First, ev.Second
+	if string(first) > string(second) {
+		first, second = second, first
+	}
+	return common.BytesToHash(crypto.Keccak256(first, second))
+}
+
+// Verify checks that both votes are signed by the offender for the height,
+// round and index of the evidence and vote for different proposers.
+func (ev *EmptyBlockEvidence) Verify() error {
+	if ev.Height == nil {
+		return ErrInvalidEvidence
+	}
+	a, err := decodeEvidenceVote(ev.First)
+	if err != nil {
+		return err
+	}
+	b, err := decodeEvidenceVote(ev.Second)
+	if err != nil {
+		return err
+	}
+	if a.signer != ev.Offender || b.signer != ev.Offender {
+		return ErrEvidenceSigner
+	}
+	for _, v := range []*SignatureData{a.data, b.data} {
+		if v.Height == nil || v.Height.Cmp(ev.Height) != 0 || v.Round != ev.Round || v.Index != ev.Index {
+			return ErrEvidenceVoteMismatch
+		}
+	}
+	if a.data.Vote == b.data.Vote {
+		return ErrEvidenceSameVote
+	}
+	return nil
+}
+
+type evidenceVote struct {
+	signer common.Address
+	data   *SignatureData
+}
+
+func decodeEvidenceVote(payload []byte) (*evidenceVote, error) {
+	msg := new(EmptyMsg)
+	signer, err := msg.RecoverAddress(payload)
+	if err != nil {
+		return nil, ErrInvalidEvidence
+	}
+	var data *SignatureData
+	if err := msg.Decode(&data); err != nil {
+		return nil, ErrInvalidEvidence
+	}
+	return &evidenceVote{signer: signer, data: data}, nil
 }
 
 type OnlineZkProof struct {
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func signVote(t *testing.T, key *ecdsa.PrivateKey, data *SignatureData) []byte {
+	enc, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &EmptyMsg{Code: 1, Msg: enc, Address: crypto.PubkeyToAddress(key.PublicKey)}
+	unsigned, err := msg.PayloadNoSig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Signature, err = crypto.Sign(crypto.Keccak256(unsigned), key); err != nil {
+		t.Fatal(err)
+	}
+	payload, err := msg.Payload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
+
+func TestEmptyBlockEvidence(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	height := big.NewInt(10)
+
+	first := signVote(t, key, &SignatureData{Vote: common.HexToAddress("0x01"), Height: height, Round: 1, Index: 2})
+	second := signVote(t, key, &SignatureData{Vote: common.HexToAddress("0x02"), Height: height, Round: 1, Index: 2})
+
+	ev, err := NewEmptyBlockEvidence(first, second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.Offender != crypto.PubkeyToAddress(key.PublicKey) || ev.Height.Cmp(height) != 0 || ev.Round != 1 || ev.Index != 2 {
+		t.Fatalf("evidence mismatch: %+v", ev)
+	}
+	if rev, _ := NewEmptyBlockEvidence(second, first); rev.Hash() != ev.Hash() {
+		t.Fatal("evidence hash depends on the vote order")
+	}
+
+	tests := []struct {
+		second []byte
+		err    error
+	}{
+		{signVote(t, key, &SignatureData{Vote: common.HexToAddress("0x01"), Height: height, Round: 1, Index: 2}), ErrEvidenceSameVote},
+		{signVote(t, key, &SignatureData{Vote: common.HexToAddress("0x02"), Height: height, Round: 1, Index: 3}), ErrEvidenceVoteMismatch},
+		{signVote(t, key, &SignatureData{Vote: common.HexToAddress("0x02"), Height: height, Round: 2, Index: 2}), ErrEvidenceVoteMismatch},
+		{signVote(t, other, &SignatureData{Vote: common.HexToAddress("0x02"), Height: height, Round: 1, Index: 2}), ErrEvidenceSigner},
+		{[]byte{0x01}, ErrInvalidEvidence},
+	}
+	for i, tt := range tests {
+		if _, err := NewEmptyBlockEvidence(first, tt.second); err != tt.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+
+	// The evidence round trips through the extra-data
+	extra := &IstanbulExtra{Evidence: []*EmptyBlockEvidence{ev}}
+	data, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dec IstanbulExtra
+	if err := rlp.DecodeBytes(data, &dec); err != nil {
+		t.Fatal(err)
+	}
+	if dec.CommittedAggregatedSeal != nil || !reflect.DeepEqual(dec.Evidence, extra.Evidence) {
+		t.Fatalf("evidence mismatch: have %+v, want %+v", dec.Evidence, extra.Evidence)
+	}
+	if err := dec.Evidence[0].Verify(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ExchangerVolume is the amount of a trade brokered by an exchanger
+type ExchangerVolume struct {
+	Address common.Address
+	Amount  *big.Int
+}
+
+// Exchanger is an entry of the open exchangers index
+type Exchanger struct {
+	Addr            common.Address
+	OpenBlockNumber *big.Int
+	// Volume is the cumulative trade volume since the exchanger was opened
+	Volume *big.Int
+}
+
+// ExchangerList is the index of open exchangers, sorted by address
+type ExchangerList struct {
+	Exchangers []*Exchanger
+}
+
+func (el *ExchangerList) Len() int {
+	return len(el.Exchangers)
+}
+
+func (el *ExchangerList) Less(i, j int) bool {
+	return bytes.Compare(el.Exchangers[i].Addr.Bytes(), el.Exchangers[j].Addr.Bytes()) < 0
+}
+
+func (el *ExchangerList) Swap(i, j int) {
+	el.Exchangers[i], el.Exchangers[j] = el.Exchangers[j], el.Exchangers[i]
+}
+
+// Copy returns a deep copy of the list
+func (el *ExchangerList) Copy() *ExchangerList {
+	cpy := &ExchangerList{}
+	for _, v := range el.Exchangers {
+		cpy.Exchangers = append(cpy.Exchangers, &Exchanger{
+			Addr:            v.Addr,
+			OpenBlockNumber: new(big.Int).Set(v.OpenBlockNumber),
+			Volume:          new(big.Int).Set(v.Volume),
+		})
+	}
+	return cpy
+}
+
+func (el *ExchangerList) GetExchanger(addr common.Address) *Exchanger {
+	i := sort.Search(len(el.Exchangers), func(i int) bool {
+		return bytes.Compare(el.Exchangers[i].Addr.Bytes(), addr.Bytes()) >= 0
+	})
+	if i < len(el.Exchangers) && el.Exchangers[i].Addr == addr {
+		return el.Exchangers[i]
+	}
+	return nil
+}
+
+// AddExchanger adds an exchanger opened at blocknumber, it returns false if
+// the exchanger is already in the list.
+func (el *ExchangerList) AddExchanger(addr common.Address, blocknumber *big.Int) bool {
+	if el.GetExchanger(addr) != nil {
+		return false
+	}
+	el.Exchangers = append(el.Exchangers, &Exchanger{
+		Addr:            addr,
+		OpenBlockNumber: new(big.Int).Set(blocknumber),
+		Volume:          big.NewInt(0),
+	})
+	sort.Sort(el)
+	return true
+}
+
+func (el *ExchangerList) RemoveExchanger(addr common.Address) bool {
+	for i, v := range el.Exchangers {
+		if v.Addr == addr {
+			el.Exchangers = append(el.Exchangers[:i], el.Exchangers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// AddVolume adds amount to the trade volume of an exchanger in the list
+func (el *ExchangerList) AddVolume(addr common.Address, amount *big.Int) {
+	if exchanger := el.GetExchanger(addr); exchanger != nil {
+		exchanger.Volume.Add(exchanger.Volume, amount)
+	}
+}
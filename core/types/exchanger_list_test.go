@@ -0,0 +1,44 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestExchangerList(t *testing.T) {
+	addr1 := common.HexToAddress("0x0000000000000000000000000000000000000003")
+	addr2 := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	addr3 := common.HexToAddress("0x0000000000000000000000000000000000000002")
+
+	var list ExchangerList
+	list.AddExchanger(addr1, big.NewInt(5))
+	list.AddExchanger(addr2, big.NewInt(6))
+	list.AddExchanger(addr3, big.NewInt(7))
+	if list.AddExchanger(addr1, big.NewInt(8)) {
+		t.Fatal("exchanger added twice")
+	}
+	for i, want := range []common.Address{addr2, addr3, addr1} {
+		if list.Exchangers[i].Addr != want {
+			t.Fatalf("exchanger %d mismatch: have %x, want %x", i, list.Exchangers[i].Addr, want)
+		}
+	}
+	if got := list.GetExchanger(addr1).OpenBlockNumber; got.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("open block number mismatch: have %v, want 5", got)
+	}
+
+	cpy := list.Copy()
+	list.AddVolume(addr3, big.NewInt(100))
+	list.AddVolume(addr3, big.NewInt(50))
+	if got := list.GetExchanger(addr3).Volume; got.Cmp(big.NewInt(150)) != 0 {
+		t.Fatalf("volume mismatch: have %v, want 150", got)
+	}
+	if got := cpy.GetExchanger(addr3).Volume; got.Sign() != 0 {
+		t.Fatalf("copy volume modified: have %v, want 0", got)
+	}
+
+	if !list.RemoveExchanger(addr3) || list.GetExchanger(addr3) != nil || list.Len() != 2 {
+		t.Fatal("exchanger not removed")
+	}
+}
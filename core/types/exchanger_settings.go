@@ -0,0 +1,159 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CollectionFeeRate is the fee rate an exchanger charges for the nfts
+// of one collection, a collection is identified by the creator of the nfts.
+type CollectionFeeRate struct {
+	Creator common.Address
+	FeeRate uint16
+}
+
+// The fields of an exchanger a type 34 transaction can update.
+const (
+	ExchangerFieldFeeRate = "fee_rate"
+	ExchangerFieldName    = "name"
+	ExchangerFieldURL     = "url"
+)
+
+// ExchangerUpdate is an update of the fee rate, name and url of an exchanger,
+// the nil fields are left unchanged.
+type ExchangerUpdate struct {
+	FeeRate *uint16
+	Name    *string
+	URL     *string
+}
+
+// ExchangerSettings holds the optional exchanger configuration which is
+// managed by the exchanger itself after it is opened.
+type ExchangerSettings struct {
+	// PendingFeeRate is a fee rate increase which takes effect at
+	// PendingFeeRateBlock, 0 means no increase is pending.
+	PendingFeeRate      uint16
+	PendingFeeRateBlock *big.Int
+	// CollectionFeeRates overrides the fee rate for some collections,
+	// an override can only lower the fee rate of the exchanger.
+	CollectionFeeRates []CollectionFeeRate
+	// AllowedCreators is the list of creators whose nfts the exchanger
+	// will broker, an empty list means all creators are allowed.
+	AllowedCreators []common.Address
+}
+
+// Copy returns a deep copy of the settings, a nil receiver returns empty settings.
+func (es *ExchangerSettings) Copy() *ExchangerSettings {
+	cpy := &ExchangerSettings{}
+	if es == nil {
+		return cpy
+	}
+	cpy.PendingFeeRate = es.PendingFeeRate
+	if es.PendingFeeRateBlock != nil {
+		cpy.PendingFeeRateBlock = new(big.Int).Set(es.PendingFeeRateBlock)
+	}
+	cpy.CollectionFeeRates = append(cpy.CollectionFeeRates, es.CollectionFeeRates...)
+	cpy.AllowedCreators = append(cpy.AllowedCreators, es.AllowedCreators...)
+	return cpy
+}
+
+// IsEmpty reports whether the settings hold no configuration.
+func (es *ExchangerSettings) IsEmpty() bool {
+	return es == nil ||
+		(es.PendingFeeRate == 0 &&
+			len(es.CollectionFeeRates) == 0 &&
+			len(es.AllowedCreators) == 0)
+}
+
+// PendingFeeRateEffective reports whether the pending fee rate has taken
+// effect at blocknumber.
+func (es *ExchangerSettings) PendingFeeRateEffective(blocknumber *big.Int) bool {
+	if es == nil || es.PendingFeeRate == 0 || es.PendingFeeRateBlock == nil {
+		return false
+	}
+	return blocknumber.Cmp(es.PendingFeeRateBlock) >= 0
+}
+
+// FeeRate returns the fee rate charged for nfts of creator at blocknumber,
+// feerate is the fee rate currently stored in the exchanger account.
+func (es *ExchangerSettings) FeeRate(feerate uint16, creator common.Address, blocknumber *big.Int) uint16 {
+	if es == nil {
+		return feerate
+	}
+	if es.PendingFeeRateEffective(blocknumber) {
+		feerate = es.PendingFeeRate
+	}
+	if collectionFeeRate, ok := es.CollectionFeeRate(creator); ok && collectionFeeRate < feerate {
+		feerate = collectionFeeRate
+	}
+	return feerate
+}
+
+// CollectionFeeRate returns the fee rate override of the collection of creator.
+func (es *ExchangerSettings) CollectionFeeRate(creator common.Address) (uint16, bool) {
+	if es == nil {
+		return 0, false
+	}
+	for _, v := range es.CollectionFeeRates {
+		if v.Creator == creator {
+			return v.FeeRate, true
+		}
+	}
+	return 0, false
+}
+
+// SetCollectionFeeRate sets the fee rate override of the collection of creator,
+// a fee rate of 0 removes the override.
+func (es *ExchangerSettings) SetCollectionFeeRate(creator common.Address, feerate uint16) {
+	for i, v := range es.CollectionFeeRates {
+		if v.Creator == creator {
+			if feerate == 0 {
+				es.CollectionFeeRates = append(es.CollectionFeeRates[:i], es.CollectionFeeRates[i+1:]...)
+			} else {
+				es.CollectionFeeRates[i].FeeRate = feerate
+			}
+			return
+		}
+	}
+	if feerate != 0 {
+		es.CollectionFeeRates = append(es.CollectionFeeRates, CollectionFeeRate{Creator: creator, FeeRate: feerate})
+	}
+}
+
+// IsAllowedCreator reports whether the exchanger brokers nfts of creator.
+func (es *ExchangerSettings) IsAllowedCreator(creator common.Address) bool {
+	if es == nil || len(es.AllowedCreators) == 0 {
+		return true
+	}
+	for _, v := range es.AllowedCreators {
+		if v == creator {
+			return true
+		}
+	}
+	return false
+}
+
+// AddAllowedCreator adds creator to the allowlist, it returns false if
+// creator is already in it.
+func (es *ExchangerSettings) AddAllowedCreator(creator common.Address) bool {
+	for _, v := range es.AllowedCreators {
+		if v == creator {
+			return false
+		}
+	}
+	es.AllowedCreators = append(es.AllowedCreators, creator)
+	return true
+}
+
+// RemoveAllowedCreator removes creator from the allowlist, it returns false
+// if creator is not in it.
+func (es *ExchangerSettings) RemoveAllowedCreator(creator common.Address) bool {
+	for i, v := range es.AllowedCreators {
+		if v == creator {
+			es.AllowedCreators = append(es.AllowedCreators[:i], es.AllowedCreators[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
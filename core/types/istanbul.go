@@ -18,6 +18,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"io"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -37,10 +38,37 @@ var (
 
 	// ErrInvalidIstanbulHeaderExtra is returned if the length of extra-data is less than 32 bytes
 	ErrInvalidIstanbulHeaderExtra = errors.New("invalid istanbul header extra-data")
+	// ErrInvalidSignerBitmap is returned if the signer bitmap of an aggregated seal
+	// refers to signers that don't exist
+	ErrInvalidSignerBitmap = errors.New("invalid signer bitmap")
+	// ErrUnknownIstanbulExtraVersion is returned if the version byte of the
+	// extra-data is not a version this node can decode
+	ErrUnknownIstanbulExtraVersion = errors.New("unknown istanbul header extra-data version")
+	// ErrOversizedIstanbulExtra is returned if a list of the extra-data is longer
+	// than its limit
+	ErrOversizedIstanbulExtra = errors.New("oversized istanbul header extra-data")
+	// ErrReservedIstanbulVanity is returned if the vanity of the extra-data
+	// uses the byte reserved for the version
+	ErrReservedIstanbulVanity = errors.New("istanbul header extra-data vanity uses the version byte")
 
 	OnlineValidatorVanity = 632
 )
 
+const (
+	// IstanbulExtraVersion1 is the version of the extra-data laid out as
+	// IstanbulExtra, it is written to the last byte of the vanity after the
+	// extra version fork. Fields appended to IstanbulExtra in the future bump
+	// the version so the nodes not aware of them reject the headers.
+	IstanbulExtraVersion1 = byte(1)
+
+	// IstanbulCommitteeSize is the maximum number of validators of the
+	// committee drawn from the validator pool for a block
+	IstanbulCommitteeSize = 11
+
+	// IstanbulRewardedExchangers is the number of exchangers rewarded in a block
+	IstanbulRewardedExchangers = 4
+)
+
 // IstanbulExtra represents the legacy IBFT header extradata
 type IstanbulExtra struct {
 	Validators         []common.Address
@@ -50,11 +78,89 @@ type IstanbulExtra struct {
 	ValidatorAddr      []common.Address
 	RewardSeal         [][]byte
 	EmptyBlockMessages [][]byte
+
+	// The aggregated seals replace CommittedSeal, RewardSeal and the votes of
+	// EmptyBlockMessages after the bls fork, they are only encoded if present
+	// so the extra-data of the blocks before the fork is unchanged.
+	CommittedAggregatedSeal  *AggregatedSeal
+	RewardAggregatedSeal     *AggregatedSeal
+	EmptyBlockAggregatedSeal *AggregatedSeal
+
+	// Evidence of equivocating empty block voters penalized in this block
+	// after the evidence fork, it is only encoded if present.
+	Evidence []*EmptyBlockEvidence
+
+	// The hash of the validator checkpoint left by this block after the
+	// committee fork, the full checkpoint is carried every
+	// ValidatorCheckpointInterval blocks. They are only encoded if present.
+	ValidatorsHash common.Hash
+	Checkpoint     *ValidatorCheckpoint
+}
+
+// AggregatedSeal is a bls signature aggregated from the signatures of the
+// signers whose bits are set in Bitmap.
+type AggregatedSeal struct {
+	Signature []byte
+	Bitmap    []byte
+}
+
+// NewAggregatedSeal returns the aggregated seal of signature signed by the
+// signers at indices.
+func NewAggregatedSeal(signature []byte, indices []int) *AggregatedSeal {
+	seal := &AggregatedSeal{Signature: common.CopyBytes(signature)}
+	for _, i := range indices {
+		for len(seal.Bitmap) <= i/8 {
+			seal.Bitmap = append(seal.Bitmap, 0)
+		}
+		seal.Bitmap[i/8] |= 1 << uint(i%8)
+	}
+	return seal
+}
+
+// Indices returns the indices of the signers in a set of size n.
+func (as *AggregatedSeal) Indices(n int) ([]int, error) {
+	var indices []int
+	for i, b := range as.Bitmap {
+		for j := 0; j < 8; j++ {
+			if b&(1<<uint(j)) == 0 {
+				continue
+			}
+			if i*8+j >= n {
+				return nil, ErrInvalidSignerBitmap
+			}
+			indices = append(indices, i*8+j)
+		}
+	}
+	return indices, nil
+}
+
+// Signers returns the signers of the seal out of addrs.
+func (as *AggregatedSeal) Signers(addrs []common.Address) ([]common.Address, error) {
+	indices, err := as.Indices(len(addrs))
+	if err != nil {
+		return nil, err
+	}
+	signers := make([]common.Address, 0, len(indices))
+	for _, i := range indices {
+		signers = append(signers, addrs[i])
+	}
+	return signers, nil
+}
+
+// Copy returns a deep copy of the seal.
+func (as *AggregatedSeal) Copy() *AggregatedSeal {
+	if as == nil {
+		return nil
+	}
+	return &AggregatedSeal{
+		Signature: common.CopyBytes(as.Signature),
+		Bitmap:    common.CopyBytes(as.Bitmap),
+	}
 }
 
 // EncodeRLP serializes ist into the Ethereum RLP format.
 func (ist *IstanbulExtra) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, []interface{}{
+	fields := []interface{}{
 		ist.Validators,
 		ist.Seal,
 		ist.CommittedSeal,
@@ -62,7 +168,26 @@ func (ist *IstanbulExtra) EncodeRLP(w io.Writer) error {
 		ist.ValidatorAddr,
 		ist.RewardSeal,
 		ist.EmptyBlockMessages,
-	})
+	}
+	committee := ist.ValidatorsHash != (common.Hash{}) || ist.Checkpoint != nil
+	if ist.CommittedAggregatedSeal != nil || ist.RewardAggregatedSeal != nil || ist.EmptyBlockAggregatedSeal != nil || len(ist.Evidence) > 0 || committee {
+		for _, seal := range []*AggregatedSeal{ist.CommittedAggregatedSeal, ist.RewardAggregatedSeal, ist.EmptyBlockAggregatedSeal} {
+			if seal == nil {
+				seal = &AggregatedSeal{}
+			}
+			fields = append(fields, seal)
+		}
+	}
+	if len(ist.Evidence) > 0 || committee {
+		fields = append(fields, ist.Evidence)
+	}
+	if committee {
+		fields = append(fields, ist.ValidatorsHash)
+	}
+	if ist.Checkpoint != nil {
+		fields = append(fields, ist.Checkpoint)
+	}
+	return rlp.Encode(w, fields)
 }
 
 // DecodeRLP implements rlp.Decoder, and load the istanbul fields from a RLP stream.
@@ -75,14 +200,38 @@ func (ist *IstanbulExtra) DecodeRLP(s *rlp.Stream) error {
 		ValidatorAddr      []common.Address
 		RewardSeal         [][]byte
 		EmptyBlockMessages [][]byte
+
+		CommittedAggregatedSeal  *AggregatedSeal `rlp:"optional"`
+		RewardAggregatedSeal     *AggregatedSeal `rlp:"optional"`
+		EmptyBlockAggregatedSeal *AggregatedSeal `rlp:"optional"`
+
+		Evidence []*EmptyBlockEvidence `rlp:"optional"`
+
+		ValidatorsHash common.Hash          `rlp:"optional"`
+		Checkpoint     *ValidatorCheckpoint `rlp:"optional"`
 	}
 	if err := s.Decode(&istanbulExtra); err != nil {
 		return err
 	}
 	ist.Validators, ist.Seal, ist.CommittedSeal, ist.ExchangerAddr, ist.ValidatorAddr, ist.RewardSeal, ist.EmptyBlockMessages = istanbulExtra.Validators, istanbulExtra.Seal, istanbulExtra.CommittedSeal, istanbulExtra.ExchangerAddr, istanbulExtra.ValidatorAddr, istanbulExtra.RewardSeal, istanbulExtra.EmptyBlockMessages
+	ist.CommittedAggregatedSeal = nonEmptyAggregatedSeal(istanbulExtra.CommittedAggregatedSeal)
+	ist.RewardAggregatedSeal = nonEmptyAggregatedSeal(istanbulExtra.RewardAggregatedSeal)
+	ist.EmptyBlockAggregatedSeal = nonEmptyAggregatedSeal(istanbulExtra.EmptyBlockAggregatedSeal)
+	if len(istanbulExtra.Evidence) > 0 {
+		ist.Evidence = istanbulExtra.Evidence
+	}
+	ist.ValidatorsHash, ist.Checkpoint = istanbulExtra.ValidatorsHash, istanbulExtra.Checkpoint
 	return nil
 }
 
+// nonEmptyAggregatedSeal returns nil for the placeholder of a missing seal
+func nonEmptyAggregatedSeal(seal *AggregatedSeal) *AggregatedSeal {
+	if seal == nil || len(seal.Signature) == 0 {
+		return nil
+	}
+	return seal
+}
+
 // ExtractIstanbulExtra extracts all values of the IstanbulExtra from the header. It returns an
 // error if the length of the given extra-data is less than 32 bytes or the extra-data can not
 // be decoded.
@@ -99,6 +248,94 @@ func ExtractIstanbulExtra(h *Header) (*IstanbulExtra, error) {
 	return istanbulExtra, nil
 }
 
+// IstanbulExtraVersion returns the version byte in the vanity of the
+// extra-data, it is only meaningful after the extra version fork.
+func IstanbulExtraVersion(h *Header) byte {
+	if len(h.Extra) < IstanbulExtraVanity {
+		return 0
+	}
+	return h.Extra[IstanbulExtraVanity-1]
+}
+
+// SetIstanbulExtraVersion writes the version byte in the vanity of the
+// extra-data, the vanity is padded if it is too short. It returns an error if
+// the vanity set by the miner uses the version byte.
+func SetIstanbulExtraVersion(h *Header, version byte) error {
+	if len(h.Extra) < IstanbulExtraVanity {
+		h.Extra = append(h.Extra, make([]byte, IstanbulExtraVanity-len(h.Extra))...)
+	}
+	return setVanityVersion(h.Extra[:IstanbulExtraVanity], version)
+}
+
+// setVanityVersion writes the version to the last byte of the vanity, which
+// must be left unset by the miner.
+func setVanityVersion(vanity []byte, version byte) error {
+	if b := vanity[IstanbulExtraVanity-1]; b != 0 && b != version {
+		return ErrReservedIstanbulVanity
+	}
+	vanity[IstanbulExtraVanity-1] = version
+	return nil
+}
+
+// ExtractVersionedIstanbulExtra extracts the IstanbulExtra of a header written
+// after the extra version fork. It returns an error if the version byte of the
+// vanity is unknown, before trying to decode the extra-data.
+func ExtractVersionedIstanbulExtra(h *Header) (*IstanbulExtra, error) {
+	if len(h.Extra) < IstanbulExtraVanity {
+		return nil, ErrInvalidIstanbulHeaderExtra
+	}
+	switch IstanbulExtraVersion(h) {
+	case IstanbulExtraVersion1:
+		return ExtractIstanbulExtra(h)
+	default:
+		return nil, ErrUnknownIstanbulExtraVersion
+	}
+}
+
+// IstanbulExtraLimits are the maximum lengths of the lists of an IstanbulExtra.
+// A zero limit is not enforced.
+type IstanbulExtraLimits struct {
+	Committee int // Validators of the committee sealing a block
+	Pool      int // Validators of the pool, the voters of an empty block
+}
+
+// CheckLimits checks the lengths of the lists of the extra-data of a block,
+// empty tells whether the block is an empty block whose validators are the
+// voters of the pool rather than a committee.
+func (ist *IstanbulExtra) CheckLimits(limits IstanbulExtraLimits, empty bool) error {
+	check := func(name string, have, limit int) error {
+		if limit > 0 && have > limit {
+			return fmt.Errorf("%w: %d %s, limit %d", ErrOversizedIstanbulExtra, have, name, limit)
+		}
+		return nil
+	}
+	validators := limits.Committee
+	if empty {
+		validators = limits.Pool
+	}
+	checks := []struct {
+		name        string
+		have, limit int
+	}{
+		{"validators", len(ist.Validators), validators},
+		{"committed seals", len(ist.CommittedSeal), len(ist.Validators)},
+		{"exchangers", len(ist.ExchangerAddr), IstanbulRewardedExchangers},
+		{"rewarded validators", len(ist.ValidatorAddr), limits.Committee},
+		{"reward seals", len(ist.RewardSeal), limits.Committee},
+		{"empty block messages", len(ist.EmptyBlockMessages), limits.Pool},
+		{"evidences", len(ist.Evidence), limits.Pool},
+	}
+	for _, c := range checks {
+		if err := check(c.name, c.have, c.limit); err != nil {
+			return err
+		}
+	}
+	if !empty && len(ist.EmptyBlockMessages) > 0 {
+		return fmt.Errorf("%w: %d empty block messages in a block", ErrOversizedIstanbulExtra, len(ist.EmptyBlockMessages))
+	}
+	return nil
+}
+
 // FilteredHeader returns a filtered header which some information (like seal, committed seals)
 // are clean to fulfill the Istanbul hash rules. It first check if the extradata can be extracted into IstanbulExtra if that fails,
 //it extracts extradata into QBFTExtra struct
@@ -130,6 +367,9 @@ func IstanbulFilteredHeader(h *Header, keepSeal bool) *Header {
 		istanbulExtra.ExchangerAddr = []common.Address{}
 		istanbulExtra.RewardSeal = [][]byte{}
 		istanbulExtra.EmptyBlockMessages = [][]byte{}
+		istanbulExtra.CommittedAggregatedSeal = nil
+		istanbulExtra.RewardAggregatedSeal = nil
+		istanbulExtra.EmptyBlockAggregatedSeal = nil
 		payload, err := rlp.EncodeToBytes(&istanbulExtra)
 		if err != nil {
 			return nil
@@ -142,6 +382,7 @@ func IstanbulFilteredHeader(h *Header, keepSeal bool) *Header {
 			istanbulExtra.Seal = []byte{}
 		}
 		istanbulExtra.CommittedSeal = [][]byte{}
+		istanbulExtra.CommittedAggregatedSeal = nil
 
 		payload, err := rlp.EncodeToBytes(&istanbulExtra)
 		if err != nil {
@@ -161,6 +402,21 @@ type QBFTExtra struct {
 	Vote          *ValidatorVote
 	Round         uint32
 	CommittedSeal [][]byte
+
+	// The Wormholes consensus fields, see IstanbulExtra. They are only encoded
+	// if present so the extra-data of plain qbft blocks is unchanged.
+	ExchangerAddr        []common.Address
+	ValidatorAddr        []common.Address
+	RewardSeal           [][]byte
+	EmptyBlockMessages   [][]byte
+	RewardAggregatedSeal *AggregatedSeal
+	ValidatorsHash       common.Hash
+	Checkpoint           *ValidatorCheckpoint
+
+	// Evidence of equivocating empty block voters penalized in this block
+	// after the evidence fork. It comes last so the extra-data of the qbft
+	// blocks without evidence is unchanged.
+	Evidence []*EmptyBlockEvidence
 }
 
 type ValidatorVote struct {
@@ -170,13 +426,37 @@ type ValidatorVote struct {
 
 // EncodeRLP serializes qist into the Ethereum RLP format.
 func (qst *QBFTExtra) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, []interface{}{
+	fields := []interface{}{
 		qst.VanityData,
 		qst.Validators,
 		qst.Vote,
 		qst.Round,
 		qst.CommittedSeal,
-	})
+	}
+	evidence := len(qst.Evidence) > 0
+	// The fields ahead of the evidence are encoded along with it
+	tail := qst.ValidatorsHash != (common.Hash{}) || qst.Checkpoint != nil || evidence
+	if len(qst.ExchangerAddr) > 0 || len(qst.ValidatorAddr) > 0 || len(qst.RewardSeal) > 0 || len(qst.EmptyBlockMessages) > 0 || qst.RewardAggregatedSeal != nil || tail {
+		fields = append(fields, qst.ExchangerAddr, qst.ValidatorAddr, qst.RewardSeal, qst.EmptyBlockMessages)
+	}
+	if qst.RewardAggregatedSeal != nil || tail {
+		seal := qst.RewardAggregatedSeal
+		if seal == nil {
+			seal = &AggregatedSeal{}
+		}
+		fields = append(fields, seal)
+	}
+	if tail {
+		fields = append(fields, qst.ValidatorsHash)
+	}
+	// A missing checkpoint is encoded as an empty list ahead of the evidence
+	if qst.Checkpoint != nil || evidence {
+		fields = append(fields, qst.Checkpoint)
+	}
+	if evidence {
+		fields = append(fields, qst.Evidence)
+	}
+	return rlp.Encode(w, fields)
 }
 
 // DecodeRLP implements rlp.Decoder, and load the QBFTExtra fields from a RLP stream.
@@ -187,11 +467,28 @@ func (qst *QBFTExtra) DecodeRLP(s *rlp.Stream) error {
 		Vote          *ValidatorVote `rlp:"nil"`
 		Round         uint32
 		CommittedSeal [][]byte
+
+		ExchangerAddr        []common.Address `rlp:"optional"`
+		ValidatorAddr        []common.Address `rlp:"optional"`
+		RewardSeal           [][]byte         `rlp:"optional"`
+		EmptyBlockMessages   [][]byte         `rlp:"optional"`
+		RewardAggregatedSeal *AggregatedSeal  `rlp:"optional"`
+
+		ValidatorsHash common.Hash          `rlp:"optional"`
+		Checkpoint     *ValidatorCheckpoint `rlp:"nil,optional"`
+
+		Evidence []*EmptyBlockEvidence `rlp:"optional"`
 	}
 	if err := s.Decode(&qbftExtra); err != nil {
 		return err
 	}
 	qst.VanityData, qst.Validators, qst.Vote, qst.Round, qst.CommittedSeal = qbftExtra.VanityData, qbftExtra.Validators, qbftExtra.Vote, qbftExtra.Round, qbftExtra.CommittedSeal
+	qst.ExchangerAddr, qst.ValidatorAddr, qst.RewardSeal, qst.EmptyBlockMessages = qbftExtra.ExchangerAddr, qbftExtra.ValidatorAddr, qbftExtra.RewardSeal, qbftExtra.EmptyBlockMessages
+	qst.RewardAggregatedSeal = nonEmptyAggregatedSeal(qbftExtra.RewardAggregatedSeal)
+	qst.ValidatorsHash, qst.Checkpoint = qbftExtra.ValidatorsHash, qbftExtra.Checkpoint
+	if len(qbftExtra.Evidence) > 0 {
+		qst.Evidence = qbftExtra.Evidence
+	}
 
 	return nil
 }
@@ -229,6 +526,80 @@ func ExtractQBFTExtra(h *Header) (*QBFTExtra, error) {
 	return qbftExtra, nil
 }
 
+// QBFTExtraVersion returns the version byte in the vanity of the qbft
+// extra-data, it is only meaningful after the extra version fork.
+func QBFTExtraVersion(h *Header) byte {
+	content, _, err := rlp.SplitList(h.Extra)
+	if err != nil {
+		return 0
+	}
+	vanity, _, err := rlp.SplitString(content)
+	if err != nil || len(vanity) < IstanbulExtraVanity {
+		return 0
+	}
+	return vanity[IstanbulExtraVanity-1]
+}
+
+// SetVersion writes the version byte in the vanity of the qbft extra-data, the
+// vanity is padded if it is too short. It returns an error if the vanity set
+// by the miner uses the version byte.
+func (qst *QBFTExtra) SetVersion(version byte) error {
+	if len(qst.VanityData) < IstanbulExtraVanity {
+		qst.VanityData = append(qst.VanityData, make([]byte, IstanbulExtraVanity-len(qst.VanityData))...)
+	}
+	return setVanityVersion(qst.VanityData[:IstanbulExtraVanity], version)
+}
+
+// ExtractVersionedQBFTExtra extracts the QBFTExtra of a header written after
+// the extra version fork. It returns an error if the version byte of the
+// vanity is unknown, before decoding the remaining fields.
+func ExtractVersionedQBFTExtra(h *Header) (*QBFTExtra, error) {
+	switch QBFTExtraVersion(h) {
+	case IstanbulExtraVersion1:
+		return ExtractQBFTExtra(h)
+	default:
+		return nil, ErrUnknownIstanbulExtraVersion
+	}
+}
+
+// CheckLimits checks the lengths of the lists of the qbft extra-data of a
+// block, see IstanbulExtra.CheckLimits.
+func (qst *QBFTExtra) CheckLimits(limits IstanbulExtraLimits, empty bool) error {
+	return qst.istanbulExtra().CheckLimits(limits, empty)
+}
+
+// istanbulExtra returns the consensus fields in the IstanbulExtra layout,
+// without a proposer seal.
+func (qst *QBFTExtra) istanbulExtra() *IstanbulExtra {
+	return &IstanbulExtra{
+		Validators:           qst.Validators,
+		Seal:                 []byte{},
+		CommittedSeal:        qst.CommittedSeal,
+		ExchangerAddr:        qst.ExchangerAddr,
+		ValidatorAddr:        qst.ValidatorAddr,
+		RewardSeal:           qst.RewardSeal,
+		EmptyBlockMessages:   qst.EmptyBlockMessages,
+		RewardAggregatedSeal: qst.RewardAggregatedSeal,
+		Evidence:             qst.Evidence,
+		ValidatorsHash:       qst.ValidatorsHash,
+		Checkpoint:           qst.Checkpoint,
+	}
+}
+
+// ExtractWormholesExtra extracts the consensus fields of the header whether it
+// was sealed by ibft or qbft. The fields of a qbft header are returned in the
+// IstanbulExtra layout, without a proposer seal.
+func ExtractWormholesExtra(h *Header) (*IstanbulExtra, error) {
+	if istanbulExtra, err := ExtractIstanbulExtra(h); err == nil {
+		return istanbulExtra, nil
+	}
+	qbftExtra, err := ExtractQBFTExtra(h)
+	if err != nil {
+		return nil, err
+	}
+	return qbftExtra.istanbulExtra(), nil
+}
+
 // QBFTFilteredHeader returns a filtered header which some information (like committed seals, round, validator vote)
 // are clean to fulfill the Istanbul hash rules. It returns nil if the extra-data cannot be
 // decoded/encoded by rlp.
@@ -248,6 +619,17 @@ func QBFTFilteredHeaderWithRound(h *Header, round uint32) *Header {
 	qbftExtra.CommittedSeal = [][]byte{}
 	qbftExtra.Round = round
 
+	// The votes and rewards of an empty block are assembled by every node on
+	// its own, they are left out of the hash like in IstanbulFilteredHeader
+	if h.Coinbase == (common.Address{}) && h.Number != nil && h.Number.Cmp(common.Big0) > 0 {
+		qbftExtra.Validators = []common.Address{}
+		qbftExtra.ExchangerAddr = nil
+		qbftExtra.ValidatorAddr = nil
+		qbftExtra.RewardSeal = nil
+		qbftExtra.EmptyBlockMessages = nil
+		qbftExtra.RewardAggregatedSeal = nil
+	}
+
 	payload, err := rlp.EncodeToBytes(&qbftExtra)
 	if err != nil {
 		return nil
@@ -0,0 +1,224 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestIstanbulExtraAggregatedSeals(t *testing.T) {
+	legacy := &IstanbulExtra{
+		Validators:    []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")},
+		Seal:          []byte{0x01},
+		CommittedSeal: [][]byte{{0x02}},
+	}
+	// The encoding of the extra without aggregated seals is unchanged
+	have, err := rlp.EncodeToBytes(legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := rlp.EncodeToBytes([]interface{}{
+		legacy.Validators, legacy.Seal, legacy.CommittedSeal, legacy.ExchangerAddr,
+		legacy.ValidatorAddr, legacy.RewardSeal, legacy.EmptyBlockMessages,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(have, want) {
+		t.Fatalf("legacy encoding mismatch: have %x, want %x", have, want)
+	}
+
+	legacy.RewardAggregatedSeal = NewAggregatedSeal([]byte{0x03}, []int{0, 9})
+	data, err := rlp.EncodeToBytes(legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dec IstanbulExtra
+	if err := rlp.DecodeBytes(data, &dec); err != nil {
+		t.Fatal(err)
+	}
+	if dec.CommittedAggregatedSeal != nil || dec.EmptyBlockAggregatedSeal != nil {
+		t.Fatal("placeholder seals decoded as seals")
+	}
+	if !reflect.DeepEqual(dec.RewardAggregatedSeal, legacy.RewardAggregatedSeal) {
+		t.Fatalf("reward seal mismatch: have %+v, want %+v", dec.RewardAggregatedSeal, legacy.RewardAggregatedSeal)
+	}
+	indices, err := dec.RewardAggregatedSeal.Indices(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(indices, []int{0, 9}) {
+		t.Fatalf("indices mismatch: have %v, want [0 9]", indices)
+	}
+	if _, err := dec.RewardAggregatedSeal.Indices(9); err != ErrInvalidSignerBitmap {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrInvalidSignerBitmap)
+	}
+}
+
+func TestQBFTExtraEvidence(t *testing.T) {
+	extra := &QBFTExtra{
+		VanityData:    bytes.Repeat([]byte{0x00}, IstanbulExtraVanity),
+		Validators:    []common.Address{common.HexToAddress("0x01")},
+		CommittedSeal: [][]byte{},
+		ValidatorAddr: []common.Address{common.HexToAddress("0x02")},
+	}
+	// The encoding of the extra without evidence is unchanged
+	have, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := rlp.EncodeToBytes([]interface{}{
+		extra.VanityData, extra.Validators, extra.Vote, extra.Round, extra.CommittedSeal,
+		extra.ExchangerAddr, extra.ValidatorAddr, extra.RewardSeal, extra.EmptyBlockMessages,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(have, want) {
+		t.Fatalf("encoding mismatch: have %x, want %x", have, want)
+	}
+
+	extra.Evidence = []*EmptyBlockEvidence{{Offender: common.HexToAddress("0x03"), Height: common.Big1, First: []byte{0x04}, Second: []byte{0x05}}}
+	data, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dec QBFTExtra
+	if err := rlp.DecodeBytes(data, &dec); err != nil {
+		t.Fatal(err)
+	}
+	if dec.Checkpoint != nil || dec.RewardAggregatedSeal != nil {
+		t.Fatal("placeholders decoded as values")
+	}
+	if !reflect.DeepEqual(dec.Evidence, extra.Evidence) {
+		t.Fatalf("evidence mismatch: have %+v, want %+v", dec.Evidence, extra.Evidence)
+	}
+	if !reflect.DeepEqual(dec.ValidatorAddr, extra.ValidatorAddr) {
+		t.Fatalf("validators mismatch: have %v, want %v", dec.ValidatorAddr, extra.ValidatorAddr)
+	}
+}
+
+func TestVersionedIstanbulExtra(t *testing.T) {
+	extra := &IstanbulExtra{
+		Validators:    []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")},
+		Seal:          []byte{},
+		CommittedSeal: [][]byte{},
+	}
+	payload, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := &Header{Number: common.Big1, Coinbase: common.HexToAddress("0x01"), Extra: append(make([]byte, IstanbulExtraVanity), payload...)}
+
+	// The unversioned extra-data is rejected by the versioned decoder only
+	if _, err := ExtractIstanbulExtra(header); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ExtractVersionedIstanbulExtra(header); err != ErrUnknownIstanbulExtraVersion {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrUnknownIstanbulExtraVersion)
+	}
+	if err := SetIstanbulExtraVersion(header, IstanbulExtraVersion1); err != nil {
+		t.Fatal(err)
+	}
+	dec, err := ExtractVersionedIstanbulExtra(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(dec.Validators, extra.Validators) {
+		t.Fatalf("validators mismatch: have %v, want %v", dec.Validators, extra.Validators)
+	}
+	// The version byte is reserved, a miner vanity using it is rejected
+	if err := SetIstanbulExtraVersion(header, IstanbulExtraVersion1+1); err != ErrReservedIstanbulVanity {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrReservedIstanbulVanity)
+	}
+	header.Extra[IstanbulExtraVanity-1] = IstanbulExtraVersion1 + 1
+	if _, err := ExtractVersionedIstanbulExtra(header); err != ErrUnknownIstanbulExtraVersion {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrUnknownIstanbulExtraVersion)
+	}
+
+	// The version byte is not part of the payload, the filtered header keeps it
+	header.Extra[IstanbulExtraVanity-1] = IstanbulExtraVersion1
+	if filtered := IstanbulFilteredHeader(header, true); IstanbulExtraVersion(filtered) != IstanbulExtraVersion1 {
+		t.Fatalf("version not kept by the filtered header")
+	}
+}
+
+func TestVersionedQBFTExtra(t *testing.T) {
+	extra := &QBFTExtra{
+		VanityData:    make([]byte, IstanbulExtraVanity),
+		Validators:    []common.Address{common.HexToAddress("0x01")},
+		CommittedSeal: [][]byte{},
+		ExchangerAddr: make([]common.Address, IstanbulRewardedExchangers+1),
+	}
+	encode := func() *Header {
+		payload, err := rlp.EncodeToBytes(extra)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &Header{Number: common.Big1, Extra: payload}
+	}
+	if _, err := ExtractVersionedQBFTExtra(encode()); err != ErrUnknownIstanbulExtraVersion {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrUnknownIstanbulExtraVersion)
+	}
+	if err := extra.SetVersion(IstanbulExtraVersion1); err != nil {
+		t.Fatal(err)
+	}
+	dec, err := ExtractVersionedQBFTExtra(encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(dec.Validators, extra.Validators) {
+		t.Fatalf("validators mismatch: have %v, want %v", dec.Validators, extra.Validators)
+	}
+	// The limits apply to the qbft lists as well
+	if err := dec.CheckLimits(IstanbulExtraLimits{Committee: IstanbulCommitteeSize}, false); !errors.Is(err, ErrOversizedIstanbulExtra) {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrOversizedIstanbulExtra)
+	}
+	extra.VanityData[IstanbulExtraVanity-1] = IstanbulExtraVersion1 + 1
+	if _, err := ExtractVersionedQBFTExtra(encode()); err != ErrUnknownIstanbulExtraVersion {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrUnknownIstanbulExtraVersion)
+	}
+	if err := extra.SetVersion(IstanbulExtraVersion1); err != ErrReservedIstanbulVanity {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrReservedIstanbulVanity)
+	}
+}
+
+func TestIstanbulExtraLimits(t *testing.T) {
+	addrs := func(n int) []common.Address { return make([]common.Address, n) }
+	msgs := func(n int) [][]byte { return make([][]byte, n) }
+	limits := IstanbulExtraLimits{Committee: IstanbulCommitteeSize, Pool: 20}
+
+	tests := []struct {
+		extra *IstanbulExtra
+		empty bool
+		ok    bool
+	}{
+		{&IstanbulExtra{Validators: addrs(11), CommittedSeal: msgs(11), ExchangerAddr: addrs(4), ValidatorAddr: addrs(8), RewardSeal: msgs(11)}, false, true},
+		{&IstanbulExtra{Validators: addrs(12)}, false, false},
+		{&IstanbulExtra{Validators: addrs(7), CommittedSeal: msgs(8)}, false, false},
+		{&IstanbulExtra{Validators: addrs(11), ExchangerAddr: addrs(5)}, false, false},
+		{&IstanbulExtra{Validators: addrs(11), RewardSeal: msgs(12)}, false, false},
+		{&IstanbulExtra{Validators: addrs(11), EmptyBlockMessages: msgs(1)}, false, false},
+		{&IstanbulExtra{Validators: addrs(20), EmptyBlockMessages: msgs(20)}, true, true},
+		{&IstanbulExtra{Validators: addrs(20), EmptyBlockMessages: msgs(21)}, true, false},
+		{&IstanbulExtra{Validators: addrs(21)}, true, false},
+		{&IstanbulExtra{Evidence: make([]*EmptyBlockEvidence, 21)}, false, false},
+	}
+	for i, tt := range tests {
+		err := tt.extra.CheckLimits(limits, tt.empty)
+		if tt.ok && err != nil {
+			t.Errorf("test %d: unexpected error %v", i, err)
+		}
+		if !tt.ok && !errors.Is(err, ErrOversizedIstanbulExtra) {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, ErrOversizedIstanbulExtra)
+		}
+	}
+	// The pool limit is not enforced if unknown
+	if err := (&IstanbulExtra{Validators: addrs(100)}).CheckLimits(IstanbulExtraLimits{Committee: IstanbulCommitteeSize}, true); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
@@ -3,11 +3,18 @@ package types
 import (
 	"errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/params"
 	"math/big"
 	"regexp"
+	"strings"
 )
 
+// InjectRewardAddress is the account collecting the inject reward share of
+// the exchanger fees
+var InjectRewardAddress = common.HexToAddress("0xFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF")
+
 type MintDeep struct {
 	UserMint     *big.Int
 	OfficialMint *big.Int
@@ -94,6 +101,7 @@ type PledgedToken struct {
 	Amount       *big.Int
 	Flag         bool
 	ProxyAddress common.Address
+	BLSPubKey    []byte
 }
 
 var DefaultDir string = "/ipfs/Qmf3xw9rEmsjJdQTV3ZcyF4KfYGtxMkXdNQ8YkVqNmLHY8"
@@ -209,6 +217,18 @@ type Wormholes struct {
 	RewardFlag    uint8            `json:"reward_flag,omitempty"`
 	BuyerAuth     TraderPayload    `json:"buyer_auth,omitempty"`
 	SellerAuth    TraderPayload    `json:"seller_auth,omitempty"`
+	// ProposalID selects the official nft proposal a vote is cast on,
+	// 0 creates a new proposal.
+	ProposalID uint64 `json:"proposal_id,omitempty"`
+	// Fields lists the exchanger fields a type 34 transaction updates, the
+	// fields not listed are left unchanged.
+	Fields []string `json:"fields,omitempty"`
+	// Amount is the hex number of snft shares to transfer
+	Amount string `json:"amount,omitempty"`
+	// BLSPubKey and BLSProof are the hex bls public key of a validator
+	// and its proof of possession
+	BLSPubKey string `json:"bls_pub_key,omitempty"`
+	BLSProof  string `json:"bls_proof,omitempty"`
 }
 
 const WormholesVersion = "v0.0.1"
@@ -311,6 +331,66 @@ func (w *Wormholes) CheckFormat() error {
 	case 28:
 	case 30:
 	case 31:
+	case 32:
+		if len(w.MetaURL) > 256 {
+			return errors.New("metaurl too long")
+		}
+
+	case 33:
+	case 34:
+		if len(w.Fields) == 0 {
+			return errors.New("no exchanger field to update")
+		}
+		for i, field := range w.Fields {
+			switch field {
+			case ExchangerFieldFeeRate, ExchangerFieldName, ExchangerFieldURL:
+			default:
+				return errors.New("invalid exchanger field")
+			}
+			for _, prev := range w.Fields[:i] {
+				if prev == field {
+					return errors.New("duplicate exchanger field")
+				}
+			}
+		}
+		if len(w.Name) > 64 {
+			return errors.New("name too long")
+		}
+		if len(w.Url) > 128 {
+			return errors.New("url too long")
+		}
+
+	case 35, 36, 37:
+		regAddr, err := regexp.Compile(PattenAddr)
+		if err != nil {
+			return err
+		}
+		match := regAddr.MatchString(w.Creator)
+		if !match {
+			return errors.New("invalid creator")
+		}
+
+	case 38, 39:
+	case 40:
+		if !strings.HasPrefix(w.Amount, "0x") &&
+			!strings.HasPrefix(w.Amount, "0X") {
+			return errors.New("amount is not string of 0x")
+		}
+		amount, ok := new(big.Int).SetString(w.Amount[2:], 16)
+		if !ok || amount.Sign() <= 0 {
+			return errors.New("invalid amount")
+		}
+
+	case 41:
+		pubKey, err := hexutil.Decode(w.BLSPubKey)
+		if err != nil || len(pubKey) != bls.PublicKeyLength {
+			return errors.New("invalid bls public key")
+		}
+		proof, err := hexutil.Decode(w.BLSProof)
+		if err != nil || len(proof) != bls.SignatureLength {
+			return errors.New("invalid bls proof")
+		}
+
 	default:
 		return errors.New("not exist nft type")
 	}
@@ -318,6 +398,44 @@ func (w *Wormholes) CheckFormat() error {
 	return nil
 }
 
+// ExchangerUpdate returns the update of the exchanger fields listed by a type
+// 34 transaction.
+func (w *Wormholes) ExchangerUpdate() *ExchangerUpdate {
+	update := new(ExchangerUpdate)
+	for _, field := range w.Fields {
+		switch field {
+		case ExchangerFieldFeeRate:
+			feeRate := w.FeeRate
+			update.FeeRate = &feeRate
+		case ExchangerFieldName:
+			name := w.Name
+			update.Name = &name
+		case ExchangerFieldURL:
+			url := w.Url
+			update.URL = &url
+		}
+	}
+	return update
+}
+
+// SetExchangerUpdate sets the fields of a type 34 transaction to update, the
+// nil fields of update are not listed.
+func (w *Wormholes) SetExchangerUpdate(update *ExchangerUpdate) {
+	w.Fields = nil
+	if update.FeeRate != nil {
+		w.FeeRate = *update.FeeRate
+		w.Fields = append(w.Fields, ExchangerFieldFeeRate)
+	}
+	if update.Name != nil {
+		w.Name = *update.Name
+		w.Fields = append(w.Fields, ExchangerFieldName)
+	}
+	if update.URL != nil {
+		w.Url = *update.URL
+		w.Fields = append(w.Fields, ExchangerFieldURL)
+	}
+}
+
 func (w *Wormholes) TxGas() (uint64, error) {
 
 	switch w.Type {
@@ -384,6 +502,26 @@ func (w *Wormholes) TxGas() (uint64, error) {
 		return params.WormholesTx30, nil
 	case 31:
 		return params.WormholesTx31, nil
+	case 32:
+		return params.WormholesTx32, nil
+	case 33:
+		return params.WormholesTx33, nil
+	case 34:
+		return params.WormholesTx34, nil
+	case 35:
+		return params.WormholesTx35, nil
+	case 36:
+		return params.WormholesTx36, nil
+	case 37:
+		return params.WormholesTx37, nil
+	case 38:
+		return params.WormholesTx38, nil
+	case 39:
+		return params.WormholesTx39, nil
+	case 40:
+		return params.WormholesTx40, nil
+	case 41:
+		return params.WormholesTx41, nil
 	default:
 		return 0, errors.New("not exist nft type")
 	}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"math/big"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -33,6 +35,34 @@ func TestInjectedOfficialNFTList(t *testing.T) {
 
 }
 
+func TestExchangerUpdate(t *testing.T) {
+	feeRate, name := uint16(0), ""
+	w := &Wormholes{Type: 34}
+	w.SetExchangerUpdate(&ExchangerUpdate{FeeRate: &feeRate, Name: &name})
+	if err := w.CheckFormat(); err != nil {
+		t.Fatalf("invalid update: %v", err)
+	}
+	if want := []string{ExchangerFieldFeeRate, ExchangerFieldName}; !reflect.DeepEqual(w.Fields, want) {
+		t.Errorf("fields mismatch: have %v, want %v", w.Fields, want)
+	}
+	update := w.ExchangerUpdate()
+	if update.FeeRate == nil || *update.FeeRate != 0 || update.Name == nil || *update.Name != "" || update.URL != nil {
+		t.Errorf("update mismatch: %+v", update)
+	}
+
+	for i, tt := range []*Wormholes{
+		{Type: 34},
+		{Type: 34, Fields: []string{"royalty"}},
+		{Type: 34, Fields: []string{ExchangerFieldURL, ExchangerFieldURL}},
+		{Type: 34, Fields: []string{ExchangerFieldName}, Name: strings.Repeat("a", 65)},
+		{Type: 34, Fields: []string{ExchangerFieldURL}, Url: strings.Repeat("a", 129)},
+	} {
+		if err := tt.CheckFormat(); err == nil {
+			t.Errorf("test %d: invalid update accepted", i)
+		}
+	}
+}
+
 // func TestSNFTExchangeList(t *testing.T) {
 // 	snftExchange := &SNFTExchange{
 // 		InjectedInfo: InjectedInfo{
@@ -0,0 +1,187 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// OfficialNFTVotingPeriod is the number of blocks a proposal is open for voting
+const OfficialNFTVotingPeriod = 720 * 24 * 7 // blockNumber of per hour * 24h * 7 days
+
+// OfficialNFTQuorumPercent is the percentage of the total validator stake the
+// voters of a proposal must have pledged for it to be elected
+const OfficialNFTQuorumPercent = 10
+
+// MaxOfficialNFTProposals is the maximum number of proposals kept at once
+const MaxOfficialNFTProposals = 256
+
+// OfficialNFTBallot is the vote of a voter on a proposal. The ballot is
+// weighted by the pledged balance of the voter when it is tallied.
+type OfficialNFTBallot struct {
+	Voter common.Address `json:"voter"`
+}
+
+// OfficialNFTProposal is a proposal of an official nft collection, the
+// Address of the embedded InjectedOfficialNFT is the proposer.
+type OfficialNFTProposal struct {
+	ID uint64 `json:"id"`
+	InjectedOfficialNFT
+	StartBlock *big.Int             `json:"start_block"`
+	EndBlock   *big.Int             `json:"end_block"`
+	Ballots    []*OfficialNFTBallot `json:"ballots"`
+}
+
+// IsOpen reports whether the proposal accepts votes at blocknumber
+func (p *OfficialNFTProposal) IsOpen(blocknumber *big.Int) bool {
+	return blocknumber.Cmp(p.EndBlock) <= 0
+}
+
+// Tally returns the sum of the stakes of the voters of the proposal, stake
+// returns the pledged balance of a voter. The stakes are read when the
+// proposal is tallied rather than when the ballots are cast, so that a stake
+// moved to another account after voting isn't counted twice.
+func (p *OfficialNFTProposal) Tally(stake func(common.Address) *big.Int) *big.Int {
+	tally := big.NewInt(0)
+	for _, ballot := range p.Ballots {
+		tally.Add(tally, stake(ballot.Voter))
+	}
+	return tally
+}
+
+// ReachedQuorum reports whether the tally of the proposal is at least
+// OfficialNFTQuorumPercent of totalStake.
+func (p *OfficialNFTProposal) ReachedQuorum(stake func(common.Address) *big.Int, totalStake *big.Int) bool {
+	if totalStake == nil || totalStake.Sign() <= 0 {
+		return false
+	}
+	voted := new(big.Int).Mul(p.Tally(stake), big.NewInt(100))
+	return voted.Cmp(new(big.Int).Mul(totalStake, big.NewInt(OfficialNFTQuorumPercent))) >= 0
+}
+
+// Vote records the ballot of voter, a second vote of the same voter is
+// ignored.
+func (p *OfficialNFTProposal) Vote(voter common.Address) {
+	for _, ballot := range p.Ballots {
+		if ballot.Voter == voter {
+			return
+		}
+	}
+	p.Ballots = append(p.Ballots, &OfficialNFTBallot{Voter: voter})
+}
+
+// OfficialNFTProposalList holds the proposals which have not been elected yet
+type OfficialNFTProposalList struct {
+	NextID    uint64
+	Proposals []*OfficialNFTProposal
+}
+
+func NewOfficialNFTProposalList() *OfficialNFTProposalList {
+	return &OfficialNFTProposalList{NextID: 1}
+}
+
+// Copy returns a deep copy of the list
+func (list *OfficialNFTProposalList) Copy() *OfficialNFTProposalList {
+	cpy := &OfficialNFTProposalList{NextID: list.NextID}
+	for _, p := range list.Proposals {
+		proposal := &OfficialNFTProposal{
+			ID: p.ID,
+			InjectedOfficialNFT: InjectedOfficialNFT{
+				Dir:        p.Dir,
+				StartIndex: new(big.Int).Set(p.StartIndex),
+				Number:     p.Number,
+				Royalty:    p.Royalty,
+				Creator:    p.Creator,
+				Address:    p.Address,
+			},
+			StartBlock: new(big.Int).Set(p.StartBlock),
+			EndBlock:   new(big.Int).Set(p.EndBlock),
+		}
+		for _, ballot := range p.Ballots {
+			proposal.Ballots = append(proposal.Ballots, &OfficialNFTBallot{Voter: ballot.Voter})
+		}
+		cpy.Proposals = append(cpy.Proposals, proposal)
+	}
+	return cpy
+}
+
+func (list *OfficialNFTProposalList) GetProposal(id uint64) *OfficialNFTProposal {
+	for _, p := range list.Proposals {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// GetOpenProposalByProposer returns the proposal of proposer which is still
+// open for voting at blocknumber.
+func (list *OfficialNFTProposalList) GetOpenProposalByProposer(proposer common.Address, blocknumber *big.Int) *OfficialNFTProposal {
+	for _, p := range list.Proposals {
+		if p.Address == proposer && p.IsOpen(blocknumber) {
+			return p
+		}
+	}
+	return nil
+}
+
+// AddProposal assigns the next ID to the proposal and opens it for voting
+// from blocknumber on.
+func (list *OfficialNFTProposalList) AddProposal(proposal *OfficialNFTProposal, blocknumber *big.Int) {
+	proposal.ID = list.NextID
+	proposal.StartBlock = new(big.Int).Set(blocknumber)
+	proposal.EndBlock = new(big.Int).Add(blocknumber, big.NewInt(OfficialNFTVotingPeriod))
+	list.NextID++
+	list.Proposals = append(list.Proposals, proposal)
+}
+
+// Leading returns the open proposal with the highest tally, ties are broken
+// by the lower ID.
+func (list *OfficialNFTProposalList) Leading(blocknumber *big.Int, stake func(common.Address) *big.Int) *OfficialNFTProposal {
+	var leading *OfficialNFTProposal
+	var leadingTally *big.Int
+	for _, p := range list.Proposals {
+		if !p.IsOpen(blocknumber) {
+			continue
+		}
+		tally := p.Tally(stake)
+		if leading == nil || tally.Cmp(leadingTally) > 0 {
+			leading, leadingTally = p, tally
+		}
+	}
+	return leading
+}
+
+// Elect removes the closed proposals which didn't reach the quorum of
+// totalStake and returns the closed proposal with the highest tally, which is
+// removed too. Ties are broken by the lower ID, nil is returned if no proposal
+// can be elected.
+func (list *OfficialNFTProposalList) Elect(blocknumber *big.Int, stake func(common.Address) *big.Int, totalStake *big.Int) *OfficialNFTProposal {
+	var elected *OfficialNFTProposal
+	var electedTally *big.Int
+	remaining := make([]*OfficialNFTProposal, 0, len(list.Proposals))
+	for _, p := range list.Proposals {
+		if p.IsOpen(blocknumber) {
+			remaining = append(remaining, p)
+			continue
+		}
+		if !p.ReachedQuorum(stake, totalStake) {
+			continue
+		}
+		remaining = append(remaining, p)
+		tally := p.Tally(stake)
+		if elected == nil || tally.Cmp(electedTally) > 0 {
+			elected, electedTally = p, tally
+		}
+	}
+	list.Proposals = remaining
+	if elected != nil {
+		for i, p := range list.Proposals {
+			if p == elected {
+				list.Proposals = append(list.Proposals[:i], list.Proposals[i+1:]...)
+				break
+			}
+		}
+	}
+	return elected
+}
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func newTestProposal(proposer common.Address) *OfficialNFTProposal {
+	return &OfficialNFTProposal{
+		InjectedOfficialNFT: InjectedOfficialNFT{
+			Dir:        DefaultDir,
+			StartIndex: big.NewInt(0),
+			Number:     DefaultNumber,
+			Royalty:    DefaultRoyalty,
+			Creator:    proposer.Hex(),
+			Address:    proposer,
+		},
+	}
+}
+
+func TestOfficialNFTProposalList(t *testing.T) {
+	proposer1 := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	proposer2 := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	voters := []common.Address{
+		common.HexToAddress("0x0000000000000000000000000000000000000011"),
+		common.HexToAddress("0x0000000000000000000000000000000000000012"),
+		common.HexToAddress("0x0000000000000000000000000000000000000013"),
+	}
+
+	// The voters pledged 5% of the total stake each, the quorum is reached
+	// from two voters on
+	stakes := map[common.Address]*big.Int{
+		voters[0]: big.NewInt(5),
+		voters[1]: big.NewInt(5),
+		voters[2]: big.NewInt(5),
+	}
+	stake := func(addr common.Address) *big.Int {
+		if s, ok := stakes[addr]; ok {
+			return s
+		}
+		return new(big.Int)
+	}
+	totalStake := big.NewInt(100)
+
+	list := NewOfficialNFTProposalList()
+	p1, p2 := newTestProposal(proposer1), newTestProposal(proposer2)
+	list.AddProposal(p1, big.NewInt(10))
+	list.AddProposal(p2, big.NewInt(20))
+	if p1.ID != 1 || p2.ID != 2 {
+		t.Fatalf("proposal id mismatch: have %d %d, want 1 2", p1.ID, p2.ID)
+	}
+	if list.GetOpenProposalByProposer(proposer1, big.NewInt(10)) != p1 {
+		t.Fatal("open proposal of proposer1 not found")
+	}
+
+	// The second vote of a voter is ignored
+	p1.Vote(voters[0])
+	p1.Vote(voters[0])
+	p2.Vote(voters[1])
+	p2.Vote(voters[2])
+	if tally := p1.Tally(stake); tally.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("tally mismatch: have %v, want 5", tally)
+	}
+	if list.Leading(big.NewInt(20), stake) != p2 {
+		t.Fatal("leading proposal mismatch")
+	}
+
+	// The ballots are weighted by the current stake of the voters
+	stakes[voters[2]] = new(big.Int)
+	if list.Leading(big.NewInt(20), stake) != p1 {
+		t.Fatal("leading proposal not weighted by the current stake")
+	}
+	stakes[voters[2]] = big.NewInt(5)
+
+	// Nothing is elected while the proposals are open
+	if elected := list.Elect(big.NewInt(20), stake, totalStake); elected != nil {
+		t.Fatalf("proposal %d elected while open", elected.ID)
+	}
+
+	// p1 closes without quorum and is dropped
+	end1 := new(big.Int).Add(p1.EndBlock, big.NewInt(1))
+	if elected := list.Elect(end1, stake, totalStake); elected != nil {
+		t.Fatalf("proposal %d elected without quorum", elected.ID)
+	}
+	if list.GetProposal(p1.ID) != nil {
+		t.Fatal("proposal without quorum not dropped")
+	}
+
+	for _, voter := range voters {
+		p2.Vote(voter)
+	}
+	cpy := list.Copy()
+	end2 := new(big.Int).Add(p2.EndBlock, big.NewInt(1))
+	if elected := list.Elect(end2, stake, totalStake); elected != p2 {
+		t.Fatal("proposal with quorum not elected")
+	}
+	if len(list.Proposals) != 0 {
+		t.Fatalf("elected proposal not removed: %d left", len(list.Proposals))
+	}
+	if len(cpy.Proposals) != 1 || cpy.Proposals[0].Tally(stake).Cmp(big.NewInt(15)) != 0 {
+		t.Fatal("copy modified by elect")
+	}
+
+	// The list survives an rlp round trip
+	data, err := rlp.EncodeToBytes(cpy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec := new(OfficialNFTProposalList)
+	if err := rlp.Decode(bytes.NewReader(data), dec); err != nil {
+		t.Fatal(err)
+	}
+	if dec.NextID != 3 || len(dec.Proposals) != 1 || len(dec.Proposals[0].Ballots) != 3 {
+		t.Fatalf("decoded list mismatch: %+v", dec)
+	}
+}
+
+func TestOfficialNFTProposalQuorum(t *testing.T) {
+	whale := common.HexToAddress("0x0000000000000000000000000000000000000021")
+	stakes := map[common.Address]*big.Int{whale: big.NewInt(9)}
+	stake := func(addr common.Address) *big.Int {
+		if s, ok := stakes[addr]; ok {
+			return s
+		}
+		return new(big.Int)
+	}
+	totalStake := big.NewInt(100)
+
+	// Voters without stake don't count towards the quorum, however many
+	p := newTestProposal(whale)
+	p.Vote(whale)
+	for i := 0; i < 10; i++ {
+		p.Vote(common.BigToAddress(big.NewInt(int64(0x100 + i))))
+	}
+	if p.ReachedQuorum(stake, totalStake) {
+		t.Fatal("quorum reached with 9% of the stake")
+	}
+
+	// Splitting a stake across accounts doesn't change the quorum
+	split := common.HexToAddress("0x0000000000000000000000000000000000000022")
+	stakes[whale], stakes[split] = big.NewInt(4), big.NewInt(5)
+	p.Vote(split)
+	if p.ReachedQuorum(stake, totalStake) {
+		t.Fatal("quorum reached by splitting a stake")
+	}
+
+	stakes[split] = big.NewInt(6)
+	if !p.ReachedQuorum(stake, totalStake) {
+		t.Fatal("quorum not reached with 10% of the stake")
+	}
+	if p.ReachedQuorum(stake, new(big.Int)) {
+		t.Fatal("quorum reached without stake")
+	}
+}
@@ -46,3 +46,15 @@ func (ol *OnlineValidatorList) ExistAddress(addr common.Address) bool {
 	}
 	return false
 }
+
+// OnlineAttestation is the evidence a node collected of the validators online
+// at a height: the senders of the consensus messages of the height and the
+// votes for the empty block the node proposed.
+type OnlineAttestation struct {
+	Height     uint64
+	Validators []common.Address // Senders of the consensus messages of the height
+	Proposer   common.Address   // Empty block proposer the votes are for, zero if no vote was collected
+	Voters     []common.Address // Reward addresses of the empty block voters, the proposer first
+	Weight     *big.Int         // Coefficient weighted stake of the voters
+	Messages   [][]byte         // Signed empty block votes, the message of the proposer first
+}
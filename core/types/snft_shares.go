@@ -0,0 +1,107 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SNFTSharesVault is the owner of the snfts which are locked because they
+// have been fractionalized, nobody holds the key of this address.
+var SNFTSharesVault = common.HexToAddress("0xFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFE")
+
+// SNFTShareSupply is the number of shares minted when a snft is fractionalized
+var SNFTShareSupply, _ = new(big.Int).SetString("1000000000000000000", 10)
+
+// SNFTShareBalance is the number of shares of a fractionalized snft
+type SNFTShareBalance struct {
+	NFTAddress common.Address
+	Amount     *big.Int
+}
+
+// SNFTShares holds the fractionalization data of an account. On a
+// fractionalized snft Supply is the number of shares minted, on any
+// account Balances are the shares it holds.
+type SNFTShares struct {
+	Supply   *big.Int
+	Balances []SNFTShareBalance
+}
+
+// Copy returns a deep copy of the shares, a nil receiver returns empty shares.
+func (ss *SNFTShares) Copy() *SNFTShares {
+	cpy := &SNFTShares{}
+	if ss == nil {
+		return cpy
+	}
+	if ss.Supply != nil {
+		cpy.Supply = new(big.Int).Set(ss.Supply)
+	}
+	for _, v := range ss.Balances {
+		cpy.Balances = append(cpy.Balances, SNFTShareBalance{
+			NFTAddress: v.NFTAddress,
+			Amount:     new(big.Int).Set(v.Amount),
+		})
+	}
+	return cpy
+}
+
+// IsEmpty reports whether the account neither is fractionalized nor holds shares.
+func (ss *SNFTShares) IsEmpty() bool {
+	return ss == nil ||
+		((ss.Supply == nil || ss.Supply.Sign() == 0) &&
+			len(ss.Balances) == 0)
+}
+
+// IsFractionalized reports whether the snft is locked by its shares.
+func (ss *SNFTShares) IsFractionalized() bool {
+	return ss != nil && ss.Supply != nil && ss.Supply.Sign() > 0
+}
+
+// Balance returns the shares of the snft nftaddress.
+func (ss *SNFTShares) Balance(nftaddress common.Address) *big.Int {
+	if ss == nil {
+		return big.NewInt(0)
+	}
+	for _, v := range ss.Balances {
+		if v.NFTAddress == nftaddress {
+			return new(big.Int).Set(v.Amount)
+		}
+	}
+	return big.NewInt(0)
+}
+
+// AddBalance adds amount to the shares of the snft nftaddress.
+func (ss *SNFTShares) AddBalance(nftaddress common.Address, amount *big.Int) {
+	for i, v := range ss.Balances {
+		if v.NFTAddress == nftaddress {
+			ss.Balances[i].Amount = new(big.Int).Add(v.Amount, amount)
+			return
+		}
+	}
+	ss.Balances = append(ss.Balances, SNFTShareBalance{
+		NFTAddress: nftaddress,
+		Amount:     new(big.Int).Set(amount),
+	})
+}
+
+// SubBalance subtracts amount from the shares of the snft nftaddress, it
+// returns false if the balance is insufficient. A balance which drops to 0
+// is removed.
+func (ss *SNFTShares) SubBalance(nftaddress common.Address, amount *big.Int) bool {
+	for i, v := range ss.Balances {
+		if v.NFTAddress != nftaddress {
+			continue
+		}
+		switch v.Amount.Cmp(amount) {
+		case 1:
+			ss.Balances[i].Amount = new(big.Int).Sub(v.Amount, amount)
+			return true
+		case 0:
+			ss.Balances = append(ss.Balances[:i], ss.Balances[i+1:]...)
+			return true
+		default:
+			return false
+		}
+	}
+	return amount.Sign() == 0
+}
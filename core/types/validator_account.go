@@ -17,6 +17,9 @@ type Validator struct {
 	Balance *big.Int
 	Proxy   common.Address
 	Weight  []*big.Int
+	// BLSPubKey is the bls key the validator aggregates its seals with,
+	// it belongs to the proxy if the validator has one
+	BLSPubKey []byte `rlp:"optional"`
 }
 
 func (v *Validator) Address() common.Address {
@@ -35,6 +38,9 @@ func NewValidatorList(validators []*Validator) *ValidatorList {
 	validatorList := new(ValidatorList)
 	for _, v := range validators {
 		validatorList.AddValidator(v.Addr, v.Balance, v.Proxy)
+		if len(v.BLSPubKey) > 0 {
+			validatorList.SetBLSPubKey(v.Addr, v.BLSPubKey)
+		}
 	}
 	return validatorList
 }
@@ -410,6 +416,9 @@ func (vl *ValidatorList) DeepCopy() *ValidatorList {
 			Balance: new(big.Int).Set(validator.Balance),
 			Proxy:   validator.Proxy,
 		}
+		if validator.BLSPubKey != nil {
+			tempValidator.BLSPubKey = common.CopyBytes(validator.BLSPubKey)
+		}
 		for _, v := range validator.Weight {
 			tempValidator.Weight = append(tempValidator.Weight, new(big.Int).Set(v))
 		}
@@ -721,3 +730,25 @@ func (vl *ValidatorList) GetValidatorAddr(address common.Address) common.Address
 	}
 	return common.Address{}
 }
+
+// SetBLSPubKey registers the bls public key of the validator addr
+func (vl *ValidatorList) SetBLSPubKey(addr common.Address, pubKey []byte) bool {
+	for _, v := range vl.Validators {
+		if v.Addr == addr {
+			v.BLSPubKey = common.CopyBytes(pubKey)
+			return true
+		}
+	}
+	return false
+}
+
+// BLSPubKey returns the bls public key registered by the validator or proxy
+// address, nil if there is none
+func (vl *ValidatorList) BLSPubKey(address common.Address) []byte {
+	for _, v := range vl.Validators {
+		if v.Addr == address || v.Proxy == address {
+			return v.BLSPubKey
+		}
+	}
+	return nil
+}
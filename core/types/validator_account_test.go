@@ -2101,3 +2101,31 @@ func randomHash() common.Hash {
 	}
 	return hash
 }
+
+func TestValidatorBLSPubKey(t *testing.T) {
+	addr, proxy := RandomAddr(), RandomAddr()
+	validatorList := NewValidatorList(nil)
+	validatorList.AddValidator(addr, big.NewInt(100), proxy)
+	if validatorList.SetBLSPubKey(RandomAddr(), []byte{0x01}) {
+		t.Fatal("bls key registered for unknown validator")
+	}
+	if !validatorList.SetBLSPubKey(addr, []byte{0x01}) {
+		t.Fatal("bls key not registered")
+	}
+	// The key is found by the proxy, which signs the seals
+	if !bytes.Equal(validatorList.BLSPubKey(proxy), []byte{0x01}) {
+		t.Fatal("bls key of proxy not found")
+	}
+	// The key survives a copy and an rlp round trip
+	data, err := rlp.EncodeToBytes(validatorList.DeepCopy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dec ValidatorList
+	if err := rlp.DecodeBytes(data, &dec); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dec.BLSPubKey(addr), []byte{0x01}) {
+		t.Fatal("bls key lost in rlp round trip")
+	}
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CheckpointValidator is a validator of the pool with the coefficient its
+// stake is weighted with when the committees are drawn
+type CheckpointValidator struct {
+	Addr        common.Address
+	Balance     *big.Int
+	Proxy       common.Address
+	Coefficient uint8
+	BLSPubKey   []byte
+}
+
+// ValidatorCheckpoint is the validator pool left by a block, from which the
+// committee of the next block is drawn. The headers commit to it so clients
+// without state can derive the committees.
+type ValidatorCheckpoint struct {
+	Validators []*CheckpointValidator
+}
+
+// NewValidatorCheckpoint returns the checkpoint of the validators in pool
+// weighted with coefficients
+func NewValidatorCheckpoint(pool *ValidatorList, coefficients map[common.Address]uint8) *ValidatorCheckpoint {
+	checkpoint := &ValidatorCheckpoint{Validators: make([]*CheckpointValidator, 0, len(pool.Validators))}
+	for _, v := range pool.Validators {
+		checkpoint.Validators = append(checkpoint.Validators, &CheckpointValidator{
+			Addr:        v.Addr,
+			Balance:     new(big.Int).Set(v.Balance),
+			Proxy:       v.Proxy,
+			Coefficient: coefficients[v.Addr],
+			BLSPubKey:   common.CopyBytes(v.BLSPubKey),
+		})
+	}
+	return checkpoint
+}
+
+// Hash returns the hash the headers commit to
+func (c *ValidatorCheckpoint) Hash() common.Hash {
+	return rlpHash(c)
+}
+
+// ValidatorList returns the validator pool with the address ranges of the
+// validators calculated from their stake and coefficient
+func (c *ValidatorCheckpoint) ValidatorList() *ValidatorList {
+	list := &ValidatorList{Validators: make([]*Validator, 0, len(c.Validators))}
+	for _, v := range c.Validators {
+		list.Validators = append(list.Validators, &Validator{
+			Addr:      v.Addr,
+			Balance:   new(big.Int).Set(v.Balance),
+			Proxy:     v.Proxy,
+			BLSPubKey: common.CopyBytes(v.BLSPubKey),
+		})
+	}
+	for _, v := range c.Validators {
+		list.CalculateAddressRangeV2(v.Addr, v.Balance, big.NewInt(int64(v.Coefficient)))
+	}
+	return list
+}
+
+// Coefficients returns the coefficients of the validators in order
+func (c *ValidatorCheckpoint) Coefficients() []uint8 {
+	coefficients := make([]uint8, len(c.Validators))
+	for i, v := range c.Validators {
+		coefficients[i] = v.Coefficient
+	}
+	return coefficients
+}
+
+// Coefficient returns the coefficient of the validator addr, or 0 if it is
+// not in the checkpoint
+func (c *ValidatorCheckpoint) Coefficient(addr common.Address) uint8 {
+	for _, v := range c.Validators {
+		if v.Addr == addr {
+			return v.Coefficient
+		}
+	}
+	return 0
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ValidatorStats is the participation of a validator in the consensus over a
+// range of blocks
+type ValidatorStats struct {
+	Address               common.Address
+	Proposed              uint64 // Blocks proposed
+	CommittedSeals        uint64 // Committed seals contributed
+	RewardSeals           uint64 // Reward seals contributed
+	EmptyBlockVotes       uint64 // Votes for empty blocks contributed
+	CoefficientAdded      uint64 // Coefficient added by the consensus rules, nominal as the state restores the coefficient to its maximum on any addition
+	CoefficientSubtracted uint64 // Coefficient subtracted by the consensus rules
+}
+
+// Add adds the participation of other to s
+func (s *ValidatorStats) Add(other *ValidatorStats) {
+	s.Proposed += other.Proposed
+	s.CommittedSeals += other.CommittedSeals
+	s.RewardSeals += other.RewardSeals
+	s.EmptyBlockVotes += other.EmptyBlockVotes
+	s.CoefficientAdded += other.CoefficientAdded
+	s.CoefficientSubtracted += other.CoefficientSubtracted
+}
+
+// ValidatorStatsSet is the participation of the validators indexed by address
+type ValidatorStatsSet map[common.Address]*ValidatorStats
+
+// Get returns the participation of addr, it is created if missing
+func (set ValidatorStatsSet) Get(addr common.Address) *ValidatorStats {
+	if s, ok := set[addr]; ok {
+		return s
+	}
+	s := &ValidatorStats{Address: addr}
+	set[addr] = s
+	return s
+}
+
+// Add adds the participation of the validators in stats to the set
+func (set ValidatorStatsSet) Add(stats []*ValidatorStats) {
+	for _, s := range stats {
+		set.Get(s.Address).Add(s)
+	}
+}
+
+// List returns the participation of the validators ordered by address
+func (set ValidatorStatsSet) List() []*ValidatorStats {
+	list := make([]*ValidatorStats, 0, len(set))
+	for _, s := range set {
+		list = append(list, s)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return bytes.Compare(list[i].Address[:], list[j].Address[:]) < 0
+	})
+	return list
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestValidatorStatsSet(t *testing.T) {
+	a, b := common.HexToAddress("0x02"), common.HexToAddress("0x01")
+	set := make(ValidatorStatsSet)
+	set.Add([]*ValidatorStats{
+		{Address: a, Proposed: 1, CoefficientAdded: 20},
+		{Address: b, EmptyBlockVotes: 1},
+	})
+	set.Add([]*ValidatorStats{
+		{Address: a, CommittedSeals: 2, CoefficientSubtracted: 20},
+	})
+	list := set.List()
+	if len(list) != 2 || list[0].Address != b || list[1].Address != a {
+		t.Fatalf("order mismatch: %v", list)
+	}
+	want := ValidatorStats{Address: a, Proposed: 1, CommittedSeals: 2, CoefficientAdded: 20, CoefficientSubtracted: 20}
+	if *list[1] != want {
+		t.Fatalf("stats mismatch: have %+v, want %+v", *list[1], want)
+	}
+
+	enc, err := rlp.EncodeToBytes(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dec []*ValidatorStats
+	if err := rlp.DecodeBytes(enc, &dec); err != nil {
+		t.Fatal(err)
+	}
+	if len(dec) != 2 || *dec[1] != want {
+		t.Fatalf("decoded stats mismatch: %+v", dec)
+	}
+}
@@ -0,0 +1,166 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// validatorStatsThrottling is the time to wait between processing two
+	// consecutive index sections.
+	validatorStatsThrottling = 100 * time.Millisecond
+
+	// maxUnindexedValidatorStats is the maximum number of sections worth of
+	// blocks attributed on the fly when querying a range of blocks.
+	maxUnindexedValidatorStats = 4
+)
+
+var (
+	validatorStatsSectionGauge        = metrics.NewRegisteredGauge("chain/validators/section", nil)
+	validatorStatsActiveGauge         = metrics.NewRegisteredGauge("chain/validators/active", nil)
+	validatorStatsProposedGauge       = metrics.NewRegisteredGauge("chain/validators/proposed", nil)
+	validatorStatsCommittedSealsGauge = metrics.NewRegisteredGauge("chain/validators/committedseals", nil)
+	validatorStatsRewardSealsGauge    = metrics.NewRegisteredGauge("chain/validators/rewardseals", nil)
+	validatorStatsEmptyVotesGauge     = metrics.NewRegisteredGauge("chain/validators/emptyvotes", nil)
+
+	// The participation of the local validator, a single validator keeps the
+	// number of metrics bounded
+	localValidatorProposedGauge       = metrics.NewRegisteredGauge("chain/validators/local/proposed", nil)
+	localValidatorCommittedSealsGauge = metrics.NewRegisteredGauge("chain/validators/local/committedseals", nil)
+	localValidatorRewardSealsGauge    = metrics.NewRegisteredGauge("chain/validators/local/rewardseals", nil)
+	localValidatorEmptyVotesGauge     = metrics.NewRegisteredGauge("chain/validators/local/emptyvotes", nil)
+	localValidatorCoefficientAddGauge = metrics.NewRegisteredGauge("chain/validators/local/coefficient/added", nil)
+	localValidatorCoefficientSubGauge = metrics.NewRegisteredGauge("chain/validators/local/coefficient/subtracted", nil)
+
+	errValidatorStatsRange = errors.New("too many unindexed blocks in range")
+)
+
+// ValidatorStatsIndexer implements a core.ChainIndexer, aggregating the
+// participation of the validators in the consensus per section.
+type ValidatorStatsIndexer struct {
+	db      ethdb.Database
+	chain   consensus.ChainHeaderReader
+	reader  consensus.ParticipationReader
+	self    common.Address // Local validator whose participation is exported as metrics
+	section uint64
+	stats   types.ValidatorStatsSet
+}
+
+// NewValidatorStatsIndexer returns a chain indexer that aggregates the
+// participation of the validators of the canonical chain, the one of self is
+// exported as metrics too.
+func NewValidatorStatsIndexer(db ethdb.Database, chain consensus.ChainHeaderReader, reader consensus.ParticipationReader, self common.Address, size, confirms uint64) *ChainIndexer {
+	backend := &ValidatorStatsIndexer{
+		db:     db,
+		chain:  chain,
+		reader: reader,
+		self:   self,
+	}
+	table := rawdb.NewTable(db, string(rawdb.ValidatorStatsIndexPrefix))
+
+	return NewChainIndexer(db, table, backend, size, confirms, validatorStatsThrottling, "validatorstats")
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new validator stats
+// section.
+func (v *ValidatorStatsIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	v.section, v.stats = section, make(types.ValidatorStatsSet)
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, adding the participation of
+// the validators in the block to the section.
+func (v *ValidatorStatsIndexer) Process(ctx context.Context, header *types.Header) error {
+	stats, err := v.reader.Participation(v.chain, header)
+	if err != nil {
+		return err
+	}
+	v.stats.Add(stats)
+	return nil
+}
+
+// Commit implements core.ChainIndexerBackend, writing the section out into
+// the database and exporting its totals and the participation of the local
+// validator as metrics. The participation of the other validators is served
+// by the API, not as metrics, to keep the number of metrics bounded.
+func (v *ValidatorStatsIndexer) Commit() error {
+	stats := v.stats.List()
+	batch := v.db.NewBatch()
+	rawdb.WriteValidatorStats(batch, v.section, stats)
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	total, local := new(types.ValidatorStats), new(types.ValidatorStats)
+	for _, s := range stats {
+		total.Add(s)
+		if s.Address == v.self {
+			local.Add(s)
+		}
+	}
+	validatorStatsSectionGauge.Update(int64(v.section))
+	validatorStatsActiveGauge.Update(int64(len(stats)))
+	validatorStatsProposedGauge.Update(int64(total.Proposed))
+	validatorStatsCommittedSealsGauge.Update(int64(total.CommittedSeals))
+	validatorStatsRewardSealsGauge.Update(int64(total.RewardSeals))
+	validatorStatsEmptyVotesGauge.Update(int64(total.EmptyBlockVotes))
+	localValidatorProposedGauge.Update(int64(local.Proposed))
+	localValidatorCommittedSealsGauge.Update(int64(local.CommittedSeals))
+	localValidatorRewardSealsGauge.Update(int64(local.RewardSeals))
+	localValidatorEmptyVotesGauge.Update(int64(local.EmptyBlockVotes))
+	localValidatorCoefficientAddGauge.Update(int64(local.CoefficientAdded))
+	localValidatorCoefficientSubGauge.Update(int64(local.CoefficientSubtracted))
+	return nil
+}
+
+// Prune returns an empty error since we don't support pruning here.
+func (v *ValidatorStatsIndexer) Prune(threshold uint64) error {
+	return nil
+}
+
+// GetValidatorStats returns the participation of addr in the canonical blocks
+// from..to. The sections aggregated by the indexer are read from the database,
+// the remaining blocks are attributed on the fly.
+func GetValidatorStats(db ethdb.Database, chain consensus.ChainHeaderReader, reader consensus.ParticipationReader, indexer *ChainIndexer, size uint64, addr common.Address, from, to uint64) (*types.ValidatorStats, error) {
+	sections, _, _ := indexer.Sections()
+
+	result := &types.ValidatorStats{Address: addr}
+	var unindexed uint64
+	for number := from; number <= to; {
+		section := number / size
+		if number%size == 0 && number+size-1 <= to && section < sections {
+			for _, s := range rawdb.ReadValidatorStats(db, section) {
+				if s.Address == addr {
+					result.Add(s)
+				}
+			}
+			number += size
+			continue
+		}
+		if unindexed++; unindexed > maxUnindexedValidatorStats*size {
+			return nil, errValidatorStatsRange
+		}
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			break
+		}
+		stats, err := reader.Participation(chain, header)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range stats {
+			if s.Address == addr {
+				result.Add(s)
+			}
+		}
+		number++
+	}
+	return result, nil
+}
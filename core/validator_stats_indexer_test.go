@@ -0,0 +1,108 @@
+package core
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// testParticipationChain serves the canonical headers of a database
+type testParticipationChain struct {
+	db ethdb.Database
+}
+
+func (c *testParticipationChain) Config() *params.ChainConfig { return params.TestChainConfig }
+func (c *testParticipationChain) CurrentHeader() *types.Header {
+	return c.GetHeaderByHash(rawdb.ReadHeadHeaderHash(c.db))
+}
+func (c *testParticipationChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	return rawdb.ReadHeader(c.db, hash, number)
+}
+func (c *testParticipationChain) GetHeaderByNumber(number uint64) *types.Header {
+	return rawdb.ReadHeader(c.db, rawdb.ReadCanonicalHash(c.db, number), number)
+}
+func (c *testParticipationChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	number := rawdb.ReadHeaderNumber(c.db, hash)
+	if number == nil {
+		return nil
+	}
+	return rawdb.ReadHeader(c.db, hash, *number)
+}
+
+// testParticipationReader attributes the proposal of a block to its coinbase
+// and a committed seal to every validator
+type testParticipationReader struct {
+	validators []common.Address
+}
+
+func (r *testParticipationReader) Participation(chain consensus.ChainHeaderReader, header *types.Header) ([]*types.ValidatorStats, error) {
+	stats := make(types.ValidatorStatsSet)
+	stats.Get(header.Coinbase).Proposed++
+	for _, v := range r.validators {
+		stats.Get(v).CommittedSeals++
+	}
+	return stats.List(), nil
+}
+
+func TestValidatorStatsIndexer(t *testing.T) {
+	const sectionSize = 4
+
+	db := rawdb.NewMemoryDatabase()
+	defer db.Close()
+
+	validators := []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")}
+	var parent common.Hash
+	for i := uint64(0); i < 3*sectionSize; i++ {
+		header := &types.Header{Number: new(big.Int).SetUint64(i), ParentHash: parent, Coinbase: validators[i%2]}
+		rawdb.WriteHeader(db, header)
+		rawdb.WriteCanonicalHash(db, header.Hash(), i)
+		rawdb.WriteHeadHeaderHash(db, header.Hash())
+		parent = header.Hash()
+	}
+	chain := &testParticipationChain{db: db}
+	reader := &testParticipationReader{validators: validators}
+	indexer := NewValidatorStatsIndexer(db, chain, reader, validators[0], sectionSize, 0)
+	defer indexer.Close()
+
+	// Index the first two sections, the last one is left unindexed
+	indexer.newHead(2*sectionSize-1, false)
+	for i := 0; ; i++ {
+		if sections, _, _ := indexer.Sections(); sections == 2 {
+			break
+		}
+		if i == 300 {
+			t.Fatal("sections not indexed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	want := []*types.ValidatorStats{
+		{Address: validators[0], Proposed: sectionSize / 2, CommittedSeals: sectionSize},
+		{Address: validators[1], Proposed: sectionSize / 2, CommittedSeals: sectionSize},
+	}
+	if have := rawdb.ReadValidatorStats(db, 1); !reflect.DeepEqual(have, want) {
+		t.Fatalf("section stats mismatch: have %v, want %v", have, want)
+	}
+
+	// The range starts within the first section and ends in the unindexed one
+	have, err := GetValidatorStats(db, chain, reader, indexer, sectionSize, validators[0], 2, 3*sectionSize-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&types.ValidatorStats{Address: validators[0], Proposed: 5, CommittedSeals: 10}); !reflect.DeepEqual(have, want) {
+		t.Fatalf("range stats mismatch: have %v, want %v", have, want)
+	}
+
+	// Too many unindexed blocks are rejected
+	indexer.setValidSections(0)
+	if _, err := GetValidatorStats(db, chain, reader, indexer, 1, validators[0], 0, 3*sectionSize-1); err != errValidatorStatsRange {
+		t.Fatalf("error mismatch: have %v, want %v", err, errValidatorStatsRange)
+	}
+}
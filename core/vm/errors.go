@@ -63,6 +63,26 @@ var (
 	ErrNotMergedSNFT                = errors.New("not merged snft")
 	ErrHasBeenPledged               = errors.New("has been pledged")
 	ErrNotExistFrozenAccount        = errors.New("not exist frozen account or unfrozen time not arrive in")
+	ErrNotCreator                   = errors.New("not the creator of the nft")
+	ErrMetaURLFrozen                = errors.New("nft metaurl has been frozen")
+	ErrNotAllowedUpdateOfficialNFT  = errors.New("not allowed update official nft metaurl")
+	ErrCreatorNotAllowed            = errors.New("creator not allowed by exchanger")
+	ErrCreatorAlreadyAllowed        = errors.New("creator already allowed by exchanger")
+	ErrTooManyAllowedCreators       = errors.New("too many allowed creators")
+	ErrTooManyCollectionFeeRates    = errors.New("too many collection fee rates")
+	ErrNotFractionalized            = errors.New("snft is not fractionalized")
+	ErrInsufficientShares           = errors.New("insufficient snft shares")
+	ErrInvalidBLSProof              = errors.New("invalid bls proof of possession")
+	ErrBuyerDataExpired             = errors.New("buyer's data is expired!")
+	ErrSellerDataExpired            = errors.New("seller's data is expired!")
+	ErrExchangerDataExpired         = errors.New("exchanger's data is expired!")
+	ErrInsufficientBuyerBalance     = errors.New("insufficient balance")
+	ErrTxAmount                     = errors.New("tx amount error")
+	ErrNotBuyer                     = errors.New("to of the tx is not buyer!")
+	ErrNotApproved                  = errors.New("from of the tx is not approved!")
+	ErrNoRightToSell                = errors.New("no right to sell nft")
+	ErrNFTAddressMismatch           = errors.New("the nft address is not same from buyer and seller!")
+	ErrGetNFTOwner                  = errors.New("Get nft owner error!")
 )
 
 // ErrStackUnderflow wraps an evm error when the items on the stack less
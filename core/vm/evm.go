@@ -47,6 +47,9 @@ const CancelPledgedInterval = 3 * 24 // for test
 const CloseExchangerInterval = 3 * 24 // for test
 //const CancelNFTPledgedInterval = 365 * 720 * 24 // day * blockNumber of per hour * 24h
 const CancelNFTPledgedInterval = 3 * 24 // for test
+const ExchangerFeeRateNoticeInterval = 7 * 720 * 24 // day * blockNumber of per hour * 24h
+const MaxExchangerAllowedCreators = 1000
+const MaxExchangerCollectionFeeRates = 1000
 const VALIDATOR_COEFFICIENT = 70
 
 type (
@@ -97,14 +100,14 @@ type (
 	IsApprovedForAllFunc                   func(StateDB, common.Address, common.Address) bool
 	VerifyPledgedBalanceFunc               func(StateDB, common.Address, *big.Int) bool
 	InjectOfficialNFTFunc                  func(StateDB, string, *big.Int, uint64, uint16, string)
-	BuyNFTBySellerOrExchangerFunc          func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int) error
-	BuyNFTByBuyerFunc                      func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int) error
-	BuyAndMintNFTByBuyerFunc               func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int) error
-	BuyAndMintNFTByExchangerFunc           func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int) error
-	BuyNFTByApproveExchangerFunc           func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int) error
-	BatchBuyNFTByApproveExchangerFunc      func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int) error
-	BuyAndMintNFTByApprovedExchangerFunc   func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int) error
-	BuyNFTByExchangerFunc                  func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int) error
+	BuyNFTBySellerOrExchangerFunc          func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int, bool) error
+	BuyNFTByBuyerFunc                      func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int, bool) error
+	BuyAndMintNFTByBuyerFunc               func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int, bool) error
+	BuyAndMintNFTByExchangerFunc           func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int, bool) error
+	BuyNFTByApproveExchangerFunc           func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int, bool) error
+	BatchBuyNFTByApproveExchangerFunc      func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int, bool) error
+	BuyAndMintNFTByApprovedExchangerFunc   func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int, bool) error
+	BuyNFTByExchangerFunc                  func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int, bool) error
 	AddExchangerTokenFunc                  func(StateDB, common.Address, *big.Int)
 	ModifyOpenExchangerTimeFunc            func(StateDB, common.Address, *big.Int)
 	SubExchangerTokenFunc                  func(StateDB, common.Address, *big.Int)
@@ -112,9 +115,11 @@ type (
 	VerifyExchangerBalanceFunc             func(StateDB, common.Address, *big.Int) bool
 	GetNftAddressAndLevelFunc              func(string) (common.Address, int, error)
 	VoteOfficialNFTFunc                    func(StateDB, *types.NominatedOfficialNFT, *big.Int) error
+	ProposeOfficialNFTFunc                 func(StateDB, *types.NominatedOfficialNFT, *big.Int) error
+	VoteOfficialNFTProposalFunc            func(StateDB, common.Address, uint64, *big.Int) error
 	ElectNominatedOfficialNFTFunc          func(StateDB, *big.Int)
 	NextIndexFunc                          func(db StateDB) *big.Int
-	VoteOfficialNFTByApprovedExchangerFunc func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int) error
+	VoteOfficialNFTByApprovedExchangerFunc func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int, bool) error
 	//ChangeRewardFlagFunc                   func(StateDB, common.Address, uint8)
 	//PledgeNFTFunc                   func(StateDB, common.Address, *big.Int)
 	//CancelPledgedNFTFunc            func(StateDB, common.Address)
@@ -122,7 +127,17 @@ type (
 	//GetPledgedFlagFunc              func(StateDB, common.Address) bool
 	//GetNFTPledgedBlockNumberFunc    func(StateDB, common.Address) *big.Int
 	RecoverValidatorCoefficientFunc           func(StateDB, common.Address) error
-	BatchForcedSaleSNFTByApproveExchangerFunc func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int) error
+	BatchForcedSaleSNFTByApproveExchangerFunc func(StateDB, *big.Int, common.Address, common.Address, *types.Wormholes, *big.Int, bool) error
+	UpdateNFTMetaURLFunc                      func(StateDB, common.Address, string, *big.Int)
+	FreezeNFTMetaURLFunc                      func(StateDB, common.Address, *big.Int)
+	UpdateExchangerFunc                       func(StateDB, common.Address, *types.ExchangerUpdate, *big.Int, uint64)
+	SetCollectionFeeRateFunc                  func(StateDB, common.Address, common.Address, uint16)
+	AddAllowedCreatorFunc                     func(StateDB, common.Address, common.Address) bool
+	RemoveAllowedCreatorFunc                  func(StateDB, common.Address, common.Address) bool
+	FractionalizeSNFTFunc                     func(StateDB, common.Address, common.Address)
+	RedeemSNFTFunc                            func(StateDB, common.Address, common.Address, *big.Int) bool
+	TransferSNFTSharesFunc                    func(StateDB, common.Address, common.Address, common.Address, *big.Int) bool
+	RegisterBLSPubKeyFunc                     func(StateDB, common.Address, []byte, []byte) error
 )
 
 func (evm *EVM) precompile(addr common.Address) (PrecompiledContract, bool) {
@@ -207,6 +222,8 @@ type BlockContext struct {
 	VerifyExchangerBalance             VerifyExchangerBalanceFunc
 	GetNftAddressAndLevel              GetNftAddressAndLevelFunc
 	VoteOfficialNFT                    VoteOfficialNFTFunc
+	ProposeOfficialNFT                 ProposeOfficialNFTFunc
+	VoteOfficialNFTProposal            VoteOfficialNFTProposalFunc
 	ElectNominatedOfficialNFT          ElectNominatedOfficialNFTFunc
 	NextIndex                          NextIndexFunc
 	VoteOfficialNFTByApprovedExchanger VoteOfficialNFTByApprovedExchangerFunc
@@ -218,6 +235,16 @@ type BlockContext struct {
 	//GetNFTPledgedBlockNumber    GetNFTPledgedBlockNumberFunc
 	RecoverValidatorCoefficient           RecoverValidatorCoefficientFunc
 	BatchForcedSaleSNFTByApproveExchanger BatchForcedSaleSNFTByApproveExchangerFunc
+	UpdateNFTMetaURL                      UpdateNFTMetaURLFunc
+	FreezeNFTMetaURL                      FreezeNFTMetaURLFunc
+	UpdateExchanger                       UpdateExchangerFunc
+	SetCollectionFeeRate                  SetCollectionFeeRateFunc
+	AddAllowedCreator                     AddAllowedCreatorFunc
+	RemoveAllowedCreator                  RemoveAllowedCreatorFunc
+	FractionalizeSNFT                     FractionalizeSNFTFunc
+	RedeemSNFT                            RedeemSNFTFunc
+	TransferSNFTShares                    TransferSNFTSharesFunc
+	RegisterBLSPubKey                     RegisterBLSPubKeyFunc
 	// Block information
 
 	ParentHeader *types.Header
@@ -611,7 +638,15 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	log.Info("EVM.Call()", "nftTransaction", nftTransaction)
 	if nftTransaction {
 		log.Info("EVM.Call()", "nftTransaction", nftTransaction, "wormholes.Type", wormholes.Type)
-		ret, gas, err = evm.HandleNFT(caller, addr, wormholes, gas, value)
+		// Capture the tracer start/end events before handling the nft
+		// operation, so failed operations are traced too
+		if evm.Config.Debug && evm.depth == 0 {
+			evm.Config.Tracer.CaptureStart(evm, caller.Address(), addr, false, input, gas, value)
+			defer func(startGas uint64, startTime time.Time) { // Lazy evaluation of the parameters
+				evm.Config.Tracer.CaptureEnd(ret, startGas-gas, time.Since(startTime), err)
+			}(gas, time.Now())
+		}
+		ret, gas, err = evm.handleNFT(caller, addr, wormholes, gas, value)
 		if err != nil {
 			return ret, gas, err
 		}
@@ -621,7 +656,7 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	// *** modify to support nft transaction 20211215 end ***
 
 	// Capture the tracer start/end events in debug mode
-	if evm.Config.Debug && evm.depth == 0 {
+	if !nftTransaction && evm.Config.Debug && evm.depth == 0 {
 		evm.Config.Tracer.CaptureStart(evm, caller.Address(), addr, false, input, gas, value)
 		defer func(startGas uint64, startTime time.Time) { // Lazy evaluation of the parameters
 			evm.Config.Tracer.CaptureEnd(ret, startGas-gas, time.Since(startTime), err)
@@ -908,6 +943,20 @@ func (evm *EVM) Create2(caller ContractRef, code []byte, gas uint64, endowment *
 // ChainConfig returns the environment's chain configuration
 func (evm *EVM) ChainConfig() *params.ChainConfig { return evm.chainConfig }
 
+// IsWormholesTypeForked returns whether the wormholes transaction type exists
+// at num, the types added by a fork are unknown before it.
+func IsWormholesTypeForked(config *params.ChainConfig, typ uint8, num *big.Int) bool {
+	switch typ {
+	case 32, 33:
+		return config.IsNFTMetaURL(num)
+	case 34, 35, 36, 37:
+		return config.IsExchangerSettings(num)
+	case 38, 39, 40:
+		return config.IsSNFTShares(num)
+	}
+	return true
+}
+
 func (evm *EVM) HandleNFT(
 	caller ContractRef,
 	addr common.Address,
@@ -915,6 +964,10 @@ func (evm *EVM) HandleNFT(
 	gas uint64,
 	value *big.Int) (ret []byte, leftOverGas uint64, err error) {
 
+	if !IsWormholesTypeForked(evm.chainConfig, wormholes.Type, evm.Context.BlockNumber) {
+		log.Error("HandleNFT() format error", "wormholes.Type", wormholes.Type, "error", ErrNotExistNFTType, "blocknumber", evm.Context.BlockNumber.Uint64())
+		return nil, gas, ErrNotExistNFTType
+	}
 	formatErr := wormholes.CheckFormat()
 	if formatErr != nil {
 		log.Error("HandleNFT() format error", "wormholes.Type", wormholes.Type, "error", formatErr, "blocknumber", evm.Context.BlockNumber.Uint64())
@@ -1400,7 +1453,8 @@ func (evm *EVM) HandleNFT(
 			caller.Address(),
 			addr,
 			&wormholes,
-			value)
+			value,
+			evm.chainConfig.IsExchangerSettings(evm.Context.BlockNumber))
 		log.Info("HandleNFT(), BuyNFTBySellerOrExchanger<<<<<<<<<<", "wormholes.Type", wormholes.Type,
 			"blocknumber", evm.Context.BlockNumber.Uint64())
 		if err != nil {
@@ -1420,7 +1474,8 @@ func (evm *EVM) HandleNFT(
 			caller.Address(),
 			addr,
 			&wormholes,
-			value)
+			value,
+			evm.chainConfig.IsExchangerSettings(evm.Context.BlockNumber))
 		log.Info("HandleNFT(), BuyNFTByBuyer<<<<<<<<<<", "wormholes.Type", wormholes.Type,
 			"blocknumber", evm.Context.BlockNumber.Uint64())
 		if err != nil {
@@ -1440,7 +1495,8 @@ func (evm *EVM) HandleNFT(
 			caller.Address(),
 			addr,
 			&wormholes,
-			value)
+			value,
+			evm.chainConfig.IsExchangerSettings(evm.Context.BlockNumber))
 		log.Info("HandleNFT(), BuyAndMintNFTByBuyer<<<<<<<<<<", "wormholes.Type", wormholes.Type,
 			"blocknumber", evm.Context.BlockNumber.Uint64())
 		if err != nil {
@@ -1460,7 +1516,8 @@ func (evm *EVM) HandleNFT(
 			caller.Address(),
 			addr,
 			&wormholes,
-			value)
+			value,
+			evm.chainConfig.IsExchangerSettings(evm.Context.BlockNumber))
 		if err != nil {
 			log.Error("HandleNFT(), BuyAndMintNFTByExchanger", "wormholes.Type", wormholes.Type,
 				"error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
@@ -1480,7 +1537,8 @@ func (evm *EVM) HandleNFT(
 			caller.Address(),
 			addr,
 			&wormholes,
-			value)
+			value,
+			evm.chainConfig.IsExchangerSettings(evm.Context.BlockNumber))
 		if err != nil {
 			log.Error("HandleNFT(), BuyNFTByApproveExchanger", "wormholes.Type", wormholes.Type,
 				"error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
@@ -1500,7 +1558,8 @@ func (evm *EVM) HandleNFT(
 			caller.Address(),
 			addr,
 			&wormholes,
-			value)
+			value,
+			evm.chainConfig.IsExchangerSettings(evm.Context.BlockNumber))
 		if err != nil {
 			log.Error("HandleNFT(), BuyAndMintNFTByApprovedExchanger", "wormholes.Type", wormholes.Type,
 				"error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
@@ -1520,7 +1579,8 @@ func (evm *EVM) HandleNFT(
 			caller.Address(),
 			addr,
 			&wormholes,
-			value)
+			value,
+			evm.chainConfig.IsExchangerSettings(evm.Context.BlockNumber))
 		if err != nil {
 			log.Error("HandleNFT(), BuyNFTByExchanger", "wormholes.Type", wormholes.Type,
 				"error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
@@ -1596,6 +1656,18 @@ func (evm *EVM) HandleNFT(
 	case 23:
 		log.Info("HandleNFT(), VoteOfficialNFT>>>>>>>>>>", "wormholes.Type", wormholes.Type,
 			"blocknumber", evm.Context.BlockNumber.Uint64())
+		proposalVoting := evm.chainConfig.IsOfficialNFTProposal(evm.Context.BlockNumber)
+		if proposalVoting && wormholes.ProposalID != 0 {
+			err := evm.Context.VoteOfficialNFTProposal(evm.StateDB, caller.Address(), wormholes.ProposalID, evm.Context.BlockNumber)
+			if err != nil {
+				log.Error("HandleNFT(), VoteOfficialNFT", "wormholes.Type", wormholes.Type,
+					"proposal", wormholes.ProposalID, "error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
+				return nil, gas, err
+			}
+			log.Info("HandleNFT(), VoteOfficialNFT<<<<<<<<<<", "wormholes.Type", wormholes.Type,
+				"proposal", wormholes.ProposalID, "blocknumber", evm.Context.BlockNumber.Uint64())
+			break
+		}
 		//if !strings.HasPrefix(wormholes.StartIndex, "0x") &&
 		//	!strings.HasPrefix(wormholes.StartIndex, "0X") {
 		//	return nil, gas, ErrStartIndex
@@ -1634,7 +1706,12 @@ func (evm *EVM) HandleNFT(
 				Address: caller.Address(),
 			},
 		}
-		err := evm.Context.VoteOfficialNFT(evm.StateDB, &nominatedNFT, evm.Context.BlockNumber)
+		var err error
+		if proposalVoting {
+			err = evm.Context.ProposeOfficialNFT(evm.StateDB, &nominatedNFT, evm.Context.BlockNumber)
+		} else {
+			err = evm.Context.VoteOfficialNFT(evm.StateDB, &nominatedNFT, evm.Context.BlockNumber)
+		}
 		if err != nil {
 			log.Error("HandleNFT(), VoteOfficialNFT", "wormholes.Type", wormholes.Type,
 				"error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
@@ -1664,7 +1741,8 @@ func (evm *EVM) HandleNFT(
 			caller.Address(),
 			addr,
 			&wormholes,
-			value)
+			value,
+			evm.chainConfig.IsOfficialNFTProposal(evm.Context.BlockNumber))
 		if err != nil {
 			log.Error("HandleNFT(), VoteOfficialNFTByApprovedExchanger", "wormholes.Type", wormholes.Type,
 				"error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
@@ -1703,7 +1781,8 @@ func (evm *EVM) HandleNFT(
 			caller.Address(),
 			addr,
 			&wormholes,
-			value)
+			value,
+			evm.chainConfig.IsExchangerSettings(evm.Context.BlockNumber))
 		if err != nil {
 			log.Error("HandleNFT(), BatchBuyNFTByApproveExchanger", "wormholes.Type", wormholes.Type,
 				"error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
@@ -1720,7 +1799,8 @@ func (evm *EVM) HandleNFT(
 			caller.Address(),
 			addr,
 			&wormholes,
-			value)
+			value,
+			evm.chainConfig.IsExchangerSettings(evm.Context.BlockNumber))
 		if err != nil {
 			log.Error("HandleNFT(), BatchForcedSaleSNFTByApproveExchanger", "wormholes.Type", wormholes.Type,
 				"error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
@@ -1728,6 +1808,196 @@ func (evm *EVM) HandleNFT(
 		}
 		log.Info("HandleNFT(), BatchForcedSaleSNFTByApproveExchanger<<<<<<<<<<", "wormholes.Type", wormholes.Type,
 			"blocknumber", evm.Context.BlockNumber.Uint64())
+	case 32:
+		log.Info("HandleNFT(), UpdateNFTMetaURL>>>>>>>>>>", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+		nftAddress, err := evm.checkNFTMetaURLUpdatable(caller.Address(), wormholes)
+		if err != nil {
+			log.Error("HandleNFT(), UpdateNFTMetaURL", "wormholes.Type", wormholes.Type,
+				"nft address", wormholes.NFTAddress, "error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, err
+		}
+		evm.Context.UpdateNFTMetaURL(evm.StateDB, nftAddress, wormholes.MetaURL, evm.Context.BlockNumber)
+		log.Info("HandleNFT(), UpdateNFTMetaURL<<<<<<<<<<", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+	case 33:
+		log.Info("HandleNFT(), FreezeNFTMetaURL>>>>>>>>>>", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+		nftAddress, err := evm.checkNFTMetaURLUpdatable(caller.Address(), wormholes)
+		if err != nil {
+			log.Error("HandleNFT(), FreezeNFTMetaURL", "wormholes.Type", wormholes.Type,
+				"nft address", wormholes.NFTAddress, "error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, err
+		}
+		evm.Context.FreezeNFTMetaURL(evm.StateDB, nftAddress, evm.Context.BlockNumber)
+		log.Info("HandleNFT(), FreezeNFTMetaURL<<<<<<<<<<", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+	case 34: //update exchanger
+		log.Info("HandleNFT(), UpdateExchanger>>>>>>>>>>", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+		if !evm.Context.GetExchangerFlag(evm.StateDB, caller.Address()) {
+			log.Error("HandleNFT(), UpdateExchanger", "wormholes.Type", wormholes.Type,
+				"error", ErrNotExchanger, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrNotExchanger
+		}
+		if wormholes.FeeRate >= 10000 {
+			log.Error("HandleNFT(), UpdateExchanger", "wormholes.Type", wormholes.Type,
+				"error", ErrFeeRateNotLessThan10000, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrFeeRateNotLessThan10000
+		}
+		evm.Context.UpdateExchanger(
+			evm.StateDB,
+			caller.Address(),
+			wormholes.ExchangerUpdate(),
+			evm.Context.BlockNumber,
+			ExchangerFeeRateNoticeInterval)
+		log.Info("HandleNFT(), UpdateExchanger<<<<<<<<<<", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+	case 35: //set collection fee rate
+		log.Info("HandleNFT(), SetCollectionFeeRate>>>>>>>>>>", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+		if !evm.Context.GetExchangerFlag(evm.StateDB, caller.Address()) {
+			log.Error("HandleNFT(), SetCollectionFeeRate", "wormholes.Type", wormholes.Type,
+				"error", ErrNotExchanger, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrNotExchanger
+		}
+		if wormholes.FeeRate >= 10000 {
+			log.Error("HandleNFT(), SetCollectionFeeRate", "wormholes.Type", wormholes.Type,
+				"error", ErrFeeRateNotLessThan10000, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrFeeRateNotLessThan10000
+		}
+		creator := common.HexToAddress(wormholes.Creator)
+		settings := evm.StateDB.GetExchangerSettings(caller.Address())
+		if _, ok := settings.CollectionFeeRate(creator); !ok &&
+			wormholes.FeeRate != 0 &&
+			len(settings.CollectionFeeRates) >= MaxExchangerCollectionFeeRates {
+			log.Error("HandleNFT(), SetCollectionFeeRate", "wormholes.Type", wormholes.Type,
+				"error", ErrTooManyCollectionFeeRates, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrTooManyCollectionFeeRates
+		}
+		evm.Context.SetCollectionFeeRate(evm.StateDB, caller.Address(), creator, wormholes.FeeRate)
+		log.Info("HandleNFT(), SetCollectionFeeRate<<<<<<<<<<", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+	case 36: //add allowed creator
+		log.Info("HandleNFT(), AddAllowedCreator>>>>>>>>>>", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+		if !evm.Context.GetExchangerFlag(evm.StateDB, caller.Address()) {
+			log.Error("HandleNFT(), AddAllowedCreator", "wormholes.Type", wormholes.Type,
+				"error", ErrNotExchanger, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrNotExchanger
+		}
+		settings := evm.StateDB.GetExchangerSettings(caller.Address())
+		if len(settings.AllowedCreators) >= MaxExchangerAllowedCreators {
+			log.Error("HandleNFT(), AddAllowedCreator", "wormholes.Type", wormholes.Type,
+				"error", ErrTooManyAllowedCreators, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrTooManyAllowedCreators
+		}
+		if !evm.Context.AddAllowedCreator(evm.StateDB, caller.Address(), common.HexToAddress(wormholes.Creator)) {
+			log.Error("HandleNFT(), AddAllowedCreator", "wormholes.Type", wormholes.Type,
+				"error", ErrCreatorAlreadyAllowed, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrCreatorAlreadyAllowed
+		}
+		log.Info("HandleNFT(), AddAllowedCreator<<<<<<<<<<", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+	case 37: //remove allowed creator
+		log.Info("HandleNFT(), RemoveAllowedCreator>>>>>>>>>>", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+		if !evm.Context.GetExchangerFlag(evm.StateDB, caller.Address()) {
+			log.Error("HandleNFT(), RemoveAllowedCreator", "wormholes.Type", wormholes.Type,
+				"error", ErrNotExchanger, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrNotExchanger
+		}
+		if !evm.Context.RemoveAllowedCreator(evm.StateDB, caller.Address(), common.HexToAddress(wormholes.Creator)) {
+			log.Error("HandleNFT(), RemoveAllowedCreator", "wormholes.Type", wormholes.Type,
+				"error", ErrCreatorNotAllowed, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrCreatorNotAllowed
+		}
+		log.Info("HandleNFT(), RemoveAllowedCreator<<<<<<<<<<", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+	case 38: //fractionalize merged snft
+		log.Info("HandleNFT(), FractionalizeSNFT>>>>>>>>>>", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+		nftAddress, level1, err := evm.Context.GetNftAddressAndLevel(wormholes.NFTAddress)
+		if err != nil {
+			return nil, gas, err
+		}
+		if !IsOfficialNFT(nftAddress) {
+			log.Error("HandleNFT(), FractionalizeSNFT", "wormholes.Type", wormholes.Type,
+				"nft address", wormholes.NFTAddress, "error", ErrNotMintByOfficial, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrNotMintByOfficial
+		}
+		nftOwner := evm.StateDB.GetNFTOwner16(nftAddress)
+		if nftOwner != caller.Address() {
+			log.Error("HandleNFT(), FractionalizeSNFT", "wormholes.Type", wormholes.Type, "nft address", wormholes.NFTAddress,
+				"nft owner", nftOwner, "error", ErrNotOwner, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrNotOwner
+		}
+		level2 := evm.StateDB.GetNFTMergeLevel(nftAddress)
+		if int(level2) != level1 {
+			log.Error("HandleNFT(), FractionalizeSNFT", "wormholes.Type", wormholes.Type, "nft address", wormholes.NFTAddress,
+				"input nft level", level1, "real nft level", level2, "error", ErrNotExistNft, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrNotExistNft
+		}
+		if level2 == 0 {
+			log.Error("HandleNFT(), FractionalizeSNFT", "wormholes.Type", wormholes.Type, "nft address", wormholes.NFTAddress,
+				"error", ErrNotMergedSNFT, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrNotMergedSNFT
+		}
+		evm.Context.FractionalizeSNFT(evm.StateDB, caller.Address(), nftAddress)
+		log.Info("HandleNFT(), FractionalizeSNFT<<<<<<<<<<", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+	case 39: //redeem fractionalized snft
+		log.Info("HandleNFT(), RedeemSNFT>>>>>>>>>>", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+		nftAddress, _, err := evm.Context.GetNftAddressAndLevel(wormholes.NFTAddress)
+		if err != nil {
+			return nil, gas, err
+		}
+		if evm.StateDB.GetSNFTShareSupply(nftAddress).Sign() == 0 {
+			log.Error("HandleNFT(), RedeemSNFT", "wormholes.Type", wormholes.Type, "nft address", wormholes.NFTAddress,
+				"error", ErrNotFractionalized, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrNotFractionalized
+		}
+		if !evm.Context.RedeemSNFT(evm.StateDB, caller.Address(), nftAddress, evm.Context.BlockNumber) {
+			log.Error("HandleNFT(), RedeemSNFT", "wormholes.Type", wormholes.Type, "nft address", wormholes.NFTAddress,
+				"error", ErrInsufficientShares, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrInsufficientShares
+		}
+		log.Info("HandleNFT(), RedeemSNFT<<<<<<<<<<", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+	case 40: //transfer snft shares
+		log.Info("HandleNFT(), TransferSNFTShares>>>>>>>>>>", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+		nftAddress, _, err := evm.Context.GetNftAddressAndLevel(wormholes.NFTAddress)
+		if err != nil {
+			return nil, gas, err
+		}
+		if evm.StateDB.GetSNFTShareSupply(nftAddress).Sign() == 0 {
+			log.Error("HandleNFT(), TransferSNFTShares", "wormholes.Type", wormholes.Type, "nft address", wormholes.NFTAddress,
+				"error", ErrNotFractionalized, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrNotFractionalized
+		}
+		amount, _ := new(big.Int).SetString(wormholes.Amount[2:], 16)
+		if !evm.Context.TransferSNFTShares(evm.StateDB, caller.Address(), addr, nftAddress, amount) {
+			log.Error("HandleNFT(), TransferSNFTShares", "wormholes.Type", wormholes.Type, "nft address", wormholes.NFTAddress,
+				"error", ErrInsufficientShares, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, ErrInsufficientShares
+		}
+		log.Info("HandleNFT(), TransferSNFTShares<<<<<<<<<<", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+	case 41: //register bls public key
+		log.Info("HandleNFT(), RegisterBLSPubKey>>>>>>>>>>", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
+		pubKey, _ := hexutil.Decode(wormholes.BLSPubKey)
+		proof, _ := hexutil.Decode(wormholes.BLSProof)
+		err := evm.Context.RegisterBLSPubKey(evm.StateDB, caller.Address(), pubKey, proof)
+		if err != nil {
+			log.Error("HandleNFT(), RegisterBLSPubKey", "wormholes.Type", wormholes.Type,
+				"error", err, "blocknumber", evm.Context.BlockNumber.Uint64())
+			return nil, gas, err
+		}
+		log.Info("HandleNFT(), RegisterBLSPubKey<<<<<<<<<<", "wormholes.Type", wormholes.Type,
+			"blocknumber", evm.Context.BlockNumber.Uint64())
 	default:
 		log.Error("HandleNFT()", "wormholes.Type", wormholes.Type, "error", ErrNotExistNFTType,
 			"blocknumber", evm.Context.BlockNumber.Uint64())
@@ -1737,6 +2007,29 @@ func (evm *EVM) HandleNFT(
 	return nil, gas, nil
 }
 
+// checkNFTMetaURLUpdatable checks that the metaurl of the nft can still be
+// changed by caller: only the creator of a user minted nft may update or
+// freeze its metadata, and only until it has been frozen.
+func (evm *EVM) checkNFTMetaURLUpdatable(caller common.Address, wormholes types.Wormholes) (common.Address, error) {
+	nftAddress, level, err := evm.Context.GetNftAddressAndLevel(wormholes.NFTAddress)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if level != 0 || !evm.Context.IsExistNFT(evm.StateDB, nftAddress) {
+		return common.Address{}, ErrNotExistNft
+	}
+	if IsOfficialNFT(nftAddress) {
+		return common.Address{}, ErrNotAllowedUpdateOfficialNFT
+	}
+	if evm.Context.GetNFTCreator(evm.StateDB, nftAddress) != caller {
+		return common.Address{}, ErrNotCreator
+	}
+	if evm.StateDB.GetNFTMetaURLFrozen(nftAddress) {
+		return common.Address{}, ErrMetaURLFrozen
+	}
+	return nftAddress, nil
+}
+
 // IsOfficialNFT return true if nft address is created by official
 func IsOfficialNFT(nftAddress common.Address) bool {
 	maskByte := byte(128)
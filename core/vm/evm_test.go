@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
 )
 
 func TestUnstakingHeight(t *testing.T) {
@@ -80,3 +82,38 @@ func TestBigFloat(t *testing.T) {
 	a3 := uint64(15)
 	fmt.Println(a1 + a2 - a3)
 }
+
+func TestIsWormholesTypeForked(t *testing.T) {
+	config := &params.ChainConfig{Istanbul: &params.IstanbulConfig{
+		NFTMetaURLBlock:        big.NewInt(10),
+		ExchangerSettingsBlock: big.NewInt(20),
+		SNFTSharesBlock:        big.NewInt(30),
+	}}
+	tests := []struct {
+		typ    uint8
+		number int64
+		want   bool
+	}{
+		{0, 0, true},
+		{32, 9, false},
+		{32, 10, true},
+		{33, 9, false},
+		{33, 10, true},
+		{34, 19, false},
+		{34, 20, true},
+		{37, 19, false},
+		{37, 20, true},
+		{38, 29, false},
+		{38, 30, true},
+		{40, 29, false},
+		{40, 30, true},
+	}
+	for _, tt := range tests {
+		if have := IsWormholesTypeForked(config, tt.typ, big.NewInt(tt.number)); have != tt.want {
+			t.Errorf("type %d at %d: forked mismatch: have %v, want %v", tt.typ, tt.number, have, tt.want)
+		}
+	}
+	if IsWormholesTypeForked(&params.ChainConfig{}, 32, big.NewInt(10)) {
+		t.Error("type 32 forked without an istanbul config")
+	}
+}
@@ -98,6 +98,13 @@ type StateDB interface {
 	GetFeeRate(common.Address) uint16
 	GetExchangerName(common.Address) string
 	GetExchangerURL(common.Address) string
+	GetExchangerFeeRate(common.Address, common.Address, *big.Int) uint16
+	IsAllowedCreator(common.Address, common.Address) bool
+	GetExchangerSettings(common.Address) *types.ExchangerSettings
+	UpdateExchanger(common.Address, *types.ExchangerUpdate, *big.Int, uint64)
+	SetCollectionFeeRate(common.Address, common.Address, uint16)
+	AddAllowedCreator(common.Address, common.Address) bool
+	RemoveAllowedCreator(common.Address, common.Address) bool
 	GetApproveAddress(common.Address) []common.Address
 	//GetNFTBalance(common.Address) uint64
 	GetNFTName(common.Address) string
@@ -105,10 +112,19 @@ type StateDB interface {
 	//GetNFTApproveAddress(common.Address) []common.Address
 	GetNFTApproveAddress(common.Address) common.Address
 	GetNFTMergeLevel(common.Address) uint8
+	GetSNFTShareSupply(common.Address) *big.Int
+	GetSNFTShares(common.Address, common.Address) *big.Int
+	FractionalizeSNFT(common.Address, common.Address)
+	RedeemSNFT(common.Address, common.Address, *big.Int) bool
+	TransferSNFTShares(common.Address, common.Address, common.Address, *big.Int) bool
+	RegisterBLSPubKey(common.Address, []byte) error
 	GetNFTCreator(common.Address) common.Address
 	GetNFTRoyalty(common.Address) uint16
 	GetNFTExchanger(common.Address) common.Address
 	GetNFTMetaURL(common.Address) string
+	GetNFTMetaURLFrozen(common.Address) bool
+	UpdateNFTMetaURL(common.Address, string, *big.Int)
+	FreezeNFTMetaURL(common.Address, *big.Int)
 	IsExistNFT(common.Address) bool
 	IsApproved(common.Address, common.Address) bool
 	IsApprovedOne(common.Address, common.Address) bool
@@ -119,8 +135,11 @@ type StateDB interface {
 	ModifyOpenExchangerTime(common.Address, *big.Int)
 	SubExchangerToken(common.Address, *big.Int)
 	SubExchangerBalance(common.Address, *big.Int)
+	AddExchangerVolume(common.Address, *big.Int)
 	GetExchangerBalance(common.Address) *big.Int
 	VoteOfficialNFT(*types.NominatedOfficialNFT, *big.Int) error
+	ProposeOfficialNFT(*types.NominatedOfficialNFT, *big.Int) error
+	VoteOfficialNFTProposal(common.Address, uint64, *big.Int) error
 	ElectNominatedOfficialNFT(*big.Int)
 	SubVoteWeight(common.Address, *big.Int)
 	AddVoteWeight(common.Address, *big.Int)
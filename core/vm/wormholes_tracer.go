@@ -0,0 +1,269 @@
+package vm
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// WormholesTracer is implemented by tracers that also follow the native
+// wormholes operations, which move balances and nfts without running any
+// evm code. Every operation is reported as an enter/exit frame.
+type WormholesTracer interface {
+	CaptureWormholesEnter(env *EVM, depth int, typ uint8, from common.Address, to common.Address, value *big.Int)
+	CaptureWormholesExit(balances []WormholesBalanceChange, nfts []WormholesNFTChange, err error)
+}
+
+// WormholesBalanceChange is the balance, pledged balance and exchanger
+// balance of an account before and after a wormholes operation. Roles are
+// the parts the account plays in it, any of "sender", "recipient", "owner",
+// "buyer", "creator", "exchanger" and "injectReward".
+type WormholesBalanceChange struct {
+	Address common.Address
+	Roles   []string
+
+	Before, After                   *big.Int
+	PledgedBefore, PledgedAfter     *big.Int
+	ExchangerBefore, ExchangerAfter *big.Int
+}
+
+// wormholesBalances are the balances of an account a wormholes operation moves.
+type wormholesBalances struct {
+	balance, pledged, exchanger *big.Int
+}
+
+// readWormholesBalances reads the balances of an account, without creating
+// it if it doesn't exist.
+func readWormholesBalances(db StateDB, addr common.Address) wormholesBalances {
+	if !db.Exist(addr) {
+		return wormholesBalances{new(big.Int), new(big.Int), new(big.Int)}
+	}
+	return wormholesBalances{
+		balance:   new(big.Int).Set(db.GetBalance(addr)),
+		pledged:   new(big.Int).Set(db.GetPledgedBalance(addr)),
+		exchanger: new(big.Int).Set(db.GetExchangerBalance(addr)),
+	}
+}
+
+// WormholesNFTChange is an nft changing owner in a wormholes operation,
+// From is empty for minted nfts and To for nfts that no longer exist.
+type WormholesNFTChange struct {
+	NFTAddress common.Address
+	From       common.Address
+	To         common.Address
+}
+
+// wormholesRecorder wraps the StateDB during a traced wormholes operation
+// and remembers the balances and nft owners before they are first changed.
+type wormholesRecorder struct {
+	StateDB
+
+	from, to common.Address
+
+	accounts []common.Address
+	balances map[common.Address]wormholesBalances
+
+	nfts   []common.Address
+	owners map[common.Address]common.Address
+}
+
+func newWormholesRecorder(db StateDB, from, to common.Address, wormholes *types.Wormholes) *wormholesRecorder {
+	r := &wormholesRecorder{
+		StateDB:  db,
+		from:     from,
+		to:       to,
+		balances: make(map[common.Address]wormholesBalances),
+		owners:   make(map[common.Address]common.Address),
+	}
+	r.touchAccount(from)
+	r.touchAccount(to)
+	for _, s := range []string{wormholes.NFTAddress, wormholes.Buyer.NFTAddress, wormholes.Seller1.NFTAddress} {
+		if common.IsHexAddress(s) {
+			r.touchNFT(common.HexToAddress(s))
+		}
+	}
+	return r
+}
+
+func (r *wormholesRecorder) touchAccount(addr common.Address) {
+	if _, ok := r.balances[addr]; !ok {
+		r.accounts = append(r.accounts, addr)
+		r.balances[addr] = readWormholesBalances(r.StateDB, addr)
+	}
+}
+
+func (r *wormholesRecorder) touchNFT(nft common.Address) {
+	if _, ok := r.owners[nft]; !ok {
+		r.nfts = append(r.nfts, nft)
+		r.owners[nft] = r.StateDB.GetNFTOwner16(nft)
+	}
+}
+
+func (r *wormholesRecorder) AddBalance(addr common.Address, amount *big.Int) {
+	r.touchAccount(addr)
+	r.StateDB.AddBalance(addr, amount)
+}
+
+func (r *wormholesRecorder) SubBalance(addr common.Address, amount *big.Int) {
+	r.touchAccount(addr)
+	r.StateDB.SubBalance(addr, amount)
+}
+
+func (r *wormholesRecorder) ChangeNFTOwner(nft common.Address, owner common.Address, level int, blocknumber *big.Int) {
+	r.touchNFT(nft)
+	r.touchAccount(owner)
+	r.StateDB.ChangeNFTOwner(nft, owner, level, blocknumber)
+}
+
+func (r *wormholesRecorder) CreateNFTByUser(exchanger common.Address, owner common.Address, royalty uint16, metaurl string) (common.Address, bool) {
+	nft, ok := r.StateDB.CreateNFTByUser(exchanger, owner, royalty, metaurl)
+	if ok {
+		if _, seen := r.owners[nft]; !seen {
+			r.nfts = append(r.nfts, nft)
+			r.owners[nft] = common.Address{}
+		}
+	}
+	return nft, ok
+}
+
+func (r *wormholesRecorder) ExchangeNFTToCurrency(owner common.Address, nft common.Address, blocknumber *big.Int, level int) {
+	r.touchAccount(owner)
+	r.touchNFT(nft)
+	r.StateDB.ExchangeNFTToCurrency(owner, nft, blocknumber, level)
+}
+
+func (r *wormholesRecorder) FractionalizeSNFT(owner common.Address, nft common.Address) {
+	r.touchNFT(nft)
+	r.StateDB.FractionalizeSNFT(owner, nft)
+}
+
+func (r *wormholesRecorder) RedeemSNFT(holder common.Address, nft common.Address, blocknumber *big.Int) bool {
+	r.touchNFT(nft)
+	return r.StateDB.RedeemSNFT(holder, nft, blocknumber)
+}
+
+func (r *wormholesRecorder) PledgeToken(addr common.Address, amount *big.Int, proxy common.Address, blocknumber *big.Int) error {
+	r.touchAccount(addr)
+	return r.StateDB.PledgeToken(addr, amount, proxy, blocknumber)
+}
+
+func (r *wormholesRecorder) CancelPledgedToken(addr common.Address, amount *big.Int) {
+	r.touchAccount(addr)
+	r.StateDB.CancelPledgedToken(addr, amount)
+}
+
+func (r *wormholesRecorder) OpenExchanger(addr common.Address, amount *big.Int, blocknumber *big.Int, feerate uint16, name string, url string) {
+	r.touchAccount(addr)
+	r.StateDB.OpenExchanger(addr, amount, blocknumber, feerate, name, url)
+}
+
+func (r *wormholesRecorder) CloseExchanger(addr common.Address, blocknumber *big.Int) {
+	r.touchAccount(addr)
+	r.StateDB.CloseExchanger(addr, blocknumber)
+}
+
+func (r *wormholesRecorder) AddExchangerToken(addr common.Address, amount *big.Int) {
+	r.touchAccount(addr)
+	r.StateDB.AddExchangerToken(addr, amount)
+}
+
+func (r *wormholesRecorder) SubExchangerToken(addr common.Address, amount *big.Int) {
+	r.touchAccount(addr)
+	r.StateDB.SubExchangerToken(addr, amount)
+}
+
+func (r *wormholesRecorder) SubExchangerBalance(addr common.Address, amount *big.Int) {
+	r.touchAccount(addr)
+	r.StateDB.SubExchangerBalance(addr, amount)
+}
+
+// nftChanges returns the nfts whose owner changed since they were first touched.
+func (r *wormholesRecorder) nftChanges() []WormholesNFTChange {
+	var changes []WormholesNFTChange
+	for _, nft := range r.nfts {
+		from, to := r.owners[nft], r.StateDB.GetNFTOwner16(nft)
+		if from != to {
+			changes = append(changes, WormholesNFTChange{NFTAddress: nft, From: from, To: to})
+		}
+	}
+	return changes
+}
+
+// balanceChanges returns the accounts whose balances changed since they were
+// first touched, with the roles they play in the nft changes.
+func (r *wormholesRecorder) balanceChanges(nfts []WormholesNFTChange) []WormholesBalanceChange {
+	var (
+		owners   = make(map[common.Address]bool)
+		buyers   = make(map[common.Address]bool)
+		creators = make(map[common.Address]bool)
+	)
+	for _, change := range nfts {
+		if change.From != (common.Address{}) && change.To != (common.Address{}) {
+			owners[change.From], buyers[change.To] = true, true
+		}
+	}
+	for _, nft := range r.nfts {
+		if !r.StateDB.Exist(nft) {
+			continue
+		}
+		if creator := r.StateDB.GetNFTCreator(nft); creator != (common.Address{}) {
+			creators[creator] = true
+		}
+	}
+	var changes []WormholesBalanceChange
+	for _, addr := range r.accounts {
+		before, after := r.balances[addr], readWormholesBalances(r.StateDB, addr)
+		if before.balance.Cmp(after.balance) == 0 && before.pledged.Cmp(after.pledged) == 0 &&
+			before.exchanger.Cmp(after.exchanger) == 0 {
+			continue
+		}
+		var roles []string
+		for _, role := range []struct {
+			name string
+			ok   bool
+		}{
+			{"sender", addr == r.from},
+			{"recipient", addr == r.to},
+			{"owner", owners[addr]},
+			{"buyer", buyers[addr]},
+			{"creator", creators[addr]},
+			{"exchanger", r.StateDB.Exist(addr) && r.StateDB.GetExchangerFlag(addr)},
+			{"injectReward", addr == types.InjectRewardAddress},
+		} {
+			if role.ok {
+				roles = append(roles, role.name)
+			}
+		}
+		changes = append(changes, WormholesBalanceChange{
+			Address:         addr,
+			Roles:           roles,
+			Before:          before.balance,
+			After:           after.balance,
+			PledgedBefore:   before.pledged,
+			PledgedAfter:    after.pledged,
+			ExchangerBefore: before.exchanger,
+			ExchangerAfter:  after.exchanger,
+		})
+	}
+	return changes
+}
+
+// handleNFT runs a wormholes operation, reporting it to the tracer if it
+// follows wormholes operations.
+func (evm *EVM) handleNFT(caller ContractRef, addr common.Address, wormholes types.Wormholes, gas uint64, value *big.Int) (ret []byte, leftOverGas uint64, err error) {
+	tracer, ok := evm.Config.Tracer.(WormholesTracer)
+	if !evm.Config.Debug || !ok {
+		return evm.HandleNFT(caller, addr, wormholes, gas, value)
+	}
+	recorder := newWormholesRecorder(evm.StateDB, caller.Address(), addr, &wormholes)
+	tracer.CaptureWormholesEnter(evm, evm.depth, wormholes.Type, caller.Address(), addr, value)
+
+	evm.StateDB = recorder
+	defer func() {
+		evm.StateDB = recorder.StateDB
+		nfts := recorder.nftChanges()
+		tracer.CaptureWormholesExit(recorder.balanceChanges(nfts), nfts, err)
+	}()
+	return evm.HandleNFT(caller, addr, wormholes, gas, value)
+}
@@ -0,0 +1,299 @@
+// Package bls implements the BLS signatures used by the validators to
+// aggregate their seals. Public keys live in G1, signatures in G2, so a set
+// of signatures over the same message is verified with a single pairing check.
+package bls
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// PublicKeyLength is the length of an uncompressed G1 public key
+	PublicKeyLength = 96
+	// SignatureLength is the length of an uncompressed G2 signature
+	SignatureLength = 192
+)
+
+var (
+	// domainSign separates the seal signatures from the proofs of possession
+	domainSign = []byte("WORMHOLES_BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	// domainPop is the domain of the proof of possession of a key
+	domainPop = []byte("WORMHOLES_BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	// keyGenSalt is the initial salt of the key generation
+	keyGenSalt = []byte("BLS-SIG-KEYGEN-SALT-")
+	// keyGenInfo binds the derived bls keys to their use as seal keys
+	keyGenInfo = []byte("wormholes-bls-key")
+
+	// fieldModulus is the modulus of the base field
+	fieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+	// groupOrder is the order of G1 and G2
+	groupOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+)
+
+var (
+	ErrInvalidPublicKey = errors.New("bls: invalid public key")
+	ErrInvalidSignature = errors.New("bls: invalid signature")
+	ErrNoSignatures     = errors.New("bls: no signatures to aggregate")
+	ErrNoPublicKeys     = errors.New("bls: no public keys to aggregate")
+)
+
+// SecretKey is a bls secret key
+type SecretKey struct {
+	k *big.Int
+}
+
+// SecretKeyFromECDSA derives the bls key of a validator from its node key
+// with the KeyGen procedure of the bls signature draft (HKDF-SHA256). The bls
+// key is not the node key reused: it is a one-way derivation bound to its use
+// by keyGenInfo, so neither key can be computed from the other one's
+// signatures or public key. Deriving it rather than generating a separate key
+// spares the operators an extra key file to manage and back up, and costs no
+// security since whoever holds the node key already controls the validator.
+func SecretKeyFromECDSA(priv *ecdsa.PrivateKey) *SecretKey {
+	return keyGen(crypto.FromECDSA(priv), keyGenInfo)
+}
+
+// keyGen derives a secret key from the key material ikm, which must be at
+// least 32 bytes long.
+func keyGen(ikm, info []byte) *SecretKey {
+	var (
+		salt = keyGenSalt
+		k    = new(big.Int)
+		okm  = make([]byte, 48)
+	)
+	for k.Sign() == 0 {
+		h := sha256.Sum256(salt)
+		salt = h[:]
+		prk := hkdf.Extract(sha256.New, append(append([]byte{}, ikm...), 0), salt)
+		r := hkdf.Expand(sha256.New, prk, append(append([]byte{}, info...), 0, byte(len(okm))))
+		if _, err := io.ReadFull(r, okm); err != nil {
+			panic(err)
+		}
+		k.Mod(new(big.Int).SetBytes(okm), groupOrder)
+	}
+	return &SecretKey{k: k}
+}
+
+// PublicKey returns the serialized public key of sk
+func (sk *SecretKey) PublicKey() []byte {
+	g1 := bls12381.NewG1()
+	return g1.ToBytes(g1.MulScalar(g1.New(), g1.One(), sk.k))
+}
+
+// Sign signs msg
+func (sk *SecretKey) Sign(msg []byte) []byte {
+	return sk.sign(msg, domainSign)
+}
+
+// ProvePossession signs the public key of sk, the proof is checked when the
+// key is registered to rule out rogue key attacks on the aggregates.
+func (sk *SecretKey) ProvePossession() []byte {
+	return sk.sign(sk.PublicKey(), domainPop)
+}
+
+func (sk *SecretKey) sign(msg, domain []byte) []byte {
+	g2 := bls12381.NewG2()
+	h, err := hashToG2(msg, domain)
+	if err != nil {
+		return nil
+	}
+	return g2.ToBytes(g2.MulScalar(g2.New(), h, sk.k))
+}
+
+// Verify checks the signature sig of msg against the public key pub
+func Verify(pub, msg, sig []byte) bool {
+	return verify(pub, msg, sig, domainSign)
+}
+
+// VerifyPossession checks the proof of possession of the public key pub
+func VerifyPossession(pub, proof []byte) bool {
+	return verify(pub, pub, proof, domainPop)
+}
+
+func verify(pub, msg, sig, domain []byte) bool {
+	pk, err := decodePublicKey(pub)
+	if err != nil {
+		return false
+	}
+	s, err := decodeSignature(sig)
+	if err != nil {
+		return false
+	}
+	return check(pk, msg, s, domain)
+}
+
+// VerifyAggregate checks the aggregate signature sig of msg against the
+// public keys pubs, which all signed the same message.
+func VerifyAggregate(pubs [][]byte, msg, sig []byte) bool {
+	pub, err := AggregatePublicKeys(pubs)
+	if err != nil {
+		return false
+	}
+	return Verify(pub, msg, sig)
+}
+
+// AggregateSignatures adds up sigs into one signature
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, ErrNoSignatures
+	}
+	g2 := bls12381.NewG2()
+	agg := g2.Zero()
+	for _, sig := range sigs {
+		s, err := decodeSignature(sig)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(agg, agg, s)
+	}
+	return g2.ToBytes(agg), nil
+}
+
+// AggregatePublicKeys adds up pubs into one public key
+func AggregatePublicKeys(pubs [][]byte) ([]byte, error) {
+	if len(pubs) == 0 {
+		return nil, ErrNoPublicKeys
+	}
+	g1 := bls12381.NewG1()
+	agg := g1.Zero()
+	for _, pub := range pubs {
+		pk, err := decodePublicKey(pub)
+		if err != nil {
+			return nil, err
+		}
+		g1.Add(agg, agg, pk)
+	}
+	return g1.ToBytes(agg), nil
+}
+
+func check(pk *bls12381.PointG1, msg []byte, sig *bls12381.PointG2, domain []byte) bool {
+	h, err := hashToG2(msg, domain)
+	if err != nil {
+		return false
+	}
+	engine := bls12381.NewPairingEngine()
+	engine.AddPair(pk, h)
+	engine.AddPairInv(engine.G1.One(), sig)
+	return engine.Check()
+}
+
+// decodePublicKey decodes a public key, rejecting the points outside of the
+// prime order subgroup and the identity.
+func decodePublicKey(pub []byte) (*bls12381.PointG1, error) {
+	if len(pub) != PublicKeyLength {
+		return nil, ErrInvalidPublicKey
+	}
+	g1 := bls12381.NewG1()
+	pk, err := g1.FromBytes(pub)
+	if err != nil || g1.IsZero(pk) || !g1.InCorrectSubgroup(pk) {
+		return nil, ErrInvalidPublicKey
+	}
+	return pk, nil
+}
+
+// decodeSignature decodes a signature, rejecting the points outside of the
+// prime order subgroup.
+func decodeSignature(sig []byte) (*bls12381.PointG2, error) {
+	if len(sig) != SignatureLength {
+		return nil, ErrInvalidSignature
+	}
+	g2 := bls12381.NewG2()
+	s, err := g2.FromBytes(sig)
+	if err != nil || !g2.InCorrectSubgroup(s) {
+		return nil, ErrInvalidSignature
+	}
+	return s, nil
+}
+
+// hashToG2 maps msg to a point of G2 with the hash_to_curve random oracle
+// encoding of RFC 9380: two field elements are derived from the message and
+// the domain, each is mapped to the curve and the results are added up.
+func hashToG2(msg, domain []byte) (*bls12381.PointG2, error) {
+	u, err := hashToFp2(msg, domain)
+	if err != nil {
+		return nil, err
+	}
+	g2 := bls12381.NewG2()
+	p0, err := g2.MapToCurve(u[0])
+	if err != nil {
+		return nil, err
+	}
+	p1, err := g2.MapToCurve(u[1])
+	if err != nil {
+		return nil, err
+	}
+	return g2.Affine(g2.Add(g2.New(), p0, p1)), nil
+}
+
+// hashToFp2 is the hash_to_field function of RFC 9380 for two fp2 elements,
+// which are returned serialized as expected by MapToCurve.
+func hashToFp2(msg, domain []byte) ([2][]byte, error) {
+	const l = 64 // bytes per base field element, ceil((381 + 128) / 8)
+
+	var u [2][]byte
+	uniform, err := expandMessageXMD(msg, domain, 2*2*l)
+	if err != nil {
+		return u, err
+	}
+	for i := range u {
+		u[i] = make([]byte, 96)
+		for j := 0; j < 2; j++ {
+			off := l * (j + 2*i)
+			e := new(big.Int).Mod(new(big.Int).SetBytes(uniform[off:off+l]), fieldModulus)
+			// MapToCurve takes the coefficients highest first
+			e.FillBytes(u[i][48*(1-j) : 48*(2-j)])
+		}
+	}
+	return u, nil
+}
+
+// expandMessageXMD is the expand_message_xmd function of RFC 9380 with SHA-256.
+func expandMessageXMD(msg, domain []byte, n int) ([]byte, error) {
+	const (
+		bInBytes = sha256.Size
+		sInBytes = sha256.BlockSize
+	)
+	ell := (n + bInBytes - 1) / bInBytes
+	if ell > 255 || n > 65535 || len(domain) > 255 {
+		return nil, errors.New("bls: invalid expand_message_xmd parameters")
+	}
+	domainPrime := append(append([]byte{}, domain...), byte(len(domain)))
+
+	h := sha256.New()
+	h.Write(make([]byte, sInBytes))
+	h.Write(msg)
+	h.Write([]byte{byte(n >> 8), byte(n), 0})
+	h.Write(domainPrime)
+	b0 := h.Sum(nil)
+
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(domainPrime)
+	bi := h.Sum(nil)
+
+	out := make([]byte, 0, ell*bInBytes)
+	out = append(out, bi...)
+	for i := 2; i <= ell; i++ {
+		x := make([]byte, bInBytes)
+		for j := range x {
+			x[j] = b0[j] ^ bi[j]
+		}
+		h.Reset()
+		h.Write(x)
+		h.Write([]byte{byte(i)})
+		h.Write(domainPrime)
+		bi = h.Sum(nil)
+		out = append(out, bi...)
+	}
+	return out[:n], nil
+}
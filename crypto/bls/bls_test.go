@@ -0,0 +1,223 @@
+package bls
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+func newTestKeys(t *testing.T, n int) []*SecretKey {
+	keys := make([]*SecretKey, n)
+	for i := range keys {
+		priv, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i] = SecretKeyFromECDSA(priv)
+	}
+	return keys
+}
+
+func TestSignVerify(t *testing.T) {
+	sk := newTestKeys(t, 1)[0]
+	msg := []byte("committed seal")
+	sig := sk.Sign(msg)
+	if len(sig) != SignatureLength {
+		t.Fatalf("signature length mismatch: have %d, want %d", len(sig), SignatureLength)
+	}
+	if !Verify(sk.PublicKey(), msg, sig) {
+		t.Fatal("valid signature rejected")
+	}
+	if Verify(sk.PublicKey(), []byte("other message"), sig) {
+		t.Fatal("signature of another message accepted")
+	}
+	if Verify(newTestKeys(t, 1)[0].PublicKey(), msg, sig) {
+		t.Fatal("signature accepted with another key")
+	}
+}
+
+func TestProvePossession(t *testing.T) {
+	sk := newTestKeys(t, 1)[0]
+	proof := sk.ProvePossession()
+	if !VerifyPossession(sk.PublicKey(), proof) {
+		t.Fatal("valid proof of possession rejected")
+	}
+	// A signature of the public key is no proof of possession
+	if VerifyPossession(sk.PublicKey(), sk.Sign(sk.PublicKey())) {
+		t.Fatal("signature accepted as proof of possession")
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	keys := newTestKeys(t, 4)
+	msg := []byte("committed seal")
+	var (
+		pubs [][]byte
+		sigs [][]byte
+	)
+	for _, sk := range keys {
+		pubs = append(pubs, sk.PublicKey())
+		sigs = append(sigs, sk.Sign(msg))
+	}
+	agg, err := AggregateSignatures(sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifyAggregate(pubs, msg, agg) {
+		t.Fatal("valid aggregate rejected")
+	}
+	if VerifyAggregate(pubs[:3], msg, agg) {
+		t.Fatal("aggregate accepted with a missing signer")
+	}
+	if _, err := AggregateSignatures(nil); err != ErrNoSignatures {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrNoSignatures)
+	}
+}
+
+// Test vectors of RFC 9380, appendix K.1
+func TestExpandMessageXMD(t *testing.T) {
+	domain := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+	tests := []struct {
+		msg  string
+		want string
+	}{
+		{"", "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235"},
+		{"abc", "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615"},
+	}
+	for _, test := range tests {
+		out, err := expandMessageXMD([]byte(test.msg), domain, 0x20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have := hex.EncodeToString(out); have != test.want {
+			t.Errorf("msg %q: have %s, want %s", test.msg, have, test.want)
+		}
+	}
+}
+
+// Test vector of RFC 9380, appendix J.10.1
+func TestHashToG2(t *testing.T) {
+	domain := []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_")
+	p, err := hashToG2([]byte(""), domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// uncompressed encoding, the fp2 coefficients highest first
+	want := common.FromHex("0x" +
+		"05cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d" +
+		"0141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a" +
+		"12424ac32561493f3fe3c260708a12b7c620e7be00099a974e259ddc7d1f6395c3c811cdd19f1e8dbf3e9ecfdcbab8d6" +
+		"0503921d7f6a12805e72940b963c0cf3471c7b2a524950ca195d11062ee75ec076daf2d4bc358c4b190c0c98064fdd92")
+	if have := bls12381.NewG2().ToBytes(p); !bytes.Equal(have, want) {
+		t.Fatalf("point mismatch:\nhave %x\nwant %x", have, want)
+	}
+}
+
+// sqrtFp returns a square root of a modulo the field modulus, or nil if a
+// isn't a square.
+func sqrtFp(a *big.Int) *big.Int {
+	// the modulus is 3 mod 4, so a^((p+1)/4) is a root if there is one
+	e := new(big.Int).Rsh(new(big.Int).Add(fieldModulus, big.NewInt(1)), 2)
+	r := new(big.Int).Exp(a, e, fieldModulus)
+	if new(big.Int).Exp(r, big.NewInt(2), fieldModulus).Cmp(new(big.Int).Mod(a, fieldModulus)) != 0 {
+		return nil
+	}
+	return r
+}
+
+// outsideSubgroup returns the encodings of a G1 and a G2 point which are on
+// the curves but not in the prime order subgroups.
+func outsideSubgroup(t *testing.T) ([]byte, []byte) {
+	var (
+		p   = fieldModulus
+		two = big.NewInt(2)
+		g1  []byte
+		g2  []byte
+	)
+	for x := int64(1); g1 == nil || g2 == nil; x++ {
+		bx := big.NewInt(x)
+		x3 := new(big.Int).Exp(bx, big.NewInt(3), p)
+
+		// y^2 = x^3 + 4
+		if y := sqrtFp(new(big.Int).Add(x3, big.NewInt(4))); g1 == nil && y != nil {
+			g1 = make([]byte, 96)
+			bx.FillBytes(g1[:48])
+			y.FillBytes(g1[48:])
+		}
+		// y^2 = x^3 + 4(1+i), the root of a0 + a1*i is y0 + y1*i with
+		// y0 = sqrt((a0 +- |a|) / 2) and y1 = a1 / (2 * y0)
+		a0, a1 := new(big.Int).Add(x3, big.NewInt(4)), big.NewInt(4)
+		if g2 != nil {
+			continue
+		}
+		norm := sqrtFp(new(big.Int).Add(new(big.Int).Mul(a0, a0), new(big.Int).Mul(a1, a1)))
+		if norm == nil {
+			continue
+		}
+		half := new(big.Int).ModInverse(two, p)
+		y0 := sqrtFp(new(big.Int).Mul(new(big.Int).Add(a0, norm), half))
+		if y0 == nil {
+			y0 = sqrtFp(new(big.Int).Mul(new(big.Int).Sub(a0, norm), half))
+		}
+		if y0 == nil || y0.Sign() == 0 {
+			continue
+		}
+		y1 := new(big.Int).Mul(a1, new(big.Int).ModInverse(new(big.Int).Mul(two, y0), p))
+		y1.Mod(y1, p)
+		g2 = make([]byte, 192)
+		bx.FillBytes(g2[48:96])
+		y1.FillBytes(g2[96:144])
+		y0.FillBytes(g2[144:])
+	}
+	if _, err := bls12381.NewG1().FromBytes(g1); err != nil {
+		t.Fatalf("g1 point not on curve: %v", err)
+	}
+	if _, err := bls12381.NewG2().FromBytes(g2); err != nil {
+		t.Fatalf("g2 point not on curve: %v", err)
+	}
+	return g1, g2
+}
+
+func TestSubgroupCheck(t *testing.T) {
+	keys := newTestKeys(t, 2)
+	msg := []byte("committed seal")
+	pub, sig := outsideSubgroup(t)
+
+	if _, err := decodePublicKey(pub); err != ErrInvalidPublicKey {
+		t.Fatalf("public key outside of the subgroup: have %v, want %v", err, ErrInvalidPublicKey)
+	}
+	if _, err := decodeSignature(sig); err != ErrInvalidSignature {
+		t.Fatalf("signature outside of the subgroup: have %v, want %v", err, ErrInvalidSignature)
+	}
+	if _, err := AggregatePublicKeys([][]byte{keys[0].PublicKey(), pub}); err != ErrInvalidPublicKey {
+		t.Fatalf("aggregated public key outside of the subgroup: have %v, want %v", err, ErrInvalidPublicKey)
+	}
+	if _, err := AggregateSignatures([][]byte{keys[0].Sign(msg), sig}); err != ErrInvalidSignature {
+		t.Fatalf("aggregated signature outside of the subgroup: have %v, want %v", err, ErrInvalidSignature)
+	}
+	if Verify(keys[1].PublicKey(), msg, sig) {
+		t.Fatal("signature outside of the subgroup accepted")
+	}
+}
+
+func TestKeyGen(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk := SecretKeyFromECDSA(priv)
+	if sk.k.Cmp(SecretKeyFromECDSA(priv).k) != 0 {
+		t.Fatal("key derivation not deterministic")
+	}
+	if sk.k.Cmp(priv.D) == 0 {
+		t.Fatal("node key reused as bls key")
+	}
+	if other := keyGen(crypto.FromECDSA(priv), []byte("other")); other.k.Cmp(sk.k) == 0 {
+		t.Fatal("key info not bound to the derived key")
+	}
+}
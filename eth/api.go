@@ -17,8 +17,10 @@
 package eth
 
 import (
+	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -522,13 +524,19 @@ func (api *PrivateDebugAPI) getModifiedAccounts(startBlock, endBlock *types.Bloc
 	if startBlock.Number().Uint64() >= endBlock.Number().Uint64() {
 		return nil, fmt.Errorf("start block height (%d) must be less than end block height (%d)", startBlock.Number().Uint64(), endBlock.Number().Uint64())
 	}
+	return api.diffAccounts(startBlock.Root(), endBlock.Root())
+}
+
+// diffAccounts returns the accounts of the new state trie that are missing or
+// different in the old one.
+func (api *PrivateDebugAPI) diffAccounts(oldRoot, newRoot common.Hash) ([]common.Address, error) {
 	triedb := api.eth.BlockChain().StateCache().TrieDB()
 
-	oldTrie, err := trie.NewSecure(startBlock.Root(), triedb)
+	oldTrie, err := trie.NewSecure(oldRoot, triedb)
 	if err != nil {
 		return nil, err
 	}
-	newTrie, err := trie.NewSecure(endBlock.Root(), triedb)
+	newTrie, err := trie.NewSecure(newRoot, triedb)
 	if err != nil {
 		return nil, err
 	}
@@ -545,3 +553,148 @@ func (api *PrivateDebugAPI) getModifiedAccounts(startBlock, endBlock *types.Bloc
 	}
 	return dirty, nil
 }
+
+// StateDiffField is the value of an account field before and after a block,
+// null while the field holds its default value.
+type StateDiffField struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// StateDiff is the set of accounts changed by a block, with the fields of each
+// account that changed.
+type StateDiff struct {
+	Number   hexutil.Uint64                               `json:"number"`
+	Hash     common.Hash                                  `json:"hash"`
+	Accounts map[common.Address]map[string]StateDiffField `json:"accounts"`
+}
+
+// StateDiffByBlock returns the accounts changed by the given block, with the
+// before and after values of every field that changed, including the wormholes
+// fields such as pledged balances, exchanger settings and nfts.
+func (api *PrivateDebugAPI) StateDiffByBlock(number rpc.BlockNumber) (*StateDiff, error) {
+	var block *types.Block
+	switch number {
+	case rpc.PendingBlockNumber:
+		return nil, errors.New("state diff of the pending block is not supported")
+	case rpc.LatestBlockNumber:
+		block = api.eth.blockchain.CurrentBlock()
+	default:
+		block = api.eth.blockchain.GetBlockByNumber(uint64(number))
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block #%d not found", number)
+	}
+	parent := api.eth.blockchain.GetBlockByHash(block.ParentHash())
+	if parent == nil {
+		return nil, fmt.Errorf("block %x has no parent", block.Number())
+	}
+	// Accounts deleted by the block only show up when diffing the other way
+	changed, err := api.diffAccounts(parent.Root(), block.Root())
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := api.diffAccounts(block.Root(), parent.Root())
+	if err != nil {
+		return nil, err
+	}
+	oldState, err := api.eth.BlockChain().StateAt(parent.Root())
+	if err != nil {
+		return nil, err
+	}
+	newState, err := api.eth.BlockChain().StateAt(block.Root())
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := stateDiff(oldState, newState, append(changed, deleted...))
+	if err != nil {
+		return nil, err
+	}
+	return &StateDiff{
+		Number:   hexutil.Uint64(block.NumberU64()),
+		Hash:     block.Hash(),
+		Accounts: accounts,
+	}, nil
+}
+
+// stateDiff compares the given accounts between two states, leaving out the
+// accounts whose fields are all equal.
+func stateDiff(oldState, newState *state.StateDB, addrs []common.Address) (map[common.Address]map[string]StateDiffField, error) {
+	var (
+		opts     = &state.DumpConfig{SkipCode: true, SkipStorage: true}
+		accounts = make(map[common.Address]map[string]StateDiffField)
+	)
+	for _, addr := range addrs {
+		if _, ok := accounts[addr]; ok {
+			continue
+		}
+		var before, after *state.DumpAccount
+		if account, ok := oldState.DumpAccount(addr, opts); ok {
+			before = &account
+		}
+		if account, ok := newState.DumpAccount(addr, opts); ok {
+			after = &account
+		}
+		fields, err := diffDumpAccounts(before, after)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) > 0 {
+			accounts[addr] = fields
+		}
+	}
+	return accounts, nil
+}
+
+// diffDumpAccounts returns the fields that differ between two account dumps,
+// keyed by their json name, the fields of the nft prefixed with "nft.". A nil
+// dump is a missing account.
+func diffDumpAccounts(before, after *state.DumpAccount) (map[string]StateDiffField, error) {
+	decode := func(account *state.DumpAccount) (map[string]json.RawMessage, error) {
+		fields := make(map[string]json.RawMessage)
+		if account == nil {
+			return fields, nil
+		}
+		blob, err := json.Marshal(account)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(blob, &fields); err != nil {
+			return nil, err
+		}
+		delete(fields, "address")
+
+		// The fields of the nft are compared one by one
+		if nft, ok := fields["nft"]; ok {
+			var nftFields map[string]json.RawMessage
+			if err := json.Unmarshal(nft, &nftFields); err != nil {
+				return nil, err
+			}
+			delete(fields, "nft")
+			for name, value := range nftFields {
+				fields["nft."+name] = value
+			}
+		}
+		return fields, nil
+	}
+	oldFields, err := decode(before)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := decode(after)
+	if err != nil {
+		return nil, err
+	}
+	diff := make(map[string]StateDiffField)
+	for name, value := range oldFields {
+		if !bytes.Equal(value, newFields[name]) {
+			diff[name] = StateDiffField{Before: value, After: newFields[name]}
+		}
+	}
+	for name, value := range newFields {
+		if _, ok := oldFields[name]; !ok {
+			diff[name] = StateDiffField{After: value}
+		}
+	}
+	return diff, nil
+}
@@ -237,6 +237,14 @@ func (b *EthAPIBackend) GetAllValidators(ctx context.Context, header *types.Head
 	return b.eth.BlockChain().ReadValidatorPool(header)
 }
 
+func (b *EthAPIBackend) GetValidatorStats(ctx context.Context, addr common.Address, from, to uint64) (*types.ValidatorStats, error) {
+	if b.eth.validatorStatsIndexer == nil {
+		return nil, errors.New("validator stats are not supported by the consensus engine")
+	}
+	reader := b.eth.engine.(consensus.ParticipationReader)
+	return core.GetValidatorStats(b.eth.chainDb, b.eth.blockchain, reader, b.eth.validatorStatsIndexer, params.ValidatorStatsBlocks, addr, from, to)
+}
+
 func (b *EthAPIBackend) SendTx(ctx context.Context, signedTx *types.Transaction) error {
 	return b.eth.txPool.AddLocal(signedTx)
 }
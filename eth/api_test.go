@@ -18,6 +18,7 @@ package eth
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -28,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -218,3 +220,64 @@ func TestStorageRangeAt(t *testing.T) {
 		}
 	}
 }
+
+func TestStateDiff(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db          = state.NewDatabase(rawdb.NewMemoryDatabase())
+		oldState, _ = state.New(common.Hash{}, db, nil)
+		owner       = common.Address{0x01}
+		buyer       = common.Address{0x02}
+		idle        = common.Address{0x03}
+	)
+	oldState.MintDeep = &types.MintDeep{UserMint: big.NewInt(0xc0fe), OfficialMint: new(big.Int)}
+	oldState.AddBalance(owner, big.NewInt(100))
+	oldState.AddBalance(idle, big.NewInt(1))
+	nft, _ := oldState.CreateNFTByUser(common.Address{}, owner, 100, "")
+	root, _ := oldState.Commit(false)
+
+	newState, _ := state.New(root, db, nil)
+	newState.OpenExchanger(owner, big.NewInt(40), big.NewInt(1), 100, "exchanger", "url")
+	newState.ChangeNFTOwner(nft, buyer, 0, big.NewInt(1))
+	newState.Commit(false)
+
+	diff, err := stateDiff(oldState, newState, []common.Address{owner, nft, idle, owner})
+	if err != nil {
+		t.Fatalf("failed to diff state: %v", err)
+	}
+	if _, ok := diff[idle]; ok {
+		t.Errorf("unchanged account reported: %v", diff[idle])
+	}
+	var fields []string
+	for name := range diff[owner] {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	want := []string{"balance", "blockNumber", "exchangerBalance", "exchangerFlag", "exchangerName", "exchangerURL", "feeRate"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("changed fields mismatch: have %v, want %v", fields, want)
+	}
+	if field := diff[owner]["balance"]; string(field.Before) != `"100"` || string(field.After) != `"60"` {
+		t.Errorf("balance mismatch: have %s -> %s, want \"100\" -> \"60\"", field.Before, field.After)
+	}
+	// The nft fields are reported one by one
+	fields = fields[:0]
+	for name := range diff[nft] {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	if want := []string{"nft.owner"}; !reflect.DeepEqual(fields, want) {
+		t.Fatalf("changed nft fields mismatch: have %v, want %v", fields, want)
+	}
+	var before, after common.Address
+	if err := json.Unmarshal(diff[nft]["nft.owner"].Before, &before); err != nil {
+		t.Fatalf("failed to decode nft owner: %v", err)
+	}
+	if err := json.Unmarshal(diff[nft]["nft.owner"].After, &after); err != nil {
+		t.Fatalf("failed to decode nft owner: %v", err)
+	}
+	if before != owner || after != buyer {
+		t.Errorf("nft owner mismatch: have %x -> %x, want %x -> %x", before, after, owner, buyer)
+	}
+}
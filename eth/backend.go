@@ -81,9 +81,10 @@ type Ethereum struct {
 	engine         consensus.Engine
 	accountManager *accounts.Manager
 
-	bloomRequests     chan chan *bloombits.Retrieval // Channel receiving bloom data retrieval requests
-	bloomIndexer      *core.ChainIndexer             // Bloom indexer operating during block imports
-	closeBloomHandler chan struct{}
+	bloomRequests         chan chan *bloombits.Retrieval // Channel receiving bloom data retrieval requests
+	bloomIndexer          *core.ChainIndexer             // Bloom indexer operating during block imports
+	validatorStatsIndexer *core.ChainIndexer             // Validator participation indexer, nil if the engine doesn't support it
+	closeBloomHandler     chan struct{}
 
 	APIBackend *EthAPIBackend
 
@@ -226,6 +227,10 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	}
 	eth.blockchain.SetCoinbase(eth.etherbase)
 	eth.bloomIndexer.Start(eth.blockchain)
+	if reader, ok := eth.engine.(consensus.ParticipationReader); ok {
+		eth.validatorStatsIndexer = core.NewValidatorStatsIndexer(chainDb, eth.blockchain, reader, eth.etherbase, params.ValidatorStatsBlocks, params.ValidatorStatsConfirms)
+		eth.validatorStatsIndexer.Start(eth.blockchain)
+	}
 
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
@@ -240,7 +245,9 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	}
 
 	eth.miner = miner.New(eth, &config.Miner, chainConfig, eth.EventMux(), eth.engine, eth.isLocalBlock)
-	eth.miner.SetExtra(makeExtraData(config.Miner.ExtraData))
+	if err := eth.miner.SetExtra(makeExtraData(config.Miner.ExtraData)); err != nil {
+		log.Warn("Miner extra data rejected", "err", err)
+	}
 	if eth.handler, err = newHandler(&handlerConfig{
 		Database:   chainDb,
 		Chain:      eth.blockchain,
@@ -590,6 +597,9 @@ func (s *Ethereum) Stop() error {
 
 	// Then stop everything else.
 	s.bloomIndexer.Close()
+	if s.validatorStatsIndexer != nil {
+		s.validatorStatsIndexer.Close()
+	}
 	close(s.closeBloomHandler)
 	s.txPool.Stop()
 	s.miner.Stop()
@@ -198,6 +198,8 @@ func (eth *Ethereum) stateAtTransaction(block *types.Block, txIndex int, reexec
 		log.Info("makeCurrent()", "state.OfficialNFTPool.InjectedOfficialNFTs", v)
 	}
 
+	statedb.OfficialNFTProposals = eth.blockchain.ReadOfficialNFTProposals(parent.Header())
+
 	var nominatedOfficialNFT *types.NominatedOfficialNFT
 	if parent.NumberU64() > 0 {
 		nominatedOfficialNFT, err = eth.blockchain.ReadNominatedOfficialNFT(parent.Header())
@@ -2,11 +2,11 @@
 // sources:
 // 4byte_tracer.js (2.933kB)
 // bigram_tracer.js (1.712kB)
-// call_tracer.js (8.956kB)
+// call_tracer.js (9.694kB)
 // evmdis_tracer.js (4.195kB)
 // noop_tracer.js (1.271kB)
 // opcount_tracer.js (1.372kB)
-// prestate_tracer.js (4.287kB)
+// prestate_tracer.js (7.006kB)
 // trigram_tracer.js (1.788kB)
 // unigram_tracer.js (1.469kB)
 
@@ -117,7 +117,7 @@ func bigram_tracerJs() (*asset, error) {
 	return a, nil
 }
 
-var _call_tracerJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\xdf\x6f\x1b\x37\xf2\x7f\x96\xfe\x8a\x89\x1f\x6a\x09\x51\x24\x39\xe9\xb7\x5f\xc0\xae\x7a\x50\x1d\x25\x35\xe0\xc6\x81\xad\x34\x08\x82\x3c\x50\xbb\xb3\x12\x6b\x8a\xdc\x92\x5c\xc9\xba\xd6\xff\xfb\x61\x86\xdc\xd5\xae\x24\x3b\xbe\x5e\x71\xe8\xbd\x69\x97\x33\xc3\xe1\xcc\x67\x7e\x71\x35\x18\xc0\xb9\xc9\x37\x56\xce\x17\x1e\x5e\x0e\x4f\xfe\x1f\xa6\x0b\x84\xb9\x79\x81\x7e\x81\x16\x8b\x25\x8c\x0b\xbf\x30\xd6\xb5\x07\x03\x98\x2e\xa4\x83\x4c\x2a\x04\xe9\x20\x17\xd6\x83\xc9\xc0\xef\xd0\x2b\x39\xb3\xc2\x6e\xfa\xed\xc1\x20\xf0\x1c\x5c\x26\x09\x99\x45\x04\x67\x32\xbf\x16\x16\x4f\x61\x63\x0a\x48\x84\x06\x8b\xa9\x74\xde\xca\x59\xe1\x11\xa4\x07\xa1\xd3\x81\xb1\xb0\x34\xa9\xcc\x36\x24\x52\x7a\x28\x74\x8a\x96\xb7\xf6\x68\x97\xae\xd4\xe3\xed\xbb\x0f\x70\x89\xce\xa1\x85\xb7\xa8\xd1\x0a\x05\xef\x8b\x99\x92\x09\x5c\xca\x04\xb5\x43\x10\x0e\x72\x7a\xe3\x16\x98\xc2\x8c\xc5\x11\xe3\x1b\x52\xe5\x26\xaa\x02\x6f\x4c\xa1\x53\xe1\xa5\xd1\x3d\x40\x49\x9a\xc3\x0a\xad\x93\x46\xc3\xab\x72\xab\x28\xb0\x07\xc6\x92\x90\x8e\xf0\x74\x00\x0b\x26\x27\xbe\x2e\x08\xbd\x01\x25\xfc\x96\xf5\x09\x06\xd9\x9e\x3b\x05\xa9\x79\x9b\x85\xc9\x11\xfc\x42\x78\x3a\xf5\x5a\x2a\x05\x33\x84\xc2\x61\x56\xa8\x1e\x49\x9b\x15\x1e\x3e\x5e\x4c\x7f\xba\xfa\x30\x85\xf1\xbb\x4f\xf0\x71\x7c\x7d\x3d\x7e\x37\xfd\x74\x06\x6b\xe9\x17\xa6\xf0\x80\x2b\x0c\xa2\xe4\x32\x57\x12\x53\x58\x0b\x6b\x85\xf6\x1b\x30\x19\x49\xf8\x79\x72\x7d\xfe\xd3\xf8\xdd\x74\xfc\xe3\xc5\xe5\xc5\xf4\x13\x18\x0b\x6f\x2e\xa6\xef\x26\x37\x37\xf0\xe6\xea\x1a\xc6\xf0\x7e\x7c\x3d\xbd\x38\xff\x70\x39\xbe\x86\xf7\x1f\xae\xdf\x5f\xdd\x4c\xfa\x70\x83\xa4\x15\x12\xff\xd7\x6d\x9e\xb1\xf7\x2c\x42\x8a\x5e\x48\xe5\x4a\x4b\x7c\x32\x05\xb8\x85\x29\x54\x0a\x0b\xb1\x42\xb0\x98\xa0\x5c\x61\x0a\x02\x12\x93\x6f\x9e\xec\x54\x92\x25\x94\xd1\x73\x3e\xf3\x83\x80\x84\x8b\x0c\xb4\xf1\x3d\x70\x88\xf0\xfd\xc2\xfb\xfc\x74\x30\x58\xaf\xd7\xfd\xb9\x2e\xfa\xc6\xce\x07\x2a\x88\x73\x83\x1f\xfa\x6d\x92\x99\x08\xa5\xa6\x56\x24\x68\xc9\x39\x02\xb2\x82\xcc\xaf\xcc\x5a\x83\xb7\x42\x3b\x91\x90\xab\xe9\x77\xc2\x60\x14\x1e\xf0\x8e\x9e\xbc\x23\xd0\x82\xc5\xdc\x58\xfa\xad\x54\x89\x33\xa9\x3d\x5a\x2d\x14\xcb\x76\xb0\x14\x29\xc2\x6c\x03\xa2\x2e\xb0\x57\x3f\x0c\xc1\x28\xb8\x1b\xa4\xce\x8c\x5d\x32\x2c\xfb\xed\xdf\xdb\xad\xa8\xa1\xf3\x22\xb9\x25\x05\x49\x7e\x52\x58\x8b\xda\x93\x29\x0b\xeb\xe4\x0a\x99\x04\x02\x4d\xb4\xe7\xe4\x97\x9f\x01\xef\x30\x29\x82\xa4\x56\x25\xe4\x14\x3e\xff\x7e\xff\xa5\xd7\x66\xd1\x29\xba\x04\x75\x8a\x29\x9f\xef\xd6\xc1\x7a\xc1\x16\x85\x35\x1e\xaf\x10\x7e\x2d\x9c\xaf\xd1\x64\xd6\x2c\x41\x68\x30\x05\x21\xbe\x6e\x1d\xa9\xbd\x61\x81\x82\x7e\x6b\xb4\xac\x51\xbf\xdd\xaa\x98\x4f\x21\x13\xca\x61\xdc\xd7\x79\xcc\xe9\x34\x52\xaf\xcc\x2d\x49\x36\x96\x20\x6c\x37\x60\xf2\xc4\xa4\x31\x18\xe8\x1c\xd5\x31\xd0\xf5\xdb\x2d\xe2\x3b\x85\xac\xd0\xbc\x6d\x47\x99\x79\x0f\xd2\x59\x17\x7e\x6f\xb7\x48\xec\xb9\xc8\x7d\x61\x91\xed\x89\xd6\x1a\xeb\x40\x2e\x97\x98\x4a\xe1\x51\x6d\xda\xad\xd6\x4a\xd8\xb0\x00\x23\x50\x66\xde\x9f\xa3\x9f\xd0\x63\xa7\x7b\xd6\x6e\xb5\x64\x06\x9d\xb0\xfa\x6c\x34\xe2\xec\x93\x49\x8d\x69\x10\xdf\xf2\x0b\xe9\xfa\x99\x28\x94\xaf\xf6\x25\xa6\x96\x45\x5f\x58\x4d\x3f\xef\x83\x16\x1f\x11\x8c\x56\x1b\x48\x28\xcb\x88\x19\x85\xa7\xdb\x38\x8f\xcb\x78\x38\xd7\x83\x4c\x38\x32\xa1\xcc\x60\x8d\x90\x5b\x7c\x91\x2c\x90\x7c\xa7\x13\x8c\x5a\xba\x8d\x63\xa7\x8e\x80\x76\xeb\x9b\xbc\xef\xcd\xbb\x62\x39\x43\xdb\xe9\xc2\x37\x30\xbc\xcb\x86\x5d\x18\x8d\xf8\x47\xa9\x7b\xe4\x89\xfa\x92\x14\x93\xc7\x83\x32\xff\x8d\xb7\x52\xcf\xc3\x59\xa3\xae\x17\x19\x08\xd0\xb8\x86\xc4\x68\x06\x35\x79\x65\x86\x52\xcf\x21\xb1\x28\x3c\xa6\x3d\x10\x69\x0a\xde\x04\xe4\x55\x38\x6b\x6e\x09\xdf\x7c\x03\x1d\xda\x6c\x04\xc7\xe7\xd7\x93\xf1\x74\x72\x0c\x7f\xfc\x01\xe1\xcd\x51\x78\xf3\xf2\xa8\x5b\xd3\x4c\xea\xab\x2c\x8b\xca\xb1\xc0\x7e\x8e\x78\xdb\x39\xe9\xf6\x57\x42\x15\x78\x95\x05\x35\x23\xed\x44\xa7\x30\x8a\x3c\xcf\x77\x79\x5e\x36\x78\x88\x69\x30\x80\xb1\x73\xb8\x9c\x29\xdc\x0f\xc8\x18\xb1\x1c\xbc\xce\x53\xc6\x22\xf4\x25\x66\x99\x2b\x24\x54\x95\xbb\x46\xf3\xb3\xc6\x2d\xbf\xc9\xf1\x14\x00\xc0\xe4\x3d\x7e\x41\xb1\xc0\x2f\xbc\xf9\x09\xef\xd8\x47\xa5\x09\x09\x55\xe3\x34\xb5\xe8\x5c\xa7\xdb\x0d\xe4\x52\xe7\x85\x3f\x6d\x90\x2f\x71\x69\xec\xa6\xef\x28\x21\x75\xf8\x68\xbd\x70\xd2\x92\x67\x2e\xdc\x85\x26\x9e\x88\xd4\xb7\xc2\x75\xb6\x4b\xe7\xc6\xf9\xd3\x72\x89\x1e\xca\x35\xb6\x05\xb1\x1d\x0f\xef\x8e\xf7\xad\x35\xec\x6e\x91\x70\xf2\x5d\x97\x58\xee\xcf\x2a\x7c\x57\x69\xa2\x9f\x17\x6e\xd1\x61\x38\x6d\x57\xb7\xa9\x60\x04\xde\x16\x78\x10\xfe\x0c\xa9\x7d\x38\x39\x54\x19\xe5\x12\x6f\x8b\x84\x61\x35\x17\x9c\x69\x38\xd2\x05\x65\x5e\x57\xcc\xd8\xe6\xde\x98\x7d\x74\x45\x70\xdd\x4c\x2e\xdf\xbc\x9e\xdc\x4c\xaf\x3f\x9c\x4f\x8f\x6b\x70\x52\x98\x79\x52\xaa\x79\x06\x85\x7a\xee\x17\xac\x3f\x89\x6b\xae\x7e\x26\x9e\x17\x27\x5f\xc2\x1b\x18\x1d\x08\xf9\xd6\xe3\x1c\xf0\xf9\x0b\xcb\xbe\xdf\x37\x5f\x93\x34\x18\xf3\xaf\x41\x92\x37\x4c\x5c\x92\x7b\x53\x12\x3c\xee\xe7\xbf\x18\x54\xe9\x8c\x28\x7e\x14\x4a\xe8\x04\x1f\xd1\x79\x1f\x6b\xf5\xa4\x79\x20\x0f\x2d\xd1\x2f\x4c\xca\x85\x21\x11\xa1\xb6\x94\x08\x4a\x8d\xc6\x7f\x3f\x1b\x8d\x2f\x2f\x6b\xb9\x88\x9f\xcf\xaf\x5e\xd7\xf3\xd3\xf1\xeb\xc9\xe5\xe4\xed\x78\x3a\xd9\xa5\xbd\x99\x8e\xa7\x17\xe7\xfc\xb6\x4c\x5d\x83\x01\xdc\xdc\xca\x9c\x2b\x0c\xe7\x6d\xb3\xcc\xb9\x55\xae\xf4\x75\x3d\xf0\x0b\x43\x4d\xa8\x8d\x05\x34\x13\x3a\x29\x0b\x9b\x2b\x01\xeb\x0d\xc1\xf5\x21\xe7\x9d\xec\x38\xaf\x82\xb0\x74\xef\x2d\xc6\x4d\xd3\x8e\x37\xa5\x5e\x5b\x83\x06\x34\x72\xf2\xe7\x04\xdb\x79\xfa\x21\xe1\x1f\x30\x84\x53\x38\x89\x59\xf4\x91\x34\xfd\x12\x9e\x93\xf8\x3f\x91\xac\x5f\x1d\xe0\xfc\x7b\xa6\xec\xbd\x40\xfb\xef\xa7\x72\x53\xf8\xab\x2c\x3b\x85\x5d\x23\x7e\xbb\x67\xc4\x8a\xfe\x12\xf5\x3e\xfd\xff\xed\xd1\x6f\xd3\x3e\xa1\xca\xe4\xf0\x6c\x0f\x22\x21\xe9\x3e\xdb\x89\x83\x68\x5c\x6e\xef\x58\x1a\x8c\x1e\x28\x34\x2f\x9b\x18\x7e\x28\x53\xfe\x47\x85\xe6\x60\x9b\x4a\xcd\x68\xb3\x11\xed\x81\x45\x6f\x25\xae\x68\xd4\x3c\x76\x2c\x92\x1a\x76\xb3\xa6\xf4\xd5\x87\x8f\x18\x24\x6a\x44\x4e\x2e\xb1\xc1\xa7\xfe\x8c\x7b\x5e\x6a\xd2\xe3\xa8\xc6\x10\x13\xdc\x87\x5b\x84\xa5\xd8\xd0\xa8\x96\x15\xfa\x76\x03\x73\xe1\x20\xdd\x68\xb1\x94\x89\x0b\xf2\xb8\xb9\xb7\x38\x17\x96\xc5\x5a\xfc\xad\x40\x47\x73\x1f\x01\x59\x24\xbe\x10\x4a\x6d\x60\x2e\x69\x78\x23\xee\xce\xcb\x57\xc3\x21\x38\x2f\x73\xd4\x69\x0f\xbe\x7b\x35\xf8\xee\x5b\xb0\x85\xc2\x6e\xbf\x5d\x2b\x61\xd5\x51\xa3\x37\x68\x21\xa2\xe7\x35\xe6\x7e\xd1\xe9\xc2\x0f\x0f\xd4\xc2\x07\x0a\xdb\x41\x5a\x78\x01\x27\x5f\xfa\xa4\xd7\xa8\x81\xdb\xe0\x49\x40\xe5\x30\x4a\xa3\x81\xf7\xea\xf5\x55\xe7\x56\x58\xa1\xc4\x0c\xbb\xa7\x3c\x00\xb3\xad\xd6\x22\x4e\x40\xe4\x14\xc8\x95\x90\x1a\x44\x92\x98\x42\x7b\x32\x7c\x39\xcc\xa8\x0d\xe5\xf7\x63\x5f\xca\xe3\x59\x51\x24\x09\x3a\x57\xa6\x7b\xf6\x1a\xa9\x23\x96\xc4\x0d\x52\x3b\x99\x62\xcd\x2b\x94\x1d\x0c\xa7\xe6\x48\x41\xa3\x74\x29\x70\x69\x1c\x6d\x32\x43\x58\x5b\x1a\xbc\x9c\xd4\x09\xdf\x3c\xa4\x48\xd6\x76\x60\x34\x08\x50\x86\xaf\x3b\x38\xc6\x41\xd8\xb9\xeb\x87\x7c\x4f\xdb\x52\xce\xd1\x66\xdd\x6f\x02\xb9\x0e\x55\x1e\x71\x76\x5a\x21\x0d\x78\x27\x9d\xe7\x8e\x9a\xb4\x94\x0e\x02\x92\xa5\x9e\xf7\x20\x37\x39\xe7\xe9\xaf\x95\xb3\x98\xac\xaf\x27\xbf\x4c\xae\xab\xc6\xe7\xe9\x4e\x2c\x67\x9e\xa3\x6a\x24\x04\x4b\xf3\x96\xc7\xf4\xe8\xc0\x10\x73\x00\x50\xa3\x07\x00\x45\xf2\xb7\xb5\xf1\x7d\xed\x38\x4a\x38\xbf\x75\xcc\x1c\xc3\x3c\x57\x57\xc0\x15\xca\xbb\x9d\xdc\xbd\x9b\x1c\x4c\x5e\x56\x08\x52\x8a\xd3\x0e\x25\xf6\xdd\x49\xa3\xb1\xb0\x1d\x38\xb6\xf8\xbc\xa8\xd9\x78\xcd\xed\x66\x20\xaa\xa5\x06\x5e\x2f\xfb\x56\x11\xaa\x01\xeb\x6e\x0a\x4f\x70\xa0\xfa\xbd\x4d\x7e\x73\xe1\x3e\x38\xf6\x7a\x4c\x7f\x33\x39\xbf\xd0\xbe\x53\x2e\x5e\x68\x78\x01\xe5\x03\x25\x75\x78\xd1\x88\xa2\x03\xd9\xb1\x95\xa2\x42\x8f\xb0\x15\x71\x06\x3b\xaf\x48\x50\x30\x07\x1b\xcd\xa2\xdf\x2f\xce\xc3\x28\x8d\x0c\xf6\xcc\xa2\xef\xe3\x6f\x85\x50\xae\x33\xac\x9a\x85\x70\x02\x6f\xb8\xbc\x8d\xf6\x3a\x49\xe2\x69\xf6\x8e\x67\x35\xb6\x68\x8d\x92\x2d\x74\x82\xe7\x26\xc5\x47\x25\x44\x11\x31\x6d\x54\xbe\x8c\xc0\x3c\xd4\x7b\xb7\xea\x04\x70\x54\x35\x04\x99\x90\xaa\xb0\x78\x74\x06\x07\xd2\x8e\x2b\x6c\x26\x12\xf6\xa5\x43\xe0\x69\xdd\x81\x33\x4b\x5c\x98\x75\x50\xe0\x50\xf2\xda\x07\x47\x85\x83\x9d\xf2\xc1\xd7\x4e\xc2\x41\xe1\xc4\x1c\x6b\xe0\xa8\x0c\x5e\x3a\xea\xe0\x15\xc2\x9f\x86\xce\xf3\xea\xf1\x09\x28\xba\xff\x6b\xe0\xb1\xe3\xe7\xbd\x3e\xa7\x24\xe2\x6e\xa7\xf6\x50\x2a\x1b\x9a\x91\xbf\x97\xe3\x9f\x1c\x61\xbb\xb4\xe1\x68\x4d\xe2\x70\xc0\x6d\x5f\xf3\x75\xf7\x57\xab\x0f\x79\xfe\xa1\x96\x89\x30\xaa\x7f\xc5\xc4\x6f\x71\xca\x5d\x0e\x3d\xe5\x16\x57\xd2\x14\x54\xc0\xf0\x7f\x69\x1c\xae\x5a\xbe\xfb\x76\xeb\x3e\xde\x0b\xb2\xdf\xea\x17\x83\xeb\x45\xbc\xd7\x0e\xdd\x52\xad\x7c\x18\xae\xad\xf1\xba\x30\x0b\x37\xce\x2d\xe6\x7f\xe4\x82\x30\x06\xba\x37\x39\xb5\x03\xb1\x3a\x29\x8b\x22\xdd\x54\x05\xb1\x17\x1a\x11\x58\x08\x9d\xc6\x61\x44\xa4\xa9\x24\x79\x0c\x42\xd2\x50\xcc\x85\xd4\xed\x83\x66\xfc\x6a\x15\x3e\x84\x8c\xbd\xde\xb6\x5e\x48\xe3\x10\x49\x13\x1f\x6b\xdc\x7e\x42\xc1\xdc\x09\xa2\xdd\xbb\xce\x78\x5d\x6a\xb4\x2b\x96\xdc\x09\x83\x58\x09\xa9\x04\x4d\x5f\xdc\x61\xe9\x14\x12\x85\x42\x87\x2f\x1c\x98\x79\xb3\x42\xeb\xda\x4f\x00\xf9\x9f\xc1\xf8\x4e\x56\x2c\x1f\xa3\x39\x9e\x1e\xb3\x4f\x8d\xd8\x70\xfc\x37\x4a\x78\x1f\xe1\x55\x33\x6f\x88\x2c\xe9\xf9\xe3\x17\x6a\xdf\x7e\x5a\x48\x71\xcf\x44\x34\x3f\xc0\xb0\xd6\x97\xff\x5d\x82\x6c\x1f\x62\x97\x55\x7f\x16\x0f\xef\x8d\xe9\x81\x42\xc1\x53\x52\xf9\x69\xaa\xec\x47\x1f\x1b\xda\xca\xe8\x0d\x1d\xdd\x5e\xf8\xf2\x9d\xde\x02\xcb\x1b\x90\xd0\xda\xcf\x10\x35\x48\x8f\x56\xd0\x3c\x44\xe8\x8a\x5f\x53\x48\x4b\xc7\xe2\xd8\x2f\x92\x82\x2e\x0a\x8e\x9f\x36\xa8\x30\x4b\x3d\xef\xb7\x5b\xe1\x7d\x2d\xde\x13\x7f\xb7\x8d\xf7\x50\x01\x99\x33\xde\x09\x54\x57\x02\x89\xbf\xe3\x6e\x91\xc7\xe6\x9d\x7b\x01\x5a\xa3\x57\x61\xa6\xde\xb9\x05\x60\xc6\x78\x13\xb0\x7b\x27\x46\x6b\xfc\xae\x01\x70\x26\x9d\x0b\x17\xc4\xec\x84\x84\xbf\xdb\x8f\x88\x92\x81\x82\xe1\xf4\x30\x03\x2d\x1d\x60\xda\xb9\x99\x20\x62\x7e\x15\x56\x43\x3d\x3f\xad\xaf\x86\x57\xf1\xa0\x72\x59\xb3\x8d\x5c\xb2\x6d\xee\xcf\x0e\x27\xb9\x61\x89\xc7\xc3\xc9\x8c\x6c\x5e\x01\xf6\x01\xd6\xfa\xac\xb1\x4f\xf2\x58\xaa\x64\xe9\x65\x66\x7b\x80\x95\xa5\xd7\x5a\x0e\x7f\xf7\x74\x91\x15\x71\x5d\xc5\x06\x4d\x43\x08\xdf\x36\xee\x2d\x1f\x9a\xb4\x68\x50\x89\x84\x65\x73\x35\x1a\x1d\x0d\xef\xaa\x0f\x23\x31\x57\x35\x68\x4a\x25\x42\x64\x84\xf3\x72\x54\xc8\x7f\x62\xdc\xb6\x1e\x83\xe5\x12\x58\x0c\x1f\x70\xb8\x9b\xa5\x10\x34\x33\x6e\x20\x0a\x47\xa3\xe8\x36\xb6\x52\x74\xd2\x62\x0a\x99\x44\x95\x82\x49\xd1\xf2\xa0\xfb\xab\x33\x3a\x7c\xaa\x43\x2b\x49\x62\xf8\x24\x19\xfe\x1d\xc0\x1f\x4a\xb5\x4c\xd0\x6f\x20\x43\xc1\xdf\xdc\xbc\x81\x5c\x38\x07\x4b\x14\x34\xda\x66\x85\x52\x1b\x30\x36\x45\x12\x5e\xcd\x7a\x14\xd6\x06\x0a\x87\xd6\xc1\x7a\x61\x62\xa9\xe5\x16\x2f\xa7\x6e\x55\xfa\x5e\xbc\xce\x91\x2e\x57\x62\x03\xd2\x53\x59\x8f\x87\xaa\x47\x7a\xf5\xa1\x8b\xbf\x96\x19\x32\xf0\x7e\x98\x97\x53\x61\x33\xce\xf9\x35\x3d\x35\x23\x3c\x0e\x45\xcd\xd8\xde\x5e\x74\x35\x03\xb9\x2c\x3d\xcd\x68\xad\x17\xb2\x66\x48\xf2\x0a\x3f\x35\x83\xb1\xd6\x6a\xf3\x02\x23\xa8\x62\xe0\xa7\x9d\xf0\x64\x2d\x63\x7c\x86\xcf\xba\x15\x39\x3f\xf5\x22\x60\xc8\x8b\x1d\x32\xce\x2d\x6e\x28\x9b\x07\x1b\xd5\x4a\x53\x78\xf1\xf9\x16\x37\x5f\x0e\x57\xa2\x08\xc7\x1a\x5d\x55\x7a\xca\xb0\x08\x6b\x8f\x24\x83\x4a\x0b\x39\x1a\x9e\x81\xfc\xbe\xce\x50\x56\x4f\x90\xcf\x9f\x97\x7b\xd6\xd7\x3f\xcb\x2f\x65\x84\x57\x88\xdf\x59\xef\x36\x34\x8a\x31\x12\x68\x28\x28\xda\xf7\xed\x7f\x05\x00\x00\xff\xff\xfb\x65\x93\x4f\xfc\x22\x00\x00")
+var _call_tracerJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\xdf\x6f\x1b\xb7\x93\x7f\x96\xfe\x8a\x49\x1e\x6a\x09\x51\x24\x27\xe9\xf5\x00\xbb\xea\x41\x75\x94\x44\x80\x1b\x07\xb6\xd2\x20\x08\xfc\x40\xed\xce\x4a\xac\xb9\xe4\x96\xe4\x4a\xde\xa6\xfe\xdf\x0f\xc3\x25\xf7\x97\x64\xc7\xed\x15\x87\x7e\x5f\x0c\x2d\xc9\x19\x0e\x87\x9f\xf9\x49\x4f\x26\x70\xa6\xb2\x42\xf3\xf5\xc6\xc2\xcb\xe3\x17\xff\x0d\xcb\x0d\xc2\x5a\x3d\x47\xbb\x41\x8d\x79\x0a\xb3\xdc\x6e\x94\x36\xfd\xc9\x04\x96\x1b\x6e\x20\xe1\x02\x81\x1b\xc8\x98\xb6\xa0\x12\xb0\x9d\xf5\x82\xaf\x34\xd3\xc5\xb8\x3f\x99\x94\x34\x07\xa7\x89\x43\xa2\x11\xc1\xa8\xc4\xee\x98\xc6\x13\x28\x54\x0e\x11\x93\xa0\x31\xe6\xc6\x6a\xbe\xca\x2d\x02\xb7\xc0\x64\x3c\x51\x1a\x52\x15\xf3\xa4\x20\x96\xdc\x42\x2e\x63\xd4\x6e\x6b\x8b\x3a\x35\x41\x8e\xb7\xef\x3f\xc2\x39\x1a\x83\x1a\xde\xa2\x44\xcd\x04\x7c\xc8\x57\x82\x47\x70\xce\x23\x94\x06\x81\x19\xc8\x68\xc4\x6c\x30\x86\x95\x63\x47\x84\x6f\x48\x94\x2b\x2f\x0a\xbc\x51\xb9\x8c\x99\xe5\x4a\x8e\x00\x39\x49\x0e\x5b\xd4\x86\x2b\x09\xaf\xc2\x56\x9e\xe1\x08\x94\x26\x26\x03\x66\xe9\x00\x1a\x54\x46\x74\x43\x60\xb2\x00\xc1\x6c\x4d\xfa\x08\x85\xd4\xe7\x8e\x81\x4b\x77\xbc\x8d\xca\x10\xec\x86\x59\xd2\xc4\x8e\x0b\x01\x2b\x84\xdc\x60\x92\x8b\x11\x71\x5b\xe5\x16\x3e\x2d\x96\xef\x2e\x3e\x2e\x61\xf6\xfe\x33\x7c\x9a\x5d\x5e\xce\xde\x2f\x3f\x9f\xc2\x8e\xdb\x8d\xca\x2d\xe0\x16\x4b\x56\x3c\xcd\x04\xc7\x18\x76\x4c\x6b\x26\x6d\x01\x2a\x21\x0e\xbf\xcc\x2f\xcf\xde\xcd\xde\x2f\x67\x3f\x2f\xce\x17\xcb\xcf\xa0\x34\xbc\x59\x2c\xdf\xcf\xaf\xae\xe0\xcd\xc5\x25\xcc\xe0\xc3\xec\x72\xb9\x38\xfb\x78\x3e\xbb\x84\x0f\x1f\x2f\x3f\x5c\x5c\xcd\xc7\x70\x85\x24\x15\x12\xfd\xb7\x75\x9e\xb8\xdb\xd3\x08\x31\x5a\xc6\x85\x09\x9a\xf8\xac\x72\x30\x1b\x95\x8b\x18\x36\x6c\x8b\xa0\x31\x42\xbe\xc5\x18\x18\x44\x2a\x2b\x1e\x7d\xa9\xc4\x8b\x09\x25\xd7\xee\xcc\xf7\x02\x12\x16\x09\x48\x65\x47\x60\x10\xe1\xc7\x8d\xb5\xd9\xc9\x64\xb2\xdb\xed\xc6\x6b\x99\x8f\x95\x5e\x4f\x44\xc9\xce\x4c\x7e\x1a\xf7\x89\x67\xc4\x84\x58\x6a\x16\xa1\x26\xb4\x32\x48\x72\x52\xbf\x50\x3b\x09\x56\x33\x69\x58\x44\x57\x4d\xbf\x69\x89\xbb\x24\xbc\xa5\x2f\x6b\x08\xb4\xa0\x31\x53\x9a\x7e\x0b\x11\x70\xc6\xa5\x45\x2d\x99\x70\xbc\x0d\xa4\x2c\x46\x58\x15\xc0\x9a\x0c\x47\xcd\xc3\x10\x8c\xca\xeb\x06\x2e\x13\xa5\x53\x07\xcb\x71\xff\x6b\xbf\xe7\x25\x34\x96\x45\x37\x24\x20\xf1\x8f\x72\xad\x51\x5a\x52\x65\xae\x0d\xdf\xa2\x5b\x02\xe5\x1a\xaf\xcf\xf9\xaf\xbf\x00\xde\x62\x94\x97\x9c\x7a\x15\x93\x13\xf8\xf2\xf5\xee\x7a\xd4\x77\xac\x63\x34\x11\xca\x18\x63\x12\x2d\xba\x31\xb0\xdb\x38\x8d\xc2\x0e\x8f\xb6\x08\xbf\xe5\xc6\x36\xd6\x24\x5a\xa5\xc0\x24\xa8\x9c\x10\xdf\xd4\x0e\x97\x56\x39\x86\x8c\x7e\x4b\xd4\x4e\xa2\x71\xbf\x57\x11\x9f\x40\xc2\x84\x41\xbf\xaf\xb1\x98\xd1\x69\xb8\xdc\xaa\x1b\x8c\x1d\x78\x70\x8b\xba\x00\x95\x45\x2a\xf6\xc6\x40\x67\xad\x8e\x81\x66\xdc\xef\x11\xdd\x09\x24\xb9\x74\xdb\x0e\x84\x5a\x8f\x20\x5e\x0d\xe1\x6b\xbf\x47\xbb\x9f\xb1\xcc\xe6\x1a\x9d\x59\xa2\xd6\x4a\x1b\xe0\x69\x8a\x31\x67\x16\x45\xd1\xef\xf5\xb6\x4c\x97\x13\x30\x05\xa1\xd6\xe3\x35\xda\x39\x7d\x0e\x86\xa7\xfd\x5e\x8f\x27\x30\x28\x67\x9f\x4c\xa7\xce\xfb\x24\x5c\x62\x5c\xb2\xef\xd9\x0d\x37\xe3\x84\xe5\xc2\x56\xfb\x12\x51\x4f\xa3\xcd\xb5\xa4\x9f\x77\xa5\x14\x9f\x10\x94\x14\x05\x44\xe4\x65\xd8\x8a\xcc\xd3\x14\xc6\x62\xea\x0f\x67\x46\x90\x30\x43\x2a\xe4\x09\xec\x10\x32\x8d\xcf\xa3\x0d\x46\x37\xa0\x64\x84\x5e\x4a\x53\x18\x52\x21\x4c\x81\x76\x1b\xab\x6c\x6c\xd5\xfb\x3c\x5d\xa1\x1e\x0c\xe1\x3b\x38\xbe\x4d\x8e\x87\x30\x9d\xba\x1f\x41\x76\x4f\xe3\xe5\xa5\xb3\xaa\xcc\x1f\xd4\xd1\x5f\x59\xcd\xe5\x7a\x30\x6c\xc8\xba\x48\x80\x81\xc4\x1d\x44\x4a\x12\x04\x2c\xdd\xca\x0a\xb9\x5c\x43\xa4\x91\x59\x8c\x47\xc0\xe2\x18\xac\x72\xa8\xaa\x71\xd6\xde\x12\xbe\xfb\x0e\x06\xb4\xd9\x14\x8e\xce\x2e\xe7\xb3\xe5\xfc\x08\xfe\xfc\x13\xca\x91\xa7\xe5\xc8\xcb\xa7\xc3\x86\x64\x5c\x5e\x24\x89\x17\xce\xe1\x72\x9c\x21\xde\x0c\x5e\x0c\xc7\x5b\x26\x72\xbc\x48\x4a\x31\xfd\xda\xb9\x8c\x61\xea\x69\x9e\x75\x69\x5e\xb6\x68\xe8\x4a\x26\x13\x98\x19\x83\xe9\x4a\xe0\xbe\x41\x7a\x8b\x75\xc6\x6b\xac\xd2\xa5\xeb\x8a\x54\x9a\x09\x24\x54\x85\x5d\xbd\xfa\x9d\xc4\x3d\x5b\x64\x78\x02\x00\xa0\xb2\x91\x1b\x20\x5b\x70\x03\x56\xbd\xc3\x5b\x77\x47\x41\x85\x84\xaa\x59\x1c\x6b\x34\x66\x30\x1c\x96\xcb\xb9\xcc\x72\x7b\xd2\x5a\x9e\x62\xaa\x74\x31\x36\xe4\x90\x06\xee\x68\xa3\xf2\xa4\x81\x66\xcd\xcc\x42\x12\x8d\x47\xea\x5b\x66\x06\xf5\xd4\x99\x32\xf6\x24\x4c\xd1\x47\x98\x73\xba\x20\xb2\xa3\xe3\xdb\xa3\x7d\x6d\x1d\x0f\x6b\x24\xbc\xf8\x61\x48\xec\xee\x4e\x2b\x7c\x57\x6e\x62\x9c\xe5\x66\x33\xa0\xcf\x61\x3d\x5b\xbb\x82\x29\x58\x9d\xe3\x41\xf8\x3b\x48\xed\xc3\xc9\xa0\x48\xc8\x97\x58\x9d\x47\x0e\x56\x6b\xe6\x3c\x8d\xb3\x74\x46\x9e\xd7\xe4\x2b\xda\x0f\xac\x52\xfb\xe8\xf2\xe0\xba\x9a\x9f\xbf\x79\x3d\xbf\x5a\x5e\x7e\x3c\x5b\x1e\x35\xe0\x24\x30\xb1\x30\x85\xce\x19\x04\xca\xb5\xdd\x38\xf9\xc9\x3e\xda\xb3\x5f\x88\xe6\xf9\x8b\xeb\x72\x04\xa6\x07\x4c\xbe\xf7\x30\x05\x7c\xb9\x76\xbc\xef\xfa\xdf\x58\x5a\x2a\xf3\x9f\x41\x92\x55\x8e\x3a\x2c\xb7\x2a\x2c\x78\xf8\x9e\xff\x61\x50\xc5\x2b\x22\xfe\x99\x09\x26\x23\x7c\x40\xe6\x7d\xac\x35\x9d\xe6\x01\x3f\x94\xa2\xdd\x28\x4a\x8c\xb6\x2a\x72\x51\xb0\x46\x50\xac\x24\xfe\x75\x6f\x34\x3b\x3f\x6f\xf8\x22\xf7\x7d\x76\xf1\xba\xe9\x9f\x8e\x5e\xcf\xcf\xe7\x6f\x67\xcb\x79\x77\xed\xd5\x72\xb6\x5c\x9c\xb9\xd1\xe0\xba\x26\x13\xb8\xba\xe1\x99\x8b\x30\xce\x6f\xab\x34\x73\xa9\x72\x25\xaf\x19\x81\xdd\x28\x4a\x42\xb5\x0f\xa0\x09\x93\x51\x08\x6c\x26\x00\xd6\x2a\x82\xeb\x7d\x97\xf7\xa2\x73\x79\x15\x84\xb9\xf9\xa0\x91\x7c\x15\x17\x18\x0f\xac\x0a\x72\xd5\x0a\x75\x1a\x75\x36\xa1\x9c\x83\x1d\x3c\xfe\x90\xf0\x3f\x70\x0c\x27\xf0\xc2\x7b\xd1\x07\xdc\xf4\x4b\x78\x06\x2a\x49\xfe\x86\xb3\x7e\x75\x80\xf2\xdf\xe9\xb2\xf7\x0c\xed\xff\xdf\x95\xab\xdc\x5e\x24\xc9\x09\x74\x95\xf8\xfd\x9e\x12\xab\xf5\xe7\x28\xf7\xd7\xff\xd7\xde\xfa\xda\xed\x93\xdd\xa8\x0c\x9e\xec\x41\xa4\x74\xba\x4f\x3a\x76\xe0\x95\x4b\x6e\xad\xbc\x7c\x98\xde\x13\x68\x5e\xb6\x31\x7c\x9f\xa7\xfc\x3f\x05\x9a\x83\x69\x2a\x25\xa3\xed\x44\x74\x04\x1a\xad\xe6\xb8\xa5\x52\xf3\xc8\x38\x96\x94\xb0\xab\x1d\xb9\xaf\x31\x7c\xa2\x0d\x26\x13\x90\x48\x99\xb0\x0a\x09\x3e\xf0\x04\x28\xce\xbb\x24\xdd\x97\x6a\xc4\x8e\xea\x4b\x8a\x5d\x08\x29\x2b\xa8\x54\x4b\x72\x79\x53\xc0\x9a\x19\x88\x0b\xc9\x52\x1e\x91\x99\x4f\x26\x8e\x0e\x34\xae\x99\x76\x6c\x35\xfe\x9e\xa3\xa1\xba\x8f\x72\x0f\x16\xd9\x9c\x09\x51\xc0\x9a\x53\xf1\x46\xd4\x83\x97\xaf\x8e\x8f\xc1\x58\x9e\xa1\x8c\x47\xf0\xc3\xab\xc9\x0f\xdf\x83\xce\x05\x0e\xc7\xde\xc3\xb5\xb5\xe3\x6f\x83\xae\xd0\xa3\xe7\x35\x66\x76\x33\x18\xc2\x4f\xf7\xc4\xc2\x70\x7f\xed\xc9\x2f\x07\xd7\xc2\x73\x78\x71\x3d\x26\xb9\xaa\x64\xd9\x45\x8b\xf2\x26\x01\x85\x41\xcf\x8d\x3a\x00\x17\xaf\x2f\x06\x37\x4c\x33\xc1\x56\x38\x3c\x71\x0d\x06\xa7\xab\x1d\xf3\x15\x10\x5d\x0a\x64\x82\x71\x09\x2c\x8a\x54\x2e\x2d\x29\x3e\x14\x33\xa2\x80\x58\xc9\x23\x1b\xf8\xb9\x5a\x91\x45\x11\x1a\x13\xdc\xbd\xbb\x35\x12\x87\xa5\x44\x0d\x5c\x1a\x4e\x7c\xc3\x4e\xa4\x54\xa3\x9c\x6b\xf6\x2b\xa8\x94\x0e\x0c\x53\x65\xac\x70\xb7\xb5\xd3\x54\x45\x1a\x2e\x23\x82\x03\xc4\x48\xda\x36\xa0\x24\x30\x10\xca\xb5\x3b\x5c\xba\x06\x4c\xaf\xcd\xb8\xf4\xf7\xb4\x2d\xa5\x89\x52\xed\xc6\x6d\x20\xd7\xb8\x9b\x96\x25\x4e\x27\x15\x92\x80\xb7\xdc\x58\x0a\x60\x4e\x1f\xdc\x10\x18\x73\x2d\xb9\x5c\x8f\x20\x53\x19\x59\xe6\x37\xc3\x99\x77\xd6\x97\xf3\x5f\xe7\x97\x55\xe2\xf3\xf8\x4b\x0c\x35\xcf\xd3\xaa\x24\x04\x4d\xf5\x96\xc5\xf8\xe9\x81\x22\xe6\x00\xa0\xa6\xf7\x00\x8a\xf8\x7b\x71\x26\x13\xf8\xd0\x38\x8e\x60\xc6\xd6\x17\xb3\x46\xeb\x46\x9b\x02\x98\x5c\x58\xd3\xf1\xdd\x9d\x4d\x32\x95\x85\x08\x41\x42\x11\xbb\x31\x39\xf6\x6e\xa5\xd1\x9a\xa8\x0b\x8e\x1a\x9f\x8b\x86\x8e\x09\x92\x0c\xca\x45\x0d\xd7\xe0\xe6\x7d\x40\xa0\x34\x83\x02\xb3\x0b\x39\x2a\xb7\x04\x87\x48\xc5\x58\x3b\xbf\x35\x33\x1f\x0d\xc6\xb5\xfb\x5b\xf1\xf5\x42\xda\x41\x98\x5c\x48\x78\x0e\xe1\x83\x9c\x3a\x3c\x6f\x59\xd1\x01\xef\xd8\x8b\x51\xa0\xc5\x8a\x6a\x21\x4f\xa1\x33\x44\x8c\x4a\x75\x38\xa5\x69\xb4\xfb\xc1\xf9\xd8\x73\x23\x85\x3d\xd1\x68\xc7\xf8\x7b\xce\x84\x19\x1c\x57\xc9\x82\xeb\x06\x8c\xad\x72\xe1\x6d\x5a\x05\xb8\x10\x01\x89\xa6\x29\x9c\xcf\x3f\xfc\xc1\xbd\x36\x02\x59\x99\x09\x9e\xa9\x18\x1f\xe4\xe0\x59\x78\xb7\x51\xdd\xa5\x07\xe6\xa1\xdc\xbb\xd7\x5c\x00\x4f\xab\x84\x20\x61\x5c\xe4\x1a\x9f\x9e\xc2\x01\xb7\x63\x72\x9d\xb0\xc8\x39\x05\x83\xe0\xaa\x75\x03\x46\xa5\xb8\x51\xbb\x52\x80\x43\xce\x6b\x1f\x1c\x21\x31\xe8\x86\x0f\xc2\x08\xf9\x82\xdc\xb0\x35\x36\xc0\x51\x29\x3c\x5c\x14\x3c\xb9\xff\x4c\x7f\x1d\x3a\xcf\xaa\xcf\x47\xa0\xe8\xee\x9f\x81\x47\xe7\x9e\xf7\xf2\x9c\xb0\xc8\x15\xae\x8d\x8f\x20\x6c\x99\x8c\xfc\xbb\x2e\xfe\xd1\x16\xd6\x5d\x5b\x66\x62\xed\xc5\xe5\x01\xeb\xbc\xe6\xdb\xd7\x5f\xcd\xde\x77\xf3\x07\xae\xf3\xce\xbb\xd6\x85\xfc\x0d\x23\x5b\xe3\xd4\x65\x39\xf4\x95\x69\xdc\x72\x95\x53\x00\xc3\xff\xa4\x72\xb8\x4a\xf9\xee\xfa\xbd\x3b\xdf\x17\x74\xf7\xd6\x6c\x0c\xee\x36\xbe\xaf\x5d\x66\x4b\x75\x4b\x93\xa2\x34\xb5\x22\x5d\x47\xcd\x41\x83\xfa\x83\x8e\xfe\x81\x06\xa1\x37\x74\xab\xb2\x54\x55\xd1\x49\x68\x64\x71\x51\x05\xc4\x51\x99\x88\xc0\x86\xc9\xd8\x17\x23\x2c\x8e\x39\xf1\x73\x20\x24\x09\xd9\x9a\x71\xe9\x03\x65\xe7\xa4\x07\x75\xde\x8c\xc2\x87\x90\xb1\x97\xdb\x36\x03\xa9\x2f\x22\xa9\xe2\x73\x12\xf7\x1f\x11\x30\x3b\x46\xd4\xed\x75\xfa\x76\xa9\x92\x26\x4f\x5d\x26\x0c\x6c\xcb\xb8\x60\x54\x7d\x91\x93\x21\xc7\x16\x09\x64\xd2\x65\x53\x74\x79\x8a\xde\x46\xfc\x89\x1f\x04\xf9\xdf\xc1\x78\xc7\x2b\x86\x4f\xaf\x8e\xc7\xdb\xec\x63\x2d\xb6\x3c\xfe\x1b\xc1\xac\xf5\xf0\x6a\xa8\xb7\xb4\x2c\x6e\xdd\xe3\x17\x4a\xdb\x7f\x9c\x49\x11\x14\xdc\x9a\x9f\xe0\xd8\xab\xe2\xdf\x64\x64\xfb\x10\x3b\xaf\xf2\x33\x7f\x78\xab\xd4\x08\x04\x52\xe2\xcd\x6d\x78\x9a\x0a\xf9\x68\x7b\xab\x36\xf3\x60\xbd\x3b\xa5\xd3\x8d\x12\x68\x0e\xb7\xf6\x25\xb3\xf4\x56\x51\xaf\x52\x19\x6a\xd7\x33\x19\x53\xb9\x60\xd0\x1b\x9e\xce\xa5\x7f\x4b\x08\x5d\x93\x11\x18\x57\x02\x14\xae\xa9\x52\x36\x03\xa8\x8a\x72\x91\x9a\xae\xac\x7c\xf4\xe0\x1a\xe8\xd9\xa6\x7a\x1f\x5a\x95\xed\x29\xc7\x2c\x55\x5b\x4c\x51\xfa\x47\x1b\x99\x58\x5a\x8a\xda\x6c\x78\x06\xd1\x86\xc9\x35\xba\x9a\xae\x70\xb5\xca\xb8\xdf\xab\xa4\x6c\x78\x13\x95\xd5\xce\xa4\x61\x81\x5f\xfb\xcd\x6e\x03\xc0\xd1\xa7\x8b\xcb\x5f\xde\x5d\x9c\xcf\xaf\x8e\x46\xfd\x66\xdf\x81\xba\xc7\x63\x2a\x28\x47\xfd\x66\x7f\xc1\x0d\x5b\x35\xea\x37\xfb\x6c\x6e\xd0\x15\xd8\x6e\xbc\x21\x4e\xa7\xbb\x41\xeb\xe8\xc3\x2d\xeb\xf9\x23\x9b\x13\x1a\x0e\x1f\xe5\x94\x4c\xac\xa9\x28\xe8\x23\xc0\xe8\x2e\xc0\x57\x65\x0f\xb8\xa8\x96\x3f\x09\x2b\x03\x9c\x1e\x6b\x20\x0f\x01\xf6\xa0\x35\x3c\xca\x18\xee\xfa\x0f\x2f\x3c\x8c\xd6\xb2\xfe\xd8\x0b\x36\x04\x27\x02\xbe\xef\xd7\x95\x8f\x96\x2b\x44\x09\xdc\x12\x5c\x31\x06\xf2\x85\xfe\xed\x8f\xdc\xb6\x71\x00\x23\x9a\x84\xd3\xab\x9f\x67\xec\x1f\xe2\xa8\x9c\xe0\x72\x3d\xee\xf7\xca\xf1\x06\x9e\x22\x7b\xdb\x06\x94\xa7\xec\x42\x2a\xb2\xb7\xf5\x15\x77\xba\x58\x34\x47\x43\xc3\x36\xa6\xea\xc9\xd0\xb7\xea\x76\x70\x69\xce\x8d\xb5\xdc\xb1\xe3\xb2\x66\x1e\x28\x1d\x07\x6e\x6f\xf7\xfd\x77\x20\x20\xd7\x7d\xd2\xf5\xf8\x25\x01\x4d\x1d\x20\xea\xf4\xd1\x48\x1e\x37\x54\x8a\x5b\x66\x9f\x27\xcd\xd9\x72\xc8\x1f\x94\xa7\x0d\xdd\xf0\x14\x47\x4d\x20\x77\xc0\x70\x1c\x00\x73\x08\xd7\xa5\xce\x2b\x44\xdd\x43\x1a\x60\x76\x98\xfb\x43\x81\xdd\x71\x0f\x76\x73\x0f\xe9\x69\xbf\x9d\x20\xdb\xdb\xc7\xb3\xac\x16\x37\x45\x6c\xad\x69\x31\xa1\xb7\x94\xfd\xe9\x43\x7d\x01\x2a\xab\xfd\xc2\x50\x0a\x4c\xa7\x4f\x8f\x6f\xab\x67\x3c\x1f\x59\x5b\x6b\x82\x10\x65\xb4\x29\x9d\x81\xb3\x0a\xfe\x07\xfa\x6d\x9b\x36\x18\xa6\xe8\x29\xdb\x3d\x37\xd6\x1e\x7d\xe5\xd2\xdd\xdc\x50\xe3\xa4\xb6\xad\x18\x0d\xd7\xf4\x60\xcc\x51\xc4\xa0\xe8\xff\x43\x28\xc0\xfc\x66\xe8\xdd\x8e\x1e\x96\x51\x73\x26\xf8\x1f\x55\x58\x21\xf3\x26\xa6\x92\x47\x68\x0b\x48\x90\xb9\x17\x62\xab\x20\x63\xc6\x40\x8a\x8c\x1a\x31\xf4\xe8\x5f\x80\xd2\x31\x12\xf3\xaa\x33\x41\x66\xad\xe8\x1f\x31\x34\xbd\x8c\x2b\x1f\x9f\x5c\x41\x92\x51\x6d\xc5\xed\xc8\x37\x1f\xb9\xc9\x04\x2b\x80\x5b\x4a\x42\xfd\xa1\x9a\x96\x5e\x3d\xcb\x92\x99\x9b\x32\x7c\xed\x47\x8e\xaa\x8b\xd1\x8d\x1b\x6e\xe2\x50\xe4\xf0\x85\x7c\x37\x76\xd4\xed\xd9\xb6\x41\xfb\x99\x35\x33\x6d\xbb\xad\xc7\xe9\xbb\x6d\x9e\x7e\xce\xd9\x66\xdb\x34\xfd\x4c\x89\x0e\x37\xe5\x10\xd5\x20\x72\xdf\x6d\x83\xf5\x33\xc1\x66\x9b\x81\xd6\x4d\x54\xdf\xa3\x10\x75\xbc\xec\x6e\x96\xfe\x38\xd9\x09\x64\x74\xf3\x03\x52\xe8\x0d\x16\x94\xaf\x94\x7a\xf5\xe8\x24\x33\x28\x07\xbe\xdc\x60\x71\x7d\x38\xd7\xf2\x10\x6e\xac\xab\x92\xab\x60\x4a\xe5\xdc\x03\x0e\xa4\x92\x82\x4f\x8f\x4f\x81\xff\xd8\x24\x08\xe1\x0f\xf8\xb3\x67\x61\xcf\xe6\xfc\x17\x7e\x1d\xbc\x42\x40\x4d\x6b\xc3\x2f\xfc\xba\xae\x02\x1b\x76\x55\xae\x39\xed\xf7\xee\xfa\x77\xfd\xff\x1d\x00\x7a\xdd\x6b\x83\xde\x25\x00\x00")
 
 func call_tracerJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -133,7 +133,7 @@ func call_tracerJs() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "call_tracer.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x46, 0x79, 0xb6, 0xbc, 0xd2, 0xc, 0x25, 0xb1, 0x22, 0x56, 0xef, 0x77, 0xb9, 0x5e, 0x2e, 0xf4, 0xda, 0xb2, 0x2f, 0x53, 0xa4, 0xff, 0xc8, 0xac, 0xbb, 0x75, 0x22, 0x46, 0x59, 0xe3, 0x1d, 0x7d}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9d, 0x8c, 0x4f, 0x9b, 0xc6, 0x60, 0x3c, 0x2f, 0x58, 0xe5, 0x36, 0x4a, 0x3, 0xdd, 0x8f, 0x87, 0xc5, 0x36, 0xcb, 0xe9, 0xba, 0xe5, 0x14, 0x1, 0xc6, 0x5c, 0xa2, 0x71, 0x99, 0x60, 0xcc, 0xc}}
 	return a, nil
 }
 
@@ -197,7 +197,7 @@ func opcount_tracerJs() (*asset, error) {
 	return a, nil
 }
 
-var _prestate_tracerJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\xdd\x6f\xdb\x38\x12\x7f\xb6\xfe\x8a\x41\x5f\x6c\x5d\x5d\xb9\xcd\x02\x7b\x80\x73\x39\x40\x75\xdd\x36\x40\x36\x09\x6c\xe7\x72\xb9\xc5\x3e\x50\xe4\x48\xe6\x9a\x26\x05\x92\xb2\xe3\x2b\xf2\xbf\x1f\x86\xfa\xf0\x47\x93\xa6\x7b\x6f\x16\x39\xfc\xcd\xf7\x6f\xc6\xa3\x11\x4c\x4c\xb9\xb3\xb2\x58\x7a\x38\x7b\xff\xe1\xef\xb0\x58\x22\x14\xe6\x1d\xfa\x25\x5a\xac\xd6\x90\x56\x7e\x69\xac\x8b\x46\x23\x58\x2c\xa5\x83\x5c\x2a\x04\xe9\xa0\x64\xd6\x83\xc9\xc1\x9f\xc8\x2b\x99\x59\x66\x77\x49\x34\x1a\xd5\x6f\x9e\xbd\x26\x84\xdc\x22\x82\x33\xb9\xdf\x32\x8b\x63\xd8\x99\x0a\x38\xd3\x60\x51\x48\xe7\xad\xcc\x2a\x8f\x20\x3d\x30\x2d\x46\xc6\xc2\xda\x08\x99\xef\x08\x52\x7a\xa8\xb4\x40\x1b\x54\x7b\xb4\x6b\xd7\xda\xf1\xe5\xfa\x0e\xae\xd0\x39\xb4\xf0\x05\x35\x5a\xa6\xe0\xb6\xca\x94\xe4\x70\x25\x39\x6a\x87\xc0\x1c\x94\x74\xe2\x96\x28\x20\x0b\x70\xf4\xf0\x33\x99\x32\x6f\x4c\x81\xcf\xa6\xd2\x82\x79\x69\xf4\x10\x50\x92\xe5\xb0\x41\xeb\xa4\xd1\xf0\x4b\xab\xaa\x01\x1c\x82\xb1\x04\x32\x60\x9e\x1c\xb0\x60\x4a\x7a\x17\x03\xd3\x3b\x50\xcc\xef\x9f\xfe\x44\x40\xf6\x7e\x0b\x90\x3a\xa8\x59\x9a\x12\xc1\x2f\x99\x27\xaf\xb7\x52\x29\xc8\x10\x2a\x87\x79\xa5\x86\x84\x96\x55\x1e\xee\x2f\x17\x5f\x6f\xee\x16\x90\x5e\x3f\xc0\x7d\x3a\x9b\xa5\xd7\x8b\x87\x73\xd8\x4a\xbf\x34\x95\x07\xdc\x60\x0d\x25\xd7\xa5\x92\x28\x60\xcb\xac\x65\xda\xef\xc0\xe4\x84\xf0\xdb\x74\x36\xf9\x9a\x5e\x2f\xd2\x8f\x97\x57\x97\x8b\x07\x30\x16\x3e\x5f\x2e\xae\xa7\xf3\x39\x7c\xbe\x99\x41\x0a\xb7\xe9\x6c\x71\x39\xb9\xbb\x4a\x67\x70\x7b\x37\xbb\xbd\x99\x4f\x13\x98\x23\x59\x85\xf4\xfe\xf5\x98\xe7\x21\x7b\x16\x41\xa0\x67\x52\xb9\x36\x12\x0f\xa6\x02\xb7\x34\x95\x12\xb0\x64\x1b\x04\x8b\x1c\xe5\x06\x05\x30\xe0\xa6\xdc\xfd\x74\x52\x09\x8b\x29\xa3\x8b\xe0\xf3\x8b\x05\x09\x97\x39\x68\xe3\x87\xe0\x10\xe1\x1f\x4b\xef\xcb\xf1\x68\xb4\xdd\x6e\x93\x42\x57\x89\xb1\xc5\x48\xd5\x70\x6e\xf4\xcf\x24\x22\xcc\xd2\xa2\xf3\xcc\xe3\xc2\x32\x8e\x16\x4c\xe5\xcb\xca\x3b\x70\x55\x9e\x4b\x2e\x51\x7b\x90\x3a\x37\x76\x1d\x2a\x05\xbc\x01\x6e\x91\x79\x04\x06\xca\x70\xa6\x00\x1f\x91\x57\xe1\xae\x8e\x74\x28\x57\xcb\xb4\x63\x3c\x9c\xe6\xd6\xac\xc9\xd7\xca\x79\xfa\xe1\x1c\xae\x33\x85\x02\x0a\xd4\xe8\xa4\x83\x4c\x19\xbe\x4a\xa2\x6f\x51\xef\xc0\x18\xaa\x93\xe0\x61\x23\x14\x6a\x63\x8b\x7d\x8b\x90\x55\x52\x09\xa9\x8b\x24\xea\xb5\xd2\x63\xd0\x95\x52\xc3\x28\x40\x28\x63\x56\x55\x99\x72\x6e\xaa\x60\xfb\x9f\xc8\x7d\x0d\xe6\x4a\xe4\x32\xa7\xe2\x60\xdd\xad\x37\xe1\xaa\xd3\x6b\x32\x92\x4f\xa2\xde\x11\xcc\x18\xf2\x4a\x07\x77\x06\x4c\x08\x3b\x04\x91\xc5\xdf\xa2\x5e\x6f\xc3\x2c\x61\xc1\x05\x78\xf3\x15\x1f\xc3\x65\x7c\x1e\xf5\x7a\x32\x87\x81\x5f\x4a\x97\xb4\xc0\xbf\x33\xce\xff\x80\x8b\x8b\x8b\xd0\xd4\xb9\xd4\x28\x62\x20\x88\xde\x73\x62\xf5\x4d\x2f\x63\x8a\x69\x8e\x63\xe8\xbf\x7f\xec\xc3\x5b\x10\x59\x52\xa0\xff\x58\x9f\xd6\xca\x12\x6f\xe6\xde\x4a\x5d\x0c\x3e\xfc\x1a\x0f\xc3\x2b\x6d\xc2\x1b\x68\xc4\xaf\x4d\x27\x5c\xdf\x73\x23\xc2\x75\x63\x73\x2d\x35\x31\xa2\x11\x6a\xa4\x9c\x37\x96\x15\x38\x86\x6f\x4f\xf4\xfd\x44\x5e\x3d\x45\xbd\xa7\xa3\x28\xcf\x6b\xa1\x17\xa2\xdc\x40\x00\x6a\x6f\xbb\x3a\x2f\x24\x75\xea\x61\x02\x02\xde\x8f\x92\x30\x6f\x4d\x39\x49\xc2\x0a\x77\xaf\x67\x82\x2e\xa4\x78\xec\x2e\x56\xb8\x8b\xcf\xa3\x17\x53\x94\x34\x46\xff\x2e\xc5\xe3\xcf\xe6\xeb\xe4\xcd\x51\x5c\xe7\x24\xb5\xb7\x37\x8e\x4f\xe2\x68\xd1\x55\xca\x53\xb9\x4b\xbd\x31\x2b\x22\xae\x25\xc5\x47\xa9\x10\x12\x53\x52\xb6\x5c\xcd\x1c\x19\xa2\x06\xe9\xd1\x32\xa2\x4e\xb3\x41\x4b\x53\x03\x2c\xfa\xca\x6a\xd7\x85\x31\x97\x9a\xa9\x16\xb8\x89\xba\xb7\x8c\xd7\x3d\x53\x9f\x1f\xc4\x92\xfb\xc7\x10\xc5\xe0\xdd\x68\x04\xa9\x07\x72\x11\x4a\x23\xb5\x1f\xc2\x16\x41\x23\x0a\x6a\x7c\x81\xa2\xe2\x3e\xe0\xf5\x37\x4c\x55\xd8\xaf\x9b\x9b\x28\x32\x3c\x35\x15\x4d\x82\x83\xe6\x1f\x06\x03\xd7\x66\x13\x46\x5c\xc6\xf8\x0a\x9a\x86\x33\x56\x16\x52\x47\x4d\x38\x8f\x9a\x8d\x2c\x4a\x08\x38\x98\x15\x72\x45\x49\xa4\x93\x8f\x4c\xc1\x05\x64\xb2\xb8\xd4\xfe\x24\x79\x75\xd0\xdb\xa7\xf1\x1f\x49\xd3\x3c\x89\x23\xc2\x1b\x9c\xc5\x43\xf8\xf0\x6b\x57\x11\xde\x10\x14\xbc\x0e\xe6\xcd\xcb\x50\xd1\x69\x31\x3c\xff\x2c\xa8\xa1\x0e\x7e\x1b\xb4\x26\xae\xca\x28\x1d\xb5\x9f\x21\x8e\xc7\x5d\x7c\xfe\x03\xdc\x63\xdf\x5a\xdc\x26\x34\x09\x13\xe2\x10\x94\x3e\xc3\x77\xc1\xdc\x9d\x43\x01\x6f\x81\xbe\xa4\x26\x55\x4e\xf2\x2f\xcc\xc5\xf0\x37\x68\x24\x6e\xad\xe4\xdf\x59\x52\xe7\xf5\x13\x72\x8b\x6b\x1a\x05\x94\x3a\xce\x94\x42\xdb\x77\x10\x88\x66\xd8\xd4\x60\x48\x32\xae\x4b\xbf\x6b\x07\x84\x67\xb6\x40\xef\x5e\xf7\x26\xe0\xbc\x7b\xd7\xf2\x66\x88\xdf\xae\x44\xb8\xb8\x80\xfe\x64\x36\x4d\x17\xd3\x7e\xd3\x7b\xa3\x11\xdc\x63\x58\x9f\x32\x25\x33\xa1\x76\x20\x50\xa1\xc7\xda\x2e\xa3\x43\x5c\x3b\x1e\x19\xd2\x1e\x44\x1b\x0a\x3e\x4a\xe7\xa5\x2e\xa0\xa6\x97\x2d\x0d\xe3\x06\x2e\x34\x16\x67\x15\x85\xe7\x74\x72\x79\x43\x6b\x88\x45\x22\x23\x1a\x1a\xa1\x47\x99\x92\xdd\xda\x92\x4b\xeb\x3c\x94\x8a\x71\x4c\x08\xaf\x33\xe6\xe5\xa2\x68\xda\x9f\x54\xcf\x42\xdf\x06\xa0\xfd\x54\x64\x8a\xa6\x2a\xa9\x77\x30\x68\x31\xe2\xa8\xd7\xb3\xad\xf4\x01\xf6\xf9\x9e\x47\x9c\xc7\xf2\x90\x45\x68\x1b\xc1\x0d\x12\xef\x06\x0a\xa9\x27\x28\xe9\xfa\xd7\x6f\xcd\xc8\x46\x97\x44\x3d\x7a\x77\x40\x06\xca\x14\xc7\x64\x20\xea\xb0\xf0\xca\x5a\xca\x7f\xc7\xdb\x39\x11\xc3\x9f\x95\xf3\x14\x53\x4b\xe1\x69\x28\xe6\x39\x66\x0d\x3c\x4a\x23\x3a\xfe\x9e\x41\x69\xd8\x85\xe1\x42\xea\x9a\xd1\x56\xaf\x80\xa5\xf1\xa8\xbd\x64\x4a\xed\x28\x0f\x5b\x4b\xbb\x0f\x6d\x3b\x43\x70\x92\xa4\x02\x4d\x05\x51\xa9\xb9\xaa\x44\x5d\x06\xa1\xf8\x1b\x3c\x17\x6c\x3e\x5e\x9a\xd6\xe8\x1c\x2b\x30\xa1\x4a\xca\xe5\x63\xb3\x76\x6a\xe8\xd7\xcc\x38\x88\xfb\x49\x67\xe4\x31\x2f\x29\x53\x24\x6d\x91\x11\xb7\xa7\x42\x58\x74\x6e\x10\x37\x44\xd5\x65\xf6\x7e\x89\x9a\x82\x0f\x1a\xb7\xd0\xed\x33\x8c\x73\xda\xef\xc4\x10\x98\x10\xc4\x87\x27\xbb\x47\xd4\xeb\xb9\xad\xf4\x7c\x09\x41\x93\x29\xf7\xbd\x18\x37\xf5\xcf\x99\x43\x78\x33\xfd\xf7\x62\x72\xf3\x69\x3a\xb9\xb9\x7d\x78\x33\x86\xa3\xb3\xf9\xe5\x7f\xa6\xdd\xd9\xc7\xf4\x2a\xbd\x9e\x4c\xdf\x8c\xc3\x40\x7f\xc6\x21\x6f\x5a\x17\x48\xa1\xf3\x8c\xaf\x92\x12\x71\x35\x78\x7f\xcc\x03\x7b\x07\x7b\xbd\xcc\x22\x5b\x9d\xef\x8d\xa9\x1b\xb4\xd1\xd1\xf2\x34\x5c\xc0\x8b\xc1\x3a\x7f\xd9\x9a\x49\x23\x3f\x68\xd9\x7f\xbf\xbf\x04\xaa\x78\xdd\x8e\xb3\xbf\x6c\x48\xe8\x1d\xc6\x57\x63\x70\x4c\xd1\xda\x2c\xff\x4b\x7f\x77\xf2\xdc\xa1\x1f\x02\x6a\x61\xb6\xc4\x7c\x1d\x6a\x7d\xd3\xe0\x1e\x84\xec\x43\x5c\xd3\xee\x4d\x3e\x88\x3b\x61\x02\xfb\x5e\xf4\xec\x39\x51\xd4\x02\x2e\x5a\xf4\xb7\xe1\xe5\xeb\x81\x3a\x6b\x22\x75\xa2\xe0\x97\x93\xb5\x30\xdc\xaf\x71\x6d\xec\xae\x99\x61\x07\xfe\xfd\x38\xaa\xe9\xd5\x55\x57\x4f\xf4\x41\x45\xd6\x1d\x7c\x9a\x5e\x4d\xbf\xa4\x8b\xe9\x91\xd4\x7c\x91\x2e\x2e\x27\xf5\xd1\x5f\x2e\xbc\x0f\x3f\x5d\x78\xfd\xf9\x7c\x71\x33\x9b\xf6\xc7\xcd\xd7\xd5\x4d\xfa\xa9\xff\x9d\xc2\x66\x75\xfc\x51\xeb\x7a\x73\x6f\xac\xf8\x7f\x3a\xe0\x60\x8d\xcb\xd9\x73\x5b\x5c\xa0\x76\xee\xab\x93\x7f\x49\xc0\x74\xcb\xca\x79\xfd\x4f\xb1\x17\xde\x3f\xcb\xc3\x4f\xd1\x53\xf4\xbf\x00\x00\x00\xff\xff\x3a\xb7\x37\x41\xbf\x10\x00\x00")
+var _prestate_tracerJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\xdf\x6f\x1b\x37\xf2\x7f\xde\xfd\x2b\xa6\x7d\xa8\xa4\x6f\xd4\x55\x92\x02\xfd\x02\x76\x75\x80\xe2\x28\xa9\x01\xd7\x0e\x2c\xe5\x72\xb9\x20\x0f\x14\x39\xab\x65\x4d\x91\x0b\x92\x6b\x49\x2d\xfc\xbf\x1f\x86\xcb\x5d\xad\x64\xc9\xf1\x5d\xef\xe1\xf2\x64\x2d\x87\x9f\xf9\xcc\x70\x38\x3f\x98\xd1\x08\x2e\x4c\xb9\xb5\x72\x59\x78\x78\xfd\xf2\xd5\xff\xc3\xbc\x40\x58\x9a\x1f\xd1\x17\x68\xb1\x5a\xc1\xa4\xf2\x85\xb1\x2e\x1d\x8d\x60\x5e\x48\x07\xb9\x54\x08\xd2\x41\xc9\xac\x07\x93\x83\x3f\x90\x57\x72\x61\x99\xdd\x66\xe9\x68\x54\xef\x39\xba\x4c\x08\xb9\x45\x04\x67\x72\xbf\x66\x16\xcf\x60\x6b\x2a\xe0\x4c\x83\x45\x21\x9d\xb7\x72\x51\x79\x04\xe9\x81\x69\x31\x32\x16\x56\x46\xc8\x7c\x4b\x90\xd2\x43\xa5\x05\xda\xa0\xda\xa3\x5d\xb9\x86\xc7\xfb\xeb\x8f\x70\x85\xce\xa1\x85\xf7\xa8\xd1\x32\x05\x1f\xaa\x85\x92\x1c\xae\x24\x47\xed\x10\x98\x83\x92\xbe\xb8\x02\x05\x2c\x02\x1c\x6d\x7c\x47\x54\x66\x91\x0a\xbc\x33\x95\x16\xcc\x4b\xa3\x87\x80\x92\x98\xc3\x3d\x5a\x27\x8d\x86\x9f\x1a\x55\x11\x70\x08\xc6\x12\x48\x9f\x79\x32\xc0\x82\x29\x69\xdf\x00\x98\xde\x82\x62\x7e\xb7\xf5\x19\x0e\xd9\xd9\x2d\x40\xea\x60\x5e\x61\x4a\x04\x5f\x30\x4f\x9e\x58\x4b\xa5\x60\x81\x50\x39\xcc\x2b\x35\x24\xb4\x45\xe5\xe1\xd3\xe5\xfc\xd7\x9b\x8f\x73\x98\x5c\x7f\x86\x4f\x93\xdb\xdb\xc9\xf5\xfc\xf3\x39\xac\xa5\x2f\x4c\xe5\x01\xef\xb1\x86\x92\xab\x52\x49\x14\xb0\x66\xd6\x32\xed\xb7\x60\x72\x42\xf8\x6d\x7a\x7b\xf1\xeb\xe4\x7a\x3e\x79\x73\x79\x75\x39\xff\x0c\xc6\xc2\xbb\xcb\xf9\xf5\x74\x36\x83\x77\x37\xb7\x30\x81\x0f\x93\xdb\xf9\xe5\xc5\xc7\xab\xc9\x2d\x7c\xf8\x78\xfb\xe1\x66\x36\xcd\x60\x86\xc4\x0a\x69\xff\xb7\x7d\x9e\x87\xd3\xb3\x08\x02\x3d\x93\xca\x35\x9e\xf8\x6c\x2a\x70\x85\xa9\x94\x80\x82\xdd\x23\x58\xe4\x28\xef\x51\x00\x03\x6e\xca\xed\xb3\x0f\x95\xb0\x98\x32\x7a\x19\x6c\x3e\x19\x90\x70\x99\x83\x36\x7e\x08\x0e\x11\x7e\x29\xbc\x2f\xcf\x46\xa3\xf5\x7a\x9d\x2d\x75\x95\x19\xbb\x1c\xa9\x1a\xce\x8d\xfe\x96\xa5\x84\x59\x5a\x74\x9e\x79\x9c\x5b\xc6\xd1\x82\xa9\x7c\x59\x79\x07\xae\xca\x73\xc9\x25\x6a\x0f\x52\xe7\xc6\xae\x42\xa4\x80\x37\xc0\x2d\x32\x8f\xc0\x40\x19\xce\x14\xe0\x06\x79\x15\xd6\x6a\x4f\x13\x31\x6f\x99\x76\x8c\x87\xaf\xb9\x35\x2b\xb2\xb5\x72\x9e\xfe\x70\x0e\x57\x0b\x85\x02\x96\xa8\xd1\x49\x07\x0b\x65\xf8\x5d\x96\xfe\x99\x26\x1d\x32\x74\x71\x08\xa8\x11\x0a\xb1\xb1\xc6\x9e\x45\x58\x54\x52\x09\xa9\x97\x59\x9a\x34\xd2\x67\xa0\x2b\xa5\x86\x69\x80\x50\xc6\xdc\x55\xe5\x84\x73\x53\x05\xee\xbf\x23\xf7\x04\x80\xe0\x4a\xe4\x32\xa7\xe0\x60\xed\xaa\x37\x61\xa9\xd5\x6b\x16\x24\x9f\xa5\xc9\x1e\xcc\x19\xe4\x95\x0e\xe6\xf4\x99\x10\x76\x08\x62\x31\xf8\x33\x4d\x92\x7b\x66\x81\x71\x0e\x63\xf0\xe6\x57\xdc\x84\xc5\xc1\x79\x9a\x24\x32\x87\xbe\x2f\xa4\xcb\x1a\xe0\x2f\x8c\xf3\xaf\x30\x1e\x8f\xc3\xa5\xce\xa5\x46\x31\x00\x82\x48\x8e\x89\xd5\x2b\xc9\x82\x29\xa6\x39\x9e\x41\xef\xe5\xa6\x07\x2f\x40\x2c\xb2\x25\xfa\x37\xf5\xd7\x5a\x59\xe6\xcd\xcc\x5b\xa9\x97\xfd\x57\x3f\x0f\x86\x61\x97\x36\x61\x0f\x44\xf1\x6b\xd3\x0a\xd7\xeb\xdc\x88\xb0\x1c\x39\xd7\x52\x17\x46\x44\xa1\x28\xe5\xbc\xb1\x6c\x89\x67\xf0\xe7\x03\xfd\x7e\x20\xab\xc8\xbd\x13\x21\x82\xc7\x70\xe3\x51\x0b\xba\x68\xc6\xae\x0a\xa3\x90\x72\x26\x2a\x51\xbb\xba\x71\x70\xc1\x1c\x38\xf4\xb4\x97\x7c\x85\x1b\x6f\x19\x8c\x23\xb3\x4f\xcd\xce\xe8\xe5\x9d\xfb\x12\xba\x4c\x7d\xda\x71\x87\x5b\x4a\x12\x61\x63\x74\xd8\x11\x8f\x7d\xb9\xc3\xed\x57\x18\xd7\x62\xe1\x47\x40\x21\xe6\x0f\x69\xf2\x10\x23\xc3\x75\x54\x46\x27\x3a\x30\xf7\x68\xad\x14\x58\xf3\x8e\x1e\x0f\xa9\x96\xed\xa2\xb1\x31\xa7\xb9\x79\x01\xce\x68\x74\x60\xb1\x34\x96\x32\xd9\x02\x73\x63\xe9\x56\xec\xfc\x61\x4a\xb4\xe1\xd6\x64\x69\x72\x4c\x77\x37\xaa\x38\x1f\x02\x2f\x98\x5e\x62\x6d\x25\xe3\x3c\x8b\x64\x60\x1c\x57\xb2\x5a\x07\x99\x26\x50\x21\xdd\x41\xce\xb3\x52\xa1\x58\xa2\x88\xa0\x07\x8b\xb8\xa9\xb7\xda\xce\x32\xc5\x66\x04\xdc\xdf\xfb\x26\xc0\xc3\x77\xe3\x31\x05\xdc\xcb\x5e\xf4\xf7\x63\x25\x3b\x46\xc7\x00\x88\xc2\xc3\xbe\x9e\x43\x1a\x4f\x68\x3a\x14\xdd\xe9\x3a\x0e\x72\xbe\x7f\xc6\xf5\xb5\x9d\xd5\xc1\x7b\xe2\xf6\xc7\xd0\x06\xd4\xde\xb6\xf9\x77\x29\xa9\x82\x34\x07\x2d\xb5\x37\x01\xef\xa9\xe4\x10\xb5\x3c\x4a\x0e\x77\xb8\xfd\x76\x86\xa0\xe0\x96\x62\xd3\x2e\xdc\xe1\x76\x70\x9e\x46\xb7\x3d\x8e\xf0\x2c\x92\xfe\x22\xc5\xe6\xb9\x79\xe4\x60\x4f\x54\x54\xdf\xbd\x19\x19\xb4\xe3\x3b\x18\x1c\xf8\xd1\xa2\xab\x94\xa7\x34\x2c\xf5\xbd\xb9\xa3\x7b\x5e\x90\x7f\x94\x0a\xde\x32\x25\x65\x11\x57\x57\xb4\x05\xa2\x06\xe9\x29\xd8\x51\x84\x0b\x45\xdd\x0c\x58\xf4\x95\xd5\xae\x75\x63\x2e\x35\x53\x0d\x70\xf4\xba\xb7\x8c\xd7\xb9\xbc\xfe\xde\xf1\x25\xf7\x9b\xe0\xc5\x60\x1d\xb5\x58\xbb\x9a\xe2\xc0\x56\x5a\x4b\xbd\x04\x6d\x22\x15\x60\x3e\x90\xd3\x48\xea\xa5\x96\x5e\x32\x25\xff\x40\xb1\x97\xde\x8f\xb9\x37\x38\x93\xea\xc7\x31\x3f\x52\x2a\x7e\x88\xae\xa9\x57\xf6\xea\x42\x9f\xfb\x4d\xe6\x4d\xe0\xb9\x7f\x78\x6d\x16\x98\x6f\x22\x2e\xe5\xce\x4e\x72\xe8\x96\xc8\x95\xb9\x47\x07\xd2\x3b\xb8\x67\xaa\xc2\xa6\x2b\x6a\xf3\x07\x2d\xa1\xca\x87\x11\xc6\x68\xb5\x0d\x66\x2d\x99\x03\x8d\x28\x1c\x95\xe5\x05\x42\xc9\xa4\x80\x05\xe3\x77\x10\xcb\x9a\xb1\x72\x29\x75\x9a\x9c\xe4\x4e\xc5\x79\xc7\x3e\x44\x2b\x7d\x7a\xc3\x14\x8c\x61\x21\x97\x97\xda\x1f\xd4\xb1\x3a\x88\x9a\xbd\x83\xaf\x4d\x96\xca\x1c\x35\x16\xfd\xd7\x83\x21\xbc\xfa\x39\x44\xd3\x11\x67\xcc\xa8\x64\x58\xf8\xee\x48\xf8\x06\xdd\xae\x5e\x1f\xc3\xb1\x6d\xe7\xbb\xe4\x7f\x2c\x9b\x7e\x83\x27\x35\x44\x84\x52\x53\x4b\x76\x56\xc6\xbf\x32\x57\x2d\x28\x1a\x7d\x3f\x9a\x5d\x8b\x67\x2c\xf7\x68\xf7\x8d\x1b\x64\x4c\x88\x03\xb1\x3a\x35\x1f\xc8\xb5\x45\x28\x79\x9a\x5b\x27\xd3\x53\xa5\x7f\xd1\x50\x22\x35\x41\x6c\xc9\xdc\x47\x87\x02\x5e\x00\xfd\x92\x9a\x2a\xbe\x93\xfc\x3d\x73\x03\xf8\x3f\x88\x12\x1f\xac\xe4\xb8\xdf\x10\x10\x81\x07\x40\xe5\x70\x17\x83\x3e\x38\x17\x4a\x23\xb5\x1f\xc2\x1a\x43\x04\x51\xc0\x08\x14\x15\xa7\x55\x84\x5e\x88\xc3\x1e\x10\x11\xfa\xd0\x04\x5e\xe5\xd1\x76\x23\x77\x18\x2e\x3a\x85\x2f\x35\xee\xff\x3b\x91\x47\x68\xde\x10\x16\x7c\x1b\xcd\x9b\xd3\x58\x4f\x9d\x5d\x77\x1f\x40\x73\x76\xde\xec\x05\x13\x09\x06\x67\x3e\x3e\x98\xbf\x10\x14\x1d\xd4\xff\x4e\x8c\xd0\xed\x1f\x8d\xe0\x2d\x72\x8b\x2b\xea\xf9\xe9\x0c\x39\x53\x0a\x6d\xcf\x41\xe8\x28\x87\x31\xa9\x87\xd3\xc6\x55\xe9\xb7\xcd\x24\xe0\x99\x5d\xa2\x77\xe9\x37\x2d\x0a\x38\x3f\xfe\xd8\x36\x21\x94\x3c\xb7\x25\x02\xb5\x01\x17\xb7\xd3\xc9\x7c\xda\x74\x02\xa3\x11\x7c\x22\x02\x1a\x16\x4a\x2e\x84\xda\x42\x6c\x6a\x02\x2f\xa3\xc3\x4d\x6d\xd3\xfa\x90\x06\x5e\x1a\x45\x71\x23\x9d\xa7\xba\x10\x3e\xc3\x9a\xa6\xae\x08\x17\x2a\x15\x67\x95\x8b\x25\xa1\x9b\x7f\xeb\xec\x69\x91\x5a\x7f\x9a\x0e\x42\xd1\x63\x4a\xb6\xf3\x69\x2e\xad\xf3\x50\x2a\xc6\x31\x4b\x93\xb6\xc3\x3a\x65\x2e\x45\x46\x53\x34\x46\x23\xb8\x0d\x85\x30\x00\xed\xc6\x1f\xa6\x68\x7c\xa2\x4b\xe4\xa0\xdf\x60\x0c\xd2\x24\xb1\x8d\x74\x07\xfb\x7c\x57\x98\x9d\xc7\xb2\x5b\x96\xa9\x53\xa6\x9a\xb7\x6d\x0a\x61\x18\x95\x48\xd7\xdf\x7f\x8b\xb3\x19\x3a\x6a\x40\x3d\x96\x9d\xea\xaa\xcc\x72\xaf\xba\x36\x6d\x3d\xaf\xac\xa5\xf3\x6f\x1b\xa1\x9c\x52\xc4\xef\x95\xf3\xe0\x3c\x0b\x9d\x6e\xac\xd9\xdd\x6a\xf7\xb8\x96\x3e\x51\x4a\xc9\x8a\x98\xb4\xeb\x59\xbf\x34\x1e\x35\xd5\x6b\xb5\xa5\x73\x58\x5b\x1a\x72\x69\xac\x1d\x82\x93\x24\x45\xbe\xa8\x45\xa5\xe6\xaa\x12\xf4\x05\xeb\x5a\x19\xf1\x5c\xe0\xbc\x3f\x1d\xaf\xd0\x39\xb6\xc4\x8c\x22\x29\x97\x9b\xf8\xbe\xa0\xa1\x57\xb7\x1a\xfd\x41\x2f\x3b\x91\x9f\x94\x59\x66\x4d\x90\x51\xb3\x34\x11\xc2\xa2\x73\xfd\x41\x4c\x58\xed\xc9\x7e\x2a\xb0\x6e\x38\x34\xae\x63\xcc\x49\x47\xfd\x1e\x0d\xf2\x62\x08\x4c\x08\x4a\x8c\x31\x27\x36\x9e\x48\x93\xc4\xad\xa5\xe7\x05\x04\x4d\xa6\xdc\xdd\xc7\x41\x8c\x7f\xce\x1c\xc2\xf7\xd3\x7f\xcc\x2f\x6e\xde\x4e\x2f\x6e\x3e\x7c\xfe\xfe\x0c\xf6\xbe\xcd\x2e\xff\x39\x6d\xbf\xbd\x99\x5c\x4d\xae\x2f\xa6\xdf\x9f\xa5\xc9\x71\x83\xbc\x69\x4c\x20\x85\xce\x33\x7e\x97\x95\x88\x77\xfd\x97\xfb\xb9\x60\x67\x60\x92\x2c\x2c\xb2\xbb\xf3\x1d\x99\xfa\x82\x46\x1d\x4d\xba\x86\x31\x9c\x74\xd6\xf9\x69\x36\x17\x51\xbe\xdf\x54\x81\xdd\xa0\x4a\x5f\x9e\xc1\xe3\xf5\xbf\x4d\x84\xa2\x84\x0c\x3f\x03\xc7\x14\xbd\x8f\xc8\x3f\x70\x08\x26\xcf\x1d\xfa\x21\xa0\x16\x66\x4d\x99\xaf\x45\xad\x57\x22\x6e\xc7\x65\xaf\x06\x59\x88\xbc\x9b\xbc\x3f\x68\x85\x9d\xfc\x03\x1f\x8b\xbe\x3e\x26\x8a\x5a\xc0\x38\xea\x85\x17\x81\xc6\xb7\x1d\xf5\x3a\x7a\xea\x40\xc1\x4f\xfb\xc7\x37\x0c\x5c\x57\xb8\x32\x76\x1b\x2b\x59\xc7\xbe\xa7\xbd\x3a\xb9\xba\x6a\xe3\xe9\x62\x72\x75\x45\x81\xd7\x7e\x78\x3b\xbd\x9a\xbe\x9f\xcc\xa7\x7b\x52\xb3\xf9\x64\x7e\x79\x51\x7f\x3a\x6d\x41\x73\x0a\x07\xcc\x5f\x3d\x3b\xf0\x7a\xb3\xd9\xfc\xe6\x76\xda\x3b\x8b\xbf\xae\x6e\x26\x6f\x7b\x8f\x14\xc6\x59\xec\xa9\xab\xeb\xcd\x27\x63\xc5\x7f\x72\x03\x3a\x73\x51\xce\x8e\x8d\x45\x94\x6e\x18\xf7\xd5\xc1\x73\x18\x30\xdd\x64\xe5\xbc\x7e\x12\x4c\x72\xb6\x3f\xe5\xec\xf2\x70\xa3\xa1\xed\x7a\x8f\x67\x79\xcd\xbc\xbc\xc7\x63\xaf\x0c\x43\x58\x17\x92\x17\x34\x1b\x51\xc9\x0e\x74\xe3\x9c\x96\x85\xf7\xee\xd8\x50\x50\xa9\x14\xa0\x73\x0f\x66\xad\xd1\xd2\xe0\x11\x9f\x31\xe8\xb1\xda\xac\x9a\xa7\x8c\xbd\x11\x24\xa0\x31\x8b\x7b\x89\xac\x99\xe2\x62\xb1\x08\x48\xde\x54\xbc\x40\x91\xa5\x49\x4b\xb1\x63\xae\x29\x77\x55\xe7\x2f\x4c\x62\x74\x8f\xbc\x29\xaf\xf0\x1e\xa9\x5d\x34\x65\x26\xb0\xf4\x05\x35\x12\x2f\x9b\xee\xa2\x59\xef\x82\xb5\x94\xe6\x61\xf0\xb6\x55\xfb\x58\xd1\x3e\x38\x49\x20\x0c\x90\xf0\x0b\x98\xb2\xe9\xc1\x5c\xa6\x50\x2f\x7d\x71\x0e\xf2\xc5\x8b\x08\x48\x1c\xea\x07\x09\x18\x77\x45\xbf\xc8\xaf\x4d\x95\x9b\xb7\x8d\x70\x3d\x9a\x91\x12\x9a\xf2\x68\x6e\xab\x9d\x3c\x8c\xf3\x70\x7f\xb0\x73\x62\x2d\x95\x26\xfb\x66\xc0\x0f\x3f\x34\x0f\x21\x34\xb5\xa3\x73\x64\xad\x29\xeb\xde\xaa\xfb\x32\x76\x38\x6d\x35\x0f\x28\xe7\xf1\xf5\x4f\x7b\xa9\x6b\xc3\x83\xe5\x8f\xcf\xe1\xcb\xbe\x9e\xe3\xcf\x0d\x4f\xdf\xf6\x7d\x84\xee\xa5\x7a\xee\xfc\x76\xc0\xa1\x7d\x1d\x6b\x79\x3f\x71\x6a\x3a\xf7\xcf\x39\x31\x12\x6b\x4e\xeb\xa4\x13\x74\xee\xbf\x1e\x1f\x58\x1f\x7b\x92\xfe\x9f\x41\x6a\xea\x91\x08\x1a\x84\x14\xba\xe7\xeb\xa6\xf4\xc4\xa5\xda\x7b\x25\xa3\x93\x84\xf8\x24\xf6\xcc\x7f\xbd\xd3\x64\x9e\x73\x3e\x3a\xf7\x9d\xb3\x39\x69\x7f\x16\x12\xc5\xee\x25\x8e\x88\x9e\xa7\x49\xf2\x90\x26\x0f\xe9\x43\xfa\xaf\x01\x00\x57\xa9\x06\xf8\x5e\x1b\x00\x00")
 
 func prestate_tracerJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -213,7 +213,7 @@ func prestate_tracerJs() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "prestate_tracer.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd4, 0x9, 0xf9, 0x44, 0x13, 0x31, 0x89, 0xf7, 0x35, 0x9a, 0xc6, 0xf0, 0x86, 0x9d, 0xb2, 0xe3, 0x57, 0xe2, 0xc0, 0xde, 0xc9, 0x3a, 0x4c, 0x4a, 0x94, 0x90, 0xa5, 0x92, 0x2f, 0xbf, 0xc0, 0xb8}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe7, 0x36, 0xfa, 0x37, 0x68, 0x60, 0xa1, 0x7e, 0xb5, 0xb1, 0xcc, 0xfc, 0x13, 0xcb, 0x46, 0xd6, 0xc6, 0x14, 0xa4, 0x8a, 0x80, 0xfb, 0xa0, 0x8f, 0x35, 0x80, 0x5d, 0x57, 0x7, 0xe9, 0xef, 0x73}}
 	return a, nil
 }
 
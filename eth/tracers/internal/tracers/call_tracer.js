@@ -192,6 +192,31 @@
 		this.callstack.push(call);
 	},
 
+	// wormholes is invoked for every native wormholes operation. These don't run
+	// any opcode, so they are reported as a call of their own with the balance
+	// movements and nft ownership changes they made.
+	wormholes: function(op, db) {
+		var call = {
+			type:      'WORMHOLES',
+			from:      op.from,
+			to:        op.to,
+			value:     op.value,
+			wormholes: {
+				type:     op.type,
+				balances: op.balances,
+				nfts:     op.nfts
+			}
+		};
+		if (op.error !== undefined) {
+			call.error = op.error;
+		}
+		var left = this.callstack.length;
+		if (this.callstack[left-1].calls === undefined) {
+			this.callstack[left-1].calls = [];
+		}
+		this.callstack[left-1].calls.push(call);
+	},
+
 	// result is invoked when all the opcodes have been iterated over and returns
 	// the final result of the tracing.
 	result: function(ctx, db) {
@@ -225,17 +250,18 @@
 	// to users who don't interpret it, just display it.
 	finalize: function(call) {
 		var sorted = {
-			type:    call.type,
-			from:    call.from,
-			to:      call.to,
-			value:   call.value,
-			gas:     call.gas,
-			gasUsed: call.gasUsed,
-			input:   call.input,
-			output:  call.output,
-			error:   call.error,
-			time:    call.time,
-			calls:   call.calls,
+			type:      call.type,
+			from:      call.from,
+			to:        call.to,
+			value:     call.value,
+			gas:       call.gas,
+			gasUsed:   call.gasUsed,
+			input:     call.input,
+			output:    call.output,
+			error:     call.error,
+			time:      call.time,
+			wormholes: call.wormholes,
+			calls:     call.calls,
 		}
 		for (var key in sorted) {
 			if (sorted[key] === undefined) {
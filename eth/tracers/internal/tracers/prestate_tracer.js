@@ -30,6 +30,25 @@
 				code:    toHex(db.getCode(addr)),
 				storage: {}
 			};
+			// Add the extended wormholes fields the account has set
+			var extra = db.getWormholesAccount(addr);
+			for (var key in extra) {
+				this.prestate[acc][key] = extra[key];
+			}
+		}
+	},
+
+	// setWormholesBalances overrides the balances of a prestate account with the
+	// ones reported before a wormholes operation.
+	setWormholesBalances: function(acc, change) {
+		acc.balance = change.before;
+		delete acc.pledgedBalance;
+		delete acc.exchangerBalance;
+		if (change.pledgedBalanceBefore !== '0x0') {
+			acc.pledgedBalance = change.pledgedBalanceBefore;
+		}
+		if (change.exchangerBalanceBefore !== '0x0') {
+			acc.exchangerBalance = change.exchangerBalanceBefore;
 		}
 	},
 
@@ -47,15 +66,35 @@
 	// result is invoked when all the opcodes have been iterated over and returns
 	// the final result of the tracing.
 	result: function(ctx, db) {
-		// At this point, we need to deduct the 'value' from the
-		// outer transaction, and move it back to the origin
-		this.lookupAccount(ctx.from, db);
+		// Transactions running no opcode at all never initialized the prestate
+		if (this.prestate === null) {
+			this.prestate = {};
+		}
+		this.lookupAccount(ctx.to, db);
 
-		var fromBal = bigInt(this.prestate[toHex(ctx.from)].balance.slice(2), 16);
-		var toBal   = bigInt(this.prestate[toHex(ctx.to)].balance.slice(2), 16);
+		if (this.wormholesTx) {
+			// A wormholes transaction moves its value in the operation itself,
+			// only the gas needs to be paid back to the origin
+			this.lookupAccount(ctx.from, db);
 
-		this.prestate[toHex(ctx.to)].balance   = '0x'+toBal.subtract(ctx.value).toString(16);
-		this.prestate[toHex(ctx.from)].balance = '0x'+fromBal.add(ctx.value).add((ctx.gasUsed + ctx.intrinsicGas) * ctx.gasPrice).toString(16);
+			var fromBal = bigInt(this.prestate[toHex(ctx.from)].balance.slice(2), 16);
+			if (this.wormholesSender !== undefined) {
+				var sender = this.wormholesSender;
+				this.setWormholesBalances(this.prestate[toHex(ctx.from)], sender);
+				fromBal = fromBal.subtract(bigInt(sender.after.slice(2), 16)).add(bigInt(sender.before.slice(2), 16));
+			}
+			this.prestate[toHex(ctx.from)].balance = '0x'+fromBal.add((ctx.gasUsed + ctx.intrinsicGas) * ctx.gasPrice).toString(16);
+		} else {
+			// At this point, we need to deduct the 'value' from the
+			// outer transaction, and move it back to the origin
+			this.lookupAccount(ctx.from, db);
+
+			var fromBal = bigInt(this.prestate[toHex(ctx.from)].balance.slice(2), 16);
+			var toBal   = bigInt(this.prestate[toHex(ctx.to)].balance.slice(2), 16);
+
+			this.prestate[toHex(ctx.to)].balance   = '0x'+toBal.subtract(ctx.value).toString(16);
+			this.prestate[toHex(ctx.from)].balance = '0x'+fromBal.add(ctx.value).add((ctx.gasUsed + ctx.intrinsicGas) * ctx.gasPrice).toString(16);
+		}
 
 		// Decrement the caller's nonce, and remove empty create targets
 		this.prestate[toHex(ctx.from)].nonce--;
@@ -104,5 +143,42 @@
 	},
 
 	// fault is invoked when the actual execution of an opcode fails.
-	fault: function(log, db) {}
+	fault: function(log, db) {},
+
+	// wormholes is invoked for every native wormholes operation, which runs no
+	// opcodes. The balances and nft owners it reports from before the operation
+	// are the prestate of the accounts it touched.
+	wormholes: function(op, db) {
+		if (this.prestate === null) {
+			this.prestate = {};
+		}
+		var topLevel = op.depth == 0;
+		if (topLevel) {
+			this.wormholesTx = true;
+		}
+		for (var i = 0; i < op.balances.length; i++) {
+			var change = op.balances[i];
+			// The origin paid for its gas before, result() accounts for it
+			if (topLevel && change.address == op.from) {
+				this.wormholesSender = change;
+				continue;
+			}
+			if (this.prestate[change.address] === undefined) {
+				this.lookupAccount(toAddress(change.address), db);
+				this.setWormholesBalances(this.prestate[change.address], change);
+			}
+		}
+		for (var i = 0; i < op.nfts.length; i++) {
+			var change = op.nfts[i];
+			if (this.prestate[change.nft] !== undefined) {
+				continue;
+			}
+			// Minted nfts didn't exist before the operation
+			if (change.from == '0x0000000000000000000000000000000000000000') {
+				continue;
+			}
+			this.lookupAccount(toAddress(change.nft), db);
+			this.prestate[change.nft].owner = change.from;
+		}
+	}
 }
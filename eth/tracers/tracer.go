@@ -238,6 +238,13 @@ func (dw *dbWrapper) pushObject(vm *duktape.Context) {
 		return 1
 	})
 	vm.PutPropString(obj, "exists")
+
+	// Push the wrapper for the extended wormholes account fields
+	vm.PushGoFunction(func(ctx *duktape.Context) int {
+		pushJSON(ctx, wormholesAccount(dw.db, common.BytesToAddress(popSlice(ctx))))
+		return 1
+	})
+	vm.PutPropString(obj, "getWormholesAccount")
 }
 
 // contractWrapper provides a JavaScript wrapper around vm.Contract
@@ -312,6 +319,9 @@ type Tracer struct {
 	reason    error  // Textual reason for the interruption
 
 	activePrecompiles []common.Address // Updated on CaptureStart based on given rules
+
+	hasWormholes bool         // Whether the tracer exposes a wormholes() function
+	wormholesOp  *wormholesOp // Native wormholes operation being traced
 }
 
 // Context contains some contextual infos for a transaction execution that is not
@@ -465,6 +475,10 @@ func New(code string, ctx *Context) (*Tracer, error) {
 	}
 	tracer.vm.Pop()
 
+	// The wormholes() function tracing native wormholes operations is optional
+	tracer.hasWormholes = tracer.vm.GetPropString(tracer.tracerObject, "wormholes") && tracer.vm.IsFunction(-1)
+	tracer.vm.Pop()
+
 	// Tracer is valid, inject the big int library to access large numbers
 	tracer.vm.EvalString(bigIntegerJS)
 	tracer.vm.PutGlobalString("bigInt")
@@ -201,6 +201,113 @@ func TestPrestateTracerCreate2(t *testing.T) {
 	}
 }
 
+// traceWormholesTransfer runs an nft transfer through the given tracer and
+// returns its result.
+func traceWormholesTransfer(t *testing.T, name string, nft, recipient common.Address) (common.Address, map[string]interface{}) {
+	privateKeyECDSA, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("err %v", err)
+	}
+	payload, err := json.Marshal(types.Wormholes{Type: 1, NFTAddress: nft.Hex(), Version: types.WormholesVersion})
+	if err != nil {
+		t.Fatalf("err %v", err)
+	}
+	unsignedTx := types.NewTransaction(1, recipient, new(big.Int), 5000000, big.NewInt(1), append([]byte("wormholes:"), payload...))
+
+	signer := types.NewEIP155Signer(big.NewInt(1))
+	tx, err := types.SignTx(unsignedTx, signer, privateKeyECDSA)
+	if err != nil {
+		t.Fatalf("err %v", err)
+	}
+	origin, _ := signer.Sender(tx)
+	txContext := vm.TxContext{
+		Origin:   origin,
+		GasPrice: big.NewInt(1),
+	}
+	context := vm.BlockContext{
+		CanTransfer:    core.CanTransfer,
+		Transfer:       core.Transfer,
+		VerifyNFTOwner: core.VerifyNFTOwner,
+		TransferNFT:    core.TransferNFT,
+		Coinbase:       common.Address{},
+		BlockNumber:    new(big.Int).SetUint64(8000000),
+		Time:           new(big.Int).SetUint64(5),
+		Difficulty:     big.NewInt(0x30000),
+		GasLimit:       uint64(6000000),
+	}
+	alloc := core.GenesisAlloc{}
+	alloc[origin] = core.GenesisAccount{
+		Nonce:   1,
+		Code:    []byte{},
+		Balance: big.NewInt(500000000000000),
+	}
+	_, statedb := tests.MakePreState(rawdb.NewMemoryDatabase(), alloc, false)
+	statedb.MintDeep = &types.MintDeep{UserMint: new(big.Int).SetBytes(nft.Bytes()), OfficialMint: new(big.Int)}
+	statedb.CreateNFTByUser(common.Address{}, origin, 100, "")
+
+	// Create the tracer, the EVM environment and run it
+	tracer, err := New(name, new(Context))
+	if err != nil {
+		t.Fatalf("failed to create %s: %v", name, err)
+	}
+	evm := vm.NewEVM(context, txContext, statedb, params.MainnetChainConfig, vm.Config{Debug: true, Tracer: tracer})
+
+	msg, err := tx.AsMessage(signer, nil)
+	if err != nil {
+		t.Fatalf("failed to prepare transaction for tracing: %v", err)
+	}
+	st := core.NewStateTransition(evm, msg, new(core.GasPool).AddGas(tx.Gas()))
+	if _, err = st.TransitionDb(); err != nil {
+		t.Fatalf("failed to execute transaction: %v", err)
+	}
+	if owner := statedb.GetNFTOwner16(nft); owner != recipient {
+		t.Fatalf("nft owner mismatch: have %x, want %x", owner, recipient)
+	}
+	res, err := tracer.GetResult()
+	if err != nil {
+		t.Fatalf("failed to retrieve trace result: %v", err)
+	}
+	ret := make(map[string]interface{})
+	if err := json.Unmarshal(res, &ret); err != nil {
+		t.Fatalf("failed to unmarshal trace result: %v", err)
+	}
+	return origin, ret
+}
+
+func TestWormholesTracers(t *testing.T) {
+	var (
+		nft       = common.HexToAddress("0x000000000000000000000000000000000000c0fe")
+		recipient = common.HexToAddress("0x00000000000000000000000000000000deadbeef")
+	)
+	origin, ret := traceWormholesTransfer(t, "callTracer", nft, recipient)
+	calls, _ := ret["calls"].([]interface{})
+	if len(calls) != 1 {
+		t.Fatalf("expected a single wormholes frame, have %v", ret["calls"])
+	}
+	frame := calls[0].(map[string]interface{})
+	if frame["type"] != "WORMHOLES" {
+		t.Fatalf("frame type mismatch: have %v, want WORMHOLES", frame["type"])
+	}
+	op := frame["wormholes"].(map[string]interface{})
+	want := []interface{}{map[string]interface{}{
+		"nft":  strings.ToLower(nft.Hex()),
+		"from": strings.ToLower(origin.Hex()),
+		"to":   strings.ToLower(recipient.Hex()),
+	}}
+	if !reflect.DeepEqual(op["nfts"], want) {
+		t.Errorf("nft changes mismatch: have %v, want %v", op["nfts"], want)
+	}
+
+	origin, ret = traceWormholesTransfer(t, "prestateTracer", nft, recipient)
+	acc, ok := ret[strings.ToLower(nft.Hex())].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected %x in result", nft)
+	}
+	if acc["owner"] != strings.ToLower(origin.Hex()) {
+		t.Errorf("prestate owner mismatch: have %v, want %x", acc["owner"], origin)
+	}
+}
+
 // Iterates over all the input-output datasets in the tracer test harness and
 // runs the JavaScript tracers against them.
 func TestCallTracer(t *testing.T) {
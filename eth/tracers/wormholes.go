@@ -0,0 +1,156 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"gopkg.in/olebedev/go-duktape.v3"
+)
+
+// wormholesOp is a native wormholes operation as handed to the optional
+// 'wormholes' function of a javascript tracer.
+type wormholesOp struct {
+	Type     uint8                    `json:"type"`
+	Depth    int                      `json:"depth"`
+	From     common.Address           `json:"from"`
+	To       common.Address           `json:"to"`
+	Value    *hexutil.Big             `json:"value"`
+	Balances []wormholesBalanceChange `json:"balances"`
+	NFTs     []wormholesNFTChange     `json:"nfts"`
+	Error    string                   `json:"error,omitempty"`
+}
+
+type wormholesBalanceChange struct {
+	Address                common.Address `json:"address"`
+	Roles                  []string       `json:"roles"`
+	Before                 *hexutil.Big   `json:"before"`
+	After                  *hexutil.Big   `json:"after"`
+	PledgedBalanceBefore   *hexutil.Big   `json:"pledgedBalanceBefore"`
+	PledgedBalanceAfter    *hexutil.Big   `json:"pledgedBalanceAfter"`
+	ExchangerBalanceBefore *hexutil.Big   `json:"exchangerBalanceBefore"`
+	ExchangerBalanceAfter  *hexutil.Big   `json:"exchangerBalanceAfter"`
+}
+
+type wormholesNFTChange struct {
+	NFTAddress common.Address `json:"nft"`
+	From       common.Address `json:"from"`
+	To         common.Address `json:"to"`
+}
+
+// pushJSON decodes a go value marshalled to JSON onto the VM stack.
+func pushJSON(ctx *duktape.Context, v interface{}) {
+	blob, err := json.Marshal(v)
+	if err != nil {
+		ctx.PushUndefined()
+		return
+	}
+	ctx.PushString(string(blob))
+	ctx.JsonDecode(-1)
+}
+
+// wormholesAccount returns the extended wormholes fields of an account that
+// differ from their defaults. Most getters create missing accounts, so those
+// are skipped to leave the traced state untouched.
+func wormholesAccount(db vm.StateDB, addr common.Address) map[string]interface{} {
+	fields := make(map[string]interface{})
+	if !db.Exist(addr) {
+		return fields
+	}
+	putBig := func(name string, v *big.Int) {
+		if v != nil && v.Sign() != 0 {
+			fields[name] = (*hexutil.Big)(v)
+		}
+	}
+	putAddr := func(name string, v common.Address) {
+		if v != (common.Address{}) {
+			fields[name] = v
+		}
+	}
+	putBig("pledgedBalance", db.GetPledgedBalance(addr))
+	putBig("pledgedBlockNumber", db.GetPledgedTime(addr))
+	if db.GetExchangerFlag(addr) {
+		fields["exchangerFlag"] = true
+		fields["exchangerName"] = db.GetExchangerName(addr)
+		fields["exchangerURL"] = db.GetExchangerURL(addr)
+		fields["feeRate"] = db.GetFeeRate(addr)
+		putBig("openExchangerBlockNumber", db.GetOpenExchangerTime(addr))
+	}
+	putBig("exchangerBalance", db.GetExchangerBalance(addr))
+	if coefficient := db.GetValidatorCoefficient(addr); coefficient != 0 {
+		fields["coefficient"] = coefficient
+	}
+	if approved := db.GetApproveAddress(addr); len(approved) > 0 {
+		fields["approveAddressList"] = approved
+	}
+	if owner := db.GetNFTOwner16(addr); owner != (common.Address{}) {
+		fields["owner"] = owner
+		putAddr("nftApproveAddress", db.GetNFTApproveAddress(addr))
+		fields["mergeLevel"] = db.GetNFTMergeLevel(addr)
+		fields["mergeNumber"] = db.GetMergeNumber(addr)
+		putAddr("creator", db.GetNFTCreator(addr))
+		fields["royalty"] = db.GetNFTRoyalty(addr)
+		putAddr("exchanger", db.GetNFTExchanger(addr))
+		fields["metaURL"] = db.GetNFTMetaURL(addr)
+		if db.GetNFTMetaURLFrozen(addr) {
+			fields["metaURLFrozen"] = true
+		}
+	}
+	return fields
+}
+
+// CaptureWormholesEnter implements the vm.WormholesTracer interface to start
+// tracing a native wormholes operation.
+func (jst *Tracer) CaptureWormholesEnter(env *vm.EVM, depth int, typ uint8, from common.Address, to common.Address, value *big.Int) {
+	jst.wormholesOp = &wormholesOp{
+		Type:  typ,
+		Depth: depth,
+		From:  from,
+		To:    to,
+		Value: (*hexutil.Big)(new(big.Int).Set(value)),
+	}
+}
+
+// CaptureWormholesExit implements the vm.WormholesTracer interface to hand a
+// finished native wormholes operation to the javascript 'wormholes' function.
+func (jst *Tracer) CaptureWormholesExit(balances []vm.WormholesBalanceChange, nfts []vm.WormholesNFTChange, err error) {
+	op := jst.wormholesOp
+	jst.wormholesOp = nil
+	if op == nil || !jst.hasWormholes || jst.err != nil {
+		return
+	}
+	// If tracing was interrupted, set the error and stop
+	if atomic.LoadUint32(&jst.interrupt) > 0 {
+		jst.err = jst.reason
+		return
+	}
+	op.Balances = make([]wormholesBalanceChange, 0, len(balances))
+	for _, b := range balances {
+		op.Balances = append(op.Balances, wormholesBalanceChange{
+			Address:                b.Address,
+			Roles:                  b.Roles,
+			Before:                 (*hexutil.Big)(b.Before),
+			After:                  (*hexutil.Big)(b.After),
+			PledgedBalanceBefore:   (*hexutil.Big)(b.PledgedBefore),
+			PledgedBalanceAfter:    (*hexutil.Big)(b.PledgedAfter),
+			ExchangerBalanceBefore: (*hexutil.Big)(b.ExchangerBefore),
+			ExchangerBalanceAfter:  (*hexutil.Big)(b.ExchangerAfter),
+		})
+	}
+	op.NFTs = make([]wormholesNFTChange, 0, len(nfts))
+	for _, n := range nfts {
+		op.NFTs = append(op.NFTs, wormholesNFTChange{NFTAddress: n.NFTAddress, From: n.From, To: n.To})
+	}
+	if err != nil {
+		op.Error = err.Error()
+	}
+	pushJSON(jst.vm, op)
+	jst.vm.PutPropString(jst.stateObject, "wormholes")
+
+	if _, err := jst.call(true, "wormholes", "wormholes", "db"); err != nil {
+		jst.err = wrapError("wormholes", err)
+	}
+}
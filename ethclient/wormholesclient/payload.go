@@ -0,0 +1,388 @@
+package wormholesclient
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Encode returns the transaction data carrying the wormholes operation w.
+func Encode(w *types.Wormholes) ([]byte, error) {
+	if err := w.CheckFormat(); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("wormholes:"), data...), nil
+}
+
+func newWormholes(typ uint8) *types.Wormholes {
+	return &types.Wormholes{Type: typ, Version: types.WormholesVersion}
+}
+
+// Mint returns the operation minting an nft to the recipient of the transaction.
+func Mint(royalty uint16, metaURL string, exchanger common.Address) *types.Wormholes {
+	w := newWormholes(0)
+	w.Royalty = royalty
+	w.MetaURL = metaURL
+	w.Exchanger = exchanger.Hex()
+	return w
+}
+
+// Transfer returns the operation transferring nft to the recipient of the transaction.
+func Transfer(nft common.Address) *types.Wormholes {
+	w := newWormholes(1)
+	w.NFTAddress = nft.Hex()
+	return w
+}
+
+// Author returns the operation approving the recipient of the transaction,
+// an exchanger, to trade nft.
+func Author(nft common.Address) *types.Wormholes {
+	w := newWormholes(2)
+	w.NFTAddress = nft.Hex()
+	return w
+}
+
+// AuthorRevoke returns the operation revoking the approval of Author.
+func AuthorRevoke(nft common.Address) *types.Wormholes {
+	w := newWormholes(3)
+	w.NFTAddress = nft.Hex()
+	return w
+}
+
+// AccountAuthor returns the operation approving the recipient of the
+// transaction, an exchanger, to trade all nfts of the sender.
+func AccountAuthor() *types.Wormholes {
+	return newWormholes(4)
+}
+
+// AccountAuthorRevoke returns the operation revoking the approval of AccountAuthor.
+func AccountAuthorRevoke() *types.Wormholes {
+	return newWormholes(5)
+}
+
+// SNFTToERB returns the operation exchanging the snft nft for erb.
+func SNFTToERB(nft common.Address) *types.Wormholes {
+	w := newWormholes(6)
+	w.NFTAddress = nft.Hex()
+	return w
+}
+
+// TokenPledge returns the operation pledging the value of the transaction to
+// become a validator. Use SignProxy to let a proxy sign on its behalf.
+func TokenPledge() *types.Wormholes {
+	return newWormholes(9)
+}
+
+// TokenRevokesPledge returns the operation cancelling the value of the
+// transaction from the pledge of the sender.
+func TokenRevokesPledge() *types.Wormholes {
+	return newWormholes(10)
+}
+
+// OpenExchanger returns the operation opening an exchanger with the value of
+// the transaction as its stake.
+func OpenExchanger(feeRate uint16, name string, url string) *types.Wormholes {
+	w := newWormholes(11)
+	w.FeeRate = feeRate
+	w.Name = name
+	w.Url = url
+	return w
+}
+
+// CloseExchanger returns the operation closing the exchanger of the sender.
+func CloseExchanger() *types.Wormholes {
+	return newWormholes(12)
+}
+
+// BuyNFTBySellerOrExchanger returns the operation a seller or exchanger sends
+// to fill the buyer order.
+func BuyNFTBySellerOrExchanger(buyer types.Payload) *types.Wormholes {
+	w := newWormholes(14)
+	w.Buyer = buyer
+	return w
+}
+
+// BuyNFTByBuyer returns the operation a buyer sends to fill the seller order.
+func BuyNFTByBuyer(seller types.Payload) *types.Wormholes {
+	w := newWormholes(15)
+	w.Seller1 = seller
+	return w
+}
+
+// BuyAndMintNFTByBuyer returns the operation a buyer sends to fill the lazy
+// mint order of a seller.
+func BuyAndMintNFTByBuyer(seller types.MintSellPayload) *types.Wormholes {
+	w := newWormholes(16)
+	w.Seller2 = seller
+	return w
+}
+
+// BuyAndMintNFTByExchanger returns the operation an exchanger sends to match
+// a buyer order with the lazy mint order of a seller.
+func BuyAndMintNFTByExchanger(buyer types.Payload, seller types.MintSellPayload) *types.Wormholes {
+	w := newWormholes(17)
+	w.Buyer = buyer
+	w.Seller2 = seller
+	return w
+}
+
+// BuyNFTByApproveExchanger returns the operation an exchanger approved by
+// exchangerAuth sends to fill the buyer order.
+func BuyNFTByApproveExchanger(buyer types.Payload, exchangerAuth types.ExchangerPayload) *types.Wormholes {
+	w := newWormholes(18)
+	w.Buyer = buyer
+	w.ExchangerAuth = exchangerAuth
+	return w
+}
+
+// BuyAndMintNFTByApprovedExchanger returns the operation an exchanger approved
+// by exchangerAuth sends to match a buyer order with a lazy mint order.
+func BuyAndMintNFTByApprovedExchanger(buyer types.Payload, seller types.MintSellPayload, exchangerAuth types.ExchangerPayload) *types.Wormholes {
+	w := newWormholes(19)
+	w.Buyer = buyer
+	w.Seller2 = seller
+	w.ExchangerAuth = exchangerAuth
+	return w
+}
+
+// BuyNFTByExchanger returns the operation an exchanger sends to match a buyer
+// order with a seller order.
+func BuyNFTByExchanger(buyer types.Payload, seller types.Payload) *types.Wormholes {
+	w := newWormholes(20)
+	w.Buyer = buyer
+	w.Seller1 = seller
+	return w
+}
+
+// AdditionalPledgeAmount returns the operation adding the value of the
+// transaction to the stake of the exchanger of the sender.
+func AdditionalPledgeAmount() *types.Wormholes {
+	return newWormholes(21)
+}
+
+// RevokesPledgeAmount returns the operation withdrawing the value of the
+// transaction from the stake of the exchanger of the sender.
+func RevokesPledgeAmount() *types.Wormholes {
+	return newWormholes(22)
+}
+
+// VoteOfficialNFT returns the operation nominating an official nft collection.
+func VoteOfficialNFT(dir string, startIndex *big.Int, number uint64, royalty uint16, creator common.Address) *types.Wormholes {
+	w := newWormholes(23)
+	w.Dir = dir
+	w.StartIndex = hexutil.EncodeBig(startIndex)
+	w.Number = number
+	w.Royalty = royalty
+	w.Creator = creator.Hex()
+	return w
+}
+
+// VoteOfficialNFTProposal returns the operation voting on the official nft
+// proposal id.
+func VoteOfficialNFTProposal(id uint64) *types.Wormholes {
+	w := newWormholes(23)
+	w.ProposalID = id
+	return w
+}
+
+// Unfrozen returns the operation releasing the frozen balance of the sender.
+func Unfrozen() *types.Wormholes {
+	return newWormholes(25)
+}
+
+// BatchBuyNFTByApproveExchanger returns the operation an exchanger approved by
+// exchangerAuth sends to match a buyer order with a seller order. The buyer
+// and the seller either sign their orders or authorize the exchanger with
+// buyerAuth and sellerAuth.
+func BatchBuyNFTByApproveExchanger(buyer types.Payload, buyerAuth types.TraderPayload, seller types.Payload, sellerAuth types.TraderPayload, exchangerAuth types.ExchangerPayload) *types.Wormholes {
+	w := newWormholes(27)
+	w.Buyer = buyer
+	w.BuyerAuth = buyerAuth
+	w.Seller1 = seller
+	w.SellerAuth = sellerAuth
+	w.ExchangerAuth = exchangerAuth
+	return w
+}
+
+// BatchForcedSaleSNFTByApproveExchanger returns the operation an exchanger
+// approved by exchangerAuth sends to sell an snft to the buyer at the
+// official price.
+func BatchForcedSaleSNFTByApproveExchanger(buyer types.Payload, buyerAuth types.TraderPayload, sellerAuth types.TraderPayload, exchangerAuth types.ExchangerPayload) *types.Wormholes {
+	w := newWormholes(28)
+	w.Buyer = buyer
+	w.BuyerAuth = buyerAuth
+	w.SellerAuth = sellerAuth
+	w.ExchangerAuth = exchangerAuth
+	return w
+}
+
+// MinerConsign returns the operation delegating the validator of the sender
+// to a proxy, use SignProxy to sign it.
+func MinerConsign() *types.Wormholes {
+	return newWormholes(31)
+}
+
+// UpdateMetaURL returns the operation replacing the meta url of nft.
+func UpdateMetaURL(nft common.Address, metaURL string) *types.Wormholes {
+	w := newWormholes(32)
+	w.NFTAddress = nft.Hex()
+	w.MetaURL = metaURL
+	return w
+}
+
+// FreezeMetaURL returns the operation freezing the meta url of nft.
+func FreezeMetaURL(nft common.Address) *types.Wormholes {
+	w := newWormholes(33)
+	w.NFTAddress = nft.Hex()
+	return w
+}
+
+// UpdateExchanger returns the operation updating the exchanger of the
+// sender, the nil fields of update leave the settings unchanged.
+func UpdateExchanger(update *types.ExchangerUpdate) *types.Wormholes {
+	w := newWormholes(34)
+	w.SetExchangerUpdate(update)
+	return w
+}
+
+// SetCollectionFeeRate returns the operation setting the fee rate of the
+// exchanger of the sender for the nfts of creator, zero removes it.
+func SetCollectionFeeRate(creator common.Address, feeRate uint16) *types.Wormholes {
+	w := newWormholes(35)
+	w.Creator = creator.Hex()
+	w.FeeRate = feeRate
+	return w
+}
+
+// AddAllowedCreator returns the operation adding creator to the allowlist of
+// the exchanger of the sender.
+func AddAllowedCreator(creator common.Address) *types.Wormholes {
+	w := newWormholes(36)
+	w.Creator = creator.Hex()
+	return w
+}
+
+// RemoveAllowedCreator returns the operation removing creator from the
+// allowlist of the exchanger of the sender.
+func RemoveAllowedCreator(creator common.Address) *types.Wormholes {
+	w := newWormholes(37)
+	w.Creator = creator.Hex()
+	return w
+}
+
+// FractionalizeSNFT returns the operation splitting the merged snft nft into shares.
+func FractionalizeSNFT(nft common.Address) *types.Wormholes {
+	w := newWormholes(38)
+	w.NFTAddress = nft.Hex()
+	return w
+}
+
+// RedeemSNFT returns the operation burning all shares of nft to release it.
+func RedeemSNFT(nft common.Address) *types.Wormholes {
+	w := newWormholes(39)
+	w.NFTAddress = nft.Hex()
+	return w
+}
+
+// TransferSNFTShares returns the operation transferring amount shares of nft
+// to the recipient of the transaction.
+func TransferSNFTShares(nft common.Address, amount *big.Int) *types.Wormholes {
+	w := newWormholes(40)
+	w.NFTAddress = nft.Hex()
+	w.Amount = hexutil.EncodeBig(amount)
+	return w
+}
+
+// RegisterBLSPubKey returns the operation registering the bls public key of
+// the validator of the sender together with its proof of possession.
+func RegisterBLSPubKey(pubKey []byte, proof []byte) *types.Wormholes {
+	w := newWormholes(41)
+	w.BLSPubKey = hexutil.Encode(pubKey)
+	w.BLSProof = hexutil.Encode(proof)
+	return w
+}
+
+// signText signs msg the way the nft orders are recovered by the chain, as a
+// personal message with a 27/28 recovery id.
+func signText(key *ecdsa.PrivateKey, msg string) (string, error) {
+	sig, err := crypto.Sign(accounts.TextHash([]byte(msg)), key)
+	if err != nil {
+		return "", err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return hexutil.Encode(sig), nil
+}
+
+// SignBuyer signs the buyer order p with key.
+func SignBuyer(key *ecdsa.PrivateKey, p *types.Payload) error {
+	sig, err := signText(key, p.Amount+p.NFTAddress+p.Exchanger+p.BlockNumber+p.Seller)
+	if err != nil {
+		return err
+	}
+	p.Sig = sig
+	return nil
+}
+
+// SignSeller signs the seller order p with key.
+func SignSeller(key *ecdsa.PrivateKey, p *types.Payload) error {
+	sig, err := signText(key, p.Amount+p.NFTAddress+p.Exchanger+p.BlockNumber)
+	if err != nil {
+		return err
+	}
+	p.Sig = sig
+	return nil
+}
+
+// SignMintSeller signs the lazy mint order p with key.
+func SignMintSeller(key *ecdsa.PrivateKey, p *types.MintSellPayload) error {
+	sig, err := signText(key, p.Amount+p.Royalty+p.MetaURL+p.ExclusiveFlag+p.Exchanger+p.BlockNumber)
+	if err != nil {
+		return err
+	}
+	p.Sig = sig
+	return nil
+}
+
+// SignExchangerAuth signs the approval p of an exchanger with the key of the
+// exchanger owner.
+func SignExchangerAuth(key *ecdsa.PrivateKey, p *types.ExchangerPayload) error {
+	p.ExchangerOwner = crypto.PubkeyToAddress(key.PublicKey).Hex()
+	sig, err := signText(key, p.ExchangerOwner+p.To+p.BlockNumber)
+	if err != nil {
+		return err
+	}
+	p.Sig = sig
+	return nil
+}
+
+// SignTraderAuth signs the authorization p of an exchanger by a buyer or seller.
+func SignTraderAuth(key *ecdsa.PrivateKey, p *types.TraderPayload) error {
+	sig, err := signText(key, p.Exchanger+p.BlockNumber)
+	if err != nil {
+		return err
+	}
+	p.Sig = sig
+	return nil
+}
+
+// SignProxy lets the proxy holding key sign for the validator sender in the
+// TokenPledge or MinerConsign operation w.
+func SignProxy(key *ecdsa.PrivateKey, w *types.Wormholes, sender common.Address) error {
+	w.ProxyAddress = crypto.PubkeyToAddress(key.PublicKey).Hex()
+	sig, err := signText(key, w.ProxyAddress+sender.Hex())
+	if err != nil {
+		return err
+	}
+	w.ProxySign = sig
+	return nil
+}
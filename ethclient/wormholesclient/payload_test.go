@@ -0,0 +1,86 @@
+package wormholesclient
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestSignOrders(t *testing.T) {
+	buyerKey, _ := crypto.GenerateKey()
+	sellerKey, _ := crypto.GenerateKey()
+	ownerKey, _ := crypto.GenerateKey()
+	exchanger := common.HexToAddress("0x68B14e0F18C3EE322d3e613fF63B87E56D86Df60")
+
+	buyer := types.Payload{
+		Amount:      "0x38d7ea4c68000",
+		NFTAddress:  "0x0000000000000000000000000000000000000001",
+		Exchanger:   exchanger.Hex(),
+		BlockNumber: "0x10",
+		Seller:      crypto.PubkeyToAddress(sellerKey.PublicKey).Hex(),
+	}
+	if err := SignBuyer(buyerKey, &buyer); err != nil {
+		t.Fatal(err)
+	}
+	seller := types.MintSellPayload{
+		Amount:        "0x38d7ea4c68000",
+		Royalty:       "0xa",
+		MetaURL:       "ipfs://meta",
+		ExclusiveFlag: "0",
+		Exchanger:     exchanger.Hex(),
+		BlockNumber:   "0x10",
+	}
+	if err := SignMintSeller(sellerKey, &seller); err != nil {
+		t.Fatal(err)
+	}
+	auth := types.ExchangerPayload{
+		To:          exchanger.Hex(),
+		BlockNumber: "0x10",
+	}
+	if err := SignExchangerAuth(ownerKey, &auth); err != nil {
+		t.Fatal(err)
+	}
+	w := BuyAndMintNFTByApprovedExchanger(buyer, seller, auth)
+	if _, err := Encode(w); err != nil {
+		t.Fatal(err)
+	}
+
+	recovered, err := core.RecoverAddress(buyer.Amount+buyer.NFTAddress+buyer.Exchanger+buyer.BlockNumber+buyer.Seller, w.Buyer.Sig)
+	if err != nil || recovered != crypto.PubkeyToAddress(buyerKey.PublicKey) {
+		t.Errorf("buyer mismatch: have %x, err %v", recovered, err)
+	}
+	recovered, err = core.RecoverAddress(seller.Amount+seller.Royalty+seller.MetaURL+seller.ExclusiveFlag+seller.Exchanger+seller.BlockNumber, w.Seller2.Sig)
+	if err != nil || recovered != crypto.PubkeyToAddress(sellerKey.PublicKey) {
+		t.Errorf("seller mismatch: have %x, err %v", recovered, err)
+	}
+	recovered, err = core.RecoverAddress(auth.ExchangerOwner+auth.To+auth.BlockNumber, w.ExchangerAuth.Sig)
+	if err != nil || recovered.Hex() != w.ExchangerAuth.ExchangerOwner {
+		t.Errorf("exchanger owner mismatch: have %x, err %v", recovered, err)
+	}
+}
+
+func TestSignProxy(t *testing.T) {
+	proxyKey, _ := crypto.GenerateKey()
+	validator := common.HexToAddress("0x091DBBa95B26793515cc9aCB9bEb5124c479f27F")
+
+	w := MinerConsign()
+	if err := SignProxy(proxyKey, w, validator); err != nil {
+		t.Fatal(err)
+	}
+	recovered, err := core.RecoverAddress(w.ProxyAddress+validator.Hex(), w.ProxySign)
+	if err != nil || recovered.Hex() != w.ProxyAddress {
+		t.Errorf("proxy mismatch: have %x, want %s, err %v", recovered, w.ProxyAddress, err)
+	}
+}
+
+func TestEncodeRejectsInvalid(t *testing.T) {
+	if _, err := Encode(Mint(100, string(make([]byte, 300)), common.Address{})); err == nil {
+		t.Error("expected overlong meta url to be rejected")
+	}
+	if _, err := Encode(&types.Wormholes{Type: 200}); err == nil {
+		t.Error("expected unknown type to be rejected")
+	}
+}
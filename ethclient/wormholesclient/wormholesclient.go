@@ -0,0 +1,394 @@
+// Package wormholesclient provides an RPC client for the wormholes specific
+// erb_ APIs and helpers to assemble and sign wormholes transactions.
+package wormholesclient
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Client is a wrapper around rpc.Client that implements the erb_ APIs.
+//
+// If you want to use the standardized Ethereum RPC functionality, use ethclient.Client instead.
+type Client struct {
+	c *rpc.Client
+}
+
+// New creates a client that uses the given RPC client.
+func New(c *rpc.Client) *Client {
+	return &Client{c}
+}
+
+// MinerProxy is a validator with the proxy that signs on its behalf.
+type MinerProxy struct {
+	Address common.Address
+	Proxy   common.Address
+	Balance *big.Int
+}
+
+// BeneficiaryAddress is a reward paid by a block.
+type BeneficiaryAddress struct {
+	Address      common.Address
+	NftAddress   common.Address
+	RewardAmount *big.Int
+}
+
+// BlockParticipants is a validator of a block and its online coefficient.
+type BlockParticipants struct {
+	Address     common.Address
+	Coefficient uint8
+}
+
+// SNFTShareInfo is the balance of shares of a fractionalized snft.
+type SNFTShareInfo struct {
+	NFTAddress common.Address `json:"nftAddress"`
+	Amount     *hexutil.Big   `json:"amount"`
+	Supply     *hexutil.Big   `json:"supply"`
+}
+
+// OfficialNFTBallotInfo is a ballot of an official nft proposal, weighted by
+// the pledged balance of the voter.
+type OfficialNFTBallotInfo struct {
+	Voter  common.Address `json:"voter"`
+	Weight *hexutil.Big   `json:"weight"`
+}
+
+// OfficialNFTProposalInfo is an official nft proposal with its tally.
+type OfficialNFTProposalInfo struct {
+	ID            hexutil.Uint64           `json:"id"`
+	Proposer      common.Address           `json:"proposer"`
+	Dir           string                   `json:"dir"`
+	Number        uint64                   `json:"number"`
+	Royalty       uint16                   `json:"royalty"`
+	Creator       string                   `json:"creator"`
+	StartBlock    *hexutil.Big             `json:"startBlock"`
+	EndBlock      *hexutil.Big             `json:"endBlock"`
+	Open          bool                     `json:"open"`
+	ReachedQuorum bool                     `json:"reachedQuorum"`
+	Tally         *hexutil.Big             `json:"tally"`
+	Ballots       []*OfficialNFTBallotInfo `json:"ballots"`
+}
+
+// ExchangerInfo is an open exchanger.
+type ExchangerInfo struct {
+	Address         common.Address `json:"address"`
+	Name            string         `json:"name"`
+	URL             string         `json:"url"`
+	FeeRate         uint16         `json:"feeRate"`
+	StakedBalance   *hexutil.Big   `json:"stakedBalance"`
+	OpenBlockNumber *hexutil.Big   `json:"openBlockNumber"`
+	Volume          *hexutil.Big   `json:"volume"`
+}
+
+// ExchangerPage is a page of ListExchangers, NextCursor is nil on the last page.
+type ExchangerPage struct {
+	Total      int              `json:"total"`
+	Exchangers []*ExchangerInfo `json:"exchangers"`
+	NextCursor *hexutil.Uint64  `json:"nextCursor"`
+}
+
+// ValidatorStats is the participation of a validator in a range of blocks.
+type ValidatorStats struct {
+	Address               common.Address `json:"address"`
+	FromBlock             uint64         `json:"fromBlock"`
+	ToBlock               uint64         `json:"toBlock"`
+	Proposed              uint64         `json:"proposed"`
+	CommittedSeals        uint64         `json:"committedSeals"`
+	RewardSeals           uint64         `json:"rewardSeals"`
+	EmptyBlockVotes       uint64         `json:"emptyBlockVotes"`
+	CoefficientAdded      uint64         `json:"coefficientAdded"`
+	CoefficientSubtracted uint64         `json:"coefficientSubtracted"`
+}
+
+// DecodedTransaction is a raw transaction decoded by DecodeTransaction.
+type DecodedTransaction struct {
+	Hash      common.Hash        `json:"hash"`
+	Tx        *types.Transaction `json:"tx"`
+	From      *common.Address    `json:"from"`
+	Wormholes *types.Wormholes   `json:"wormholes"`
+	Error     string             `json:"error,omitempty"`
+}
+
+// Version returns the wormholes version of the node.
+func (ec *Client) Version(ctx context.Context) (string, error) {
+	var result string
+	err := ec.c.CallContext(ctx, &result, "erb_version")
+	return result, err
+}
+
+// QueryMinerProxy returns the validators with their proxies at the given block.
+func (ec *Client) QueryMinerProxy(ctx context.Context, number *big.Int, account common.Address) ([]*MinerProxy, error) {
+	var result []*MinerProxy
+	err := ec.c.CallContext(ctx, &result, "erb_queryMinerProxy", toBlockNumArg(number), account)
+	return result, err
+}
+
+// GetAccountInfo returns the wormholes account of the given address. The
+// block number can be nil, in which case the account is taken from the
+// latest known block.
+func (ec *Client) GetAccountInfo(ctx context.Context, account common.Address, number *big.Int) (*state.Account, error) {
+	var result state.Account
+	if err := ec.c.CallContext(ctx, &result, "erb_getAccountInfo", account, toBlockNumArg(number)); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSNFTShares returns the shares of fractionalized snfts held by account.
+func (ec *Client) GetSNFTShares(ctx context.Context, account common.Address, number *big.Int) ([]*SNFTShareInfo, error) {
+	var result []*SNFTShareInfo
+	err := ec.c.CallContext(ctx, &result, "erb_getSNFTShares", account, toBlockNumArg(number))
+	return result, err
+}
+
+// GetValidators returns the committee drawn from the validator pool for the
+// given block.
+func (ec *Client) GetValidators(ctx context.Context, number *big.Int) ([]common.Address, error) {
+	var result []common.Address
+	err := ec.c.CallContext(ctx, &result, "erb_getValidators", toBlockNumArg(number))
+	return result, err
+}
+
+// GetElevenValidatorsWithProxy returns the committee of the given block, with
+// the proxies in place of the validators that have one.
+func (ec *Client) GetElevenValidatorsWithProxy(ctx context.Context, number *big.Int) ([]common.Address, error) {
+	var result []common.Address
+	err := ec.c.CallContext(ctx, &result, "erb_getElevenValidatorsWithProxy", toBlockNumArg(number))
+	return result, err
+}
+
+// GetRealAddr returns the validator a proxy signs for, or the given address
+// if it is not a proxy.
+func (ec *Client) GetRealAddr(ctx context.Context, account common.Address) (common.Address, error) {
+	var result common.Address
+	err := ec.c.CallContext(ctx, &result, "erb_getRealAddr", account)
+	return result, err
+}
+
+// GetBlockBeneficiaryAddressByNumber returns the rewards paid by the given block.
+func (ec *Client) GetBlockBeneficiaryAddressByNumber(ctx context.Context, number *big.Int, fullTx bool) ([]*BeneficiaryAddress, error) {
+	var result []*BeneficiaryAddress
+	err := ec.c.CallContext(ctx, &result, "erb_getBlockBeneficiaryAddressByNumber", toBlockNumArg(number), fullTx)
+	return result, err
+}
+
+// GetUserMintDeep returns the hex index of the next user minted nft.
+func (ec *Client) GetUserMintDeep(ctx context.Context, number *big.Int) (string, error) {
+	var result string
+	err := ec.c.CallContext(ctx, &result, "erb_getUserMintDeep", toBlockNumArg(number))
+	return result, err
+}
+
+// GetOfficialMintDeep returns the hex index of the next official snft.
+func (ec *Client) GetOfficialMintDeep(ctx context.Context, number *big.Int) (string, error) {
+	var result string
+	err := ec.c.CallContext(ctx, &result, "erb_getOfficialMintDeep", toBlockNumArg(number))
+	return result, err
+}
+
+// GetStaker returns the stakers at the given block.
+func (ec *Client) GetStaker(ctx context.Context, number *big.Int) (*types.DBStakerList, error) {
+	var result types.DBStakerList
+	if err := ec.c.CallContext(ctx, &result, "erb_getStaker", toBlockNumArg(number)); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAllStakers returns the stakers of the current block.
+func (ec *Client) GetAllStakers(ctx context.Context) (*types.StakerList, error) {
+	var result types.StakerList
+	if err := ec.c.CallContext(ctx, &result, "erb_getAllStakers"); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetStakerLen returns the number of stakers at the given block.
+func (ec *Client) GetStakerLen(ctx context.Context, number *big.Int) (int, error) {
+	var result int
+	err := ec.c.CallContext(ctx, &result, "erb_getStakerLen", toBlockNumArg(number))
+	return result, err
+}
+
+// GetOfficialNFTProposals returns the official nft proposals at the given block.
+func (ec *Client) GetOfficialNFTProposals(ctx context.Context, number *big.Int) ([]*OfficialNFTProposalInfo, error) {
+	var result []*OfficialNFTProposalInfo
+	err := ec.c.CallContext(ctx, &result, "erb_getOfficialNFTProposals", toBlockNumArg(number))
+	return result, err
+}
+
+// ListExchangers returns a page of the open exchangers at the given block. A
+// zero limit and an empty sortBy leave the choice to the node.
+func (ec *Client) ListExchangers(ctx context.Context, number *big.Int, cursor uint64, limit uint64, sortBy string) (*ExchangerPage, error) {
+	var (
+		result   ExchangerPage
+		limitArg *hexutil.Uint64
+		sortArg  *string
+	)
+	if limit > 0 {
+		limitArg = (*hexutil.Uint64)(&limit)
+	}
+	if sortBy != "" {
+		sortArg = &sortBy
+	}
+	if err := ec.c.CallContext(ctx, &result, "erb_listExchangers", toBlockNumArg(number), hexutil.Uint64(cursor), limitArg, sortArg); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetValidator returns the validator pool at the given block.
+func (ec *Client) GetValidator(ctx context.Context, number *big.Int) (*types.ValidatorList, error) {
+	var result types.ValidatorList
+	if err := ec.c.CallContext(ctx, &result, "erb_getValidator", toBlockNumArg(number)); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetValidatorLen returns the size of the validator pool at the given block.
+func (ec *Client) GetValidatorLen(ctx context.Context, number *big.Int) (int, error) {
+	var result int
+	err := ec.c.CallContext(ctx, &result, "erb_getValidatorLen", toBlockNumArg(number))
+	return result, err
+}
+
+// GetNominatedNFTInfo returns the official nft nominated at the given block.
+func (ec *Client) GetNominatedNFTInfo(ctx context.Context, number *big.Int) (*types.InjectedOfficialNFT, error) {
+	var result *types.InjectedOfficialNFT
+	err := ec.c.CallContext(ctx, &result, "erb_getNominatedNFTInfo", toBlockNumArg(number))
+	return result, err
+}
+
+// GetCurrentNFTInfo returns the latest official nft injected until the given block.
+func (ec *Client) GetCurrentNFTInfo(ctx context.Context, number *big.Int) (*types.InjectedOfficialNFT, error) {
+	var result *types.InjectedOfficialNFT
+	err := ec.c.CallContext(ctx, &result, "erb_getCurrentNFTInfo", toBlockNumArg(number))
+	return result, err
+}
+
+// GetInjectedNFTInfo returns the official nfts injected until the given block.
+func (ec *Client) GetInjectedNFTInfo(ctx context.Context, number *big.Int) (*types.InjectedOfficialNFTList, error) {
+	var result *types.InjectedOfficialNFTList
+	err := ec.c.CallContext(ctx, &result, "erb_getInjectedNFTInfo", toBlockNumArg(number))
+	return result, err
+}
+
+// GetShouldParticipantsCoefficientByNumber returns the committee that should
+// have sealed the given block with their coefficients.
+func (ec *Client) GetShouldParticipantsCoefficientByNumber(ctx context.Context, number *big.Int) ([]*BlockParticipants, error) {
+	var result []*BlockParticipants
+	err := ec.c.CallContext(ctx, &result, "erb_getShouldParticipantsCoefficientByNumber", toBlockNumArg(number))
+	return result, err
+}
+
+// GetCoefficientByNumber returns the coefficients of the validators of the
+// given block.
+func (ec *Client) GetCoefficientByNumber(ctx context.Context, number *big.Int) ([]*BlockParticipants, error) {
+	var result []*BlockParticipants
+	err := ec.c.CallContext(ctx, &result, "erb_getCoefficientByNumber", toBlockNumArg(number))
+	return result, err
+}
+
+// GetRealParticipantsByNumber returns the validators that sealed the given
+// block with their coefficients.
+func (ec *Client) GetRealParticipantsByNumber(ctx context.Context, number *big.Int) ([]*BlockParticipants, error) {
+	var result []*BlockParticipants
+	err := ec.c.CallContext(ctx, &result, "erb_getRealParticipantsByNumber", toBlockNumArg(number))
+	return result, err
+}
+
+// GetValidatorStats returns the participation of a validator in the given
+// range of blocks.
+func (ec *Client) GetValidatorStats(ctx context.Context, account common.Address, fromBlock *big.Int, toBlock *big.Int) (*ValidatorStats, error) {
+	var result ValidatorStats
+	if err := ec.c.CallContext(ctx, &result, "erb_getValidatorStats", account, toBlockNumArg(fromBlock), toBlockNumArg(toBlock)); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DecodeTransaction decodes a raw transaction and the wormholes operation it
+// carries.
+func (ec *Client) DecodeTransaction(ctx context.Context, tx *types.Transaction) (*DecodedTransaction, error) {
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var result DecodedTransaction
+	if err := ec.c.CallContext(ctx, &result, "erb_decodeTransaction", hexutil.Bytes(data)); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SendWormholes signs the transaction carrying w with key and submits it.
+// The nonce, gas price and gas limit are filled in from the pending state.
+func (ec *Client) SendWormholes(ctx context.Context, key *ecdsa.PrivateKey, to common.Address, value *big.Int, w *types.Wormholes) (*types.Transaction, error) {
+	data, err := Encode(w)
+	if err != nil {
+		return nil, err
+	}
+	client := ethclient.NewClient(ec.c)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		value = new(big.Int)
+	}
+	gas, err := client.EstimateGas(ctx, ethereum.CallMsg{
+		From:     from,
+		To:       &to,
+		GasPrice: gasPrice,
+		Value:    value,
+		Data:     data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.LegacyTx{
+		Nonce:    nonce,
+		To:       &to,
+		Value:    value,
+		Gas:      gas,
+		GasPrice: gasPrice,
+		Data:     data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tx, client.SendTransaction(ctx, tx)
+}
+
+func toBlockNumArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+	pending := big.NewInt(-1)
+	if number.Cmp(pending) == 0 {
+		return "pending"
+	}
+	return hexutil.EncodeBig(number)
+}
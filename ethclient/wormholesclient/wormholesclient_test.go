@@ -0,0 +1,160 @@
+package wormholesclient
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	testKey, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	testAddr    = crypto.PubkeyToAddress(testKey.PublicKey)
+	testBalance = new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))
+
+	testValidators = []common.Address{
+		common.HexToAddress("0x091DBBa95B26793515cc9aCB9bEb5124c479f27F"),
+		common.HexToAddress("0x107837Ea83f8f06533DDd3fC39451Cd0AA8DA8BD"),
+		common.HexToAddress("0x612DFa56DcA1F581Ed34b9c60Da86f1268Ab6349"),
+	}
+	testExchangers = []common.Address{
+		common.HexToAddress("0x68B14e0F18C3EE322d3e613fF63B87E56D86Df60"),
+		common.HexToAddress("0xeEF79493F62dA884389312d16669455A7E0045c1"),
+	}
+)
+
+func newTestBackend(t *testing.T) *node.Node {
+	validatorBalance := new(big.Int).Mul(big.NewInt(70000), big.NewInt(params.Ether))
+	exchangerBalance := new(big.Int).Mul(big.NewInt(280), big.NewInt(params.Ether))
+	genesis := &core.Genesis{
+		Config:       params.AllEthashProtocolChanges,
+		GasLimit:     10000000,
+		Difficulty:   big.NewInt(1),
+		Alloc:        core.GenesisAlloc{testAddr: {Balance: testBalance}},
+		Stake:        core.GenesisAlloc{},
+		Validator:    core.GenesisAlloc{},
+		Dir:          "/ipfs/QmS2U6Mu2X5HaUbrbVp6JoLmdcFphXiD98avZnq1My8vef",
+		InjectNumber: 4096,
+		StartIndex:   big.NewInt(0),
+		Royalty:      100,
+		Creator:      "0x35636d53Ac3DfF2b2347dDfa37daD7077b3f5b6F",
+	}
+	for _, addr := range testValidators {
+		genesis.Alloc[addr] = core.GenesisAccount{Balance: validatorBalance}
+		genesis.Validator[addr] = core.GenesisAccount{Balance: validatorBalance, Proxy: common.Address{}.Hex()}
+	}
+	for _, addr := range testExchangers {
+		genesis.Alloc[addr] = core.GenesisAccount{Balance: exchangerBalance}
+		genesis.Stake[addr] = core.GenesisAccount{Balance: exchangerBalance, FeeRate: 250, ExchangerName: "exchanger", ExchangerUrl: "www.wormholesexchanger.com"}
+	}
+	n, err := node.New(&node.Config{})
+	if err != nil {
+		t.Fatalf("can't create new node: %v", err)
+	}
+	config := &ethconfig.Config{Genesis: genesis}
+	config.Ethash.PowMode = ethash.ModeFake
+	if _, err := eth.New(n, config); err != nil {
+		t.Fatalf("can't create new ethereum service: %v", err)
+	}
+	if err := n.Start(); err != nil {
+		t.Fatalf("can't start test node: %v", err)
+	}
+	return n
+}
+
+func TestWormholesClient(t *testing.T) {
+	backend := newTestBackend(t)
+	client, err := backend.Attach()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer backend.Close()
+	defer client.Close()
+
+	ec := New(client)
+	ctx := context.Background()
+	genesis := big.NewInt(0)
+
+	version, err := ec.Version(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "wormholes v" + params.Version; version != want {
+		t.Errorf("version mismatch: have %s, want %s", version, want)
+	}
+
+	validators, err := ec.GetValidator(ctx, genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(validators.Validators) != len(testValidators) {
+		t.Fatalf("validator count mismatch: have %d, want %d", len(validators.Validators), len(testValidators))
+	}
+	for _, addr := range testValidators {
+		if !validators.Exist(addr) {
+			t.Errorf("validator %x missing", addr)
+		}
+	}
+	if n, err := ec.GetValidatorLen(ctx, genesis); err != nil || n != len(testValidators) {
+		t.Errorf("validator len mismatch: have %d, want %d, err %v", n, len(testValidators), err)
+	}
+	stakers, err := ec.GetStaker(ctx, genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stakers.DBStakers) != len(testExchangers) {
+		t.Errorf("staker count mismatch: have %d, want %d", len(stakers.DBStakers), len(testExchangers))
+	}
+
+	account, err := ec.GetAccountInfo(ctx, testExchangers[0], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !account.ExchangerFlag || account.FeeRate != 250 {
+		t.Errorf("exchanger account mismatch: flag %v, fee rate %d", account.ExchangerFlag, account.FeeRate)
+	}
+	page, err := ec.ListExchangers(ctx, genesis, 0, 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != len(testExchangers) || len(page.Exchangers) != 1 || page.NextCursor == nil {
+		t.Errorf("exchanger page mismatch: total %d, len %d, next %v", page.Total, len(page.Exchangers), page.NextCursor)
+	}
+
+	// Sign a mint and let the node decode it
+	data, err := Encode(Mint(100, "ipfs://meta", testExchangers[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := types.SignNewTx(testKey, types.LatestSignerForChainID(params.AllEthashProtocolChanges.ChainID), &types.LegacyTx{
+		To:       &testAddr,
+		Gas:      params.WormholesTx0 + 10000,
+		GasPrice: big.NewInt(params.InitialBaseFee),
+		Data:     data,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := ec.DecodeTransaction(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Error != "" {
+		t.Errorf("unexpected decoding error: %s", decoded.Error)
+	}
+	if decoded.From == nil || *decoded.From != testAddr {
+		t.Errorf("sender mismatch: have %v, want %x", decoded.From, testAddr)
+	}
+	if decoded.Wormholes == nil || decoded.Wormholes.Type != 0 || decoded.Wormholes.MetaURL != "ipfs://meta" {
+		t.Errorf("wormholes mismatch: %+v", decoded.Wormholes)
+	}
+}
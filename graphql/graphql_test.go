@@ -17,6 +17,7 @@
 package graphql
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/ethdb"
@@ -594,3 +595,140 @@ func createGQLServiceWithTransactions(t *testing.T, stack *node.Node) {
 		t.Fatalf("could not create graphql service: %v", err)
 	}
 }
+
+func TestGraphQLWormholes(t *testing.T) {
+	stack, err := node.New(&node.Config{
+		HTTPHost: "127.0.0.1",
+		HTTPPort: 0,
+		WSHost:   "127.0.0.1",
+		WSPort:   0,
+	})
+	if err != nil {
+		t.Fatalf("could not create node: %v", err)
+	}
+	defer stack.Close()
+	createGQLServiceWithWormholes(t, stack)
+	if err := stack.Start(); err != nil {
+		t.Fatalf("could not start node: %v", err)
+	}
+
+	for i, tt := range []struct {
+		body string
+		want string
+	}{
+		{
+			body: `{"query": "{block {transactions { wormholes { type royalty metaURL nftAddress version } } nft(address: \"0x0000000000000000000000000000000000000001\") { owner { address } creator royalty metaURL mergeLevel exchanger { address } } }}"}`,
+			want: `{"data":{"block":{"transactions":[{"wormholes":{"type":0,"royalty":100,"metaURL":"/ipfs/test","nftAddress":null,"version":"v0.0.1"}}],"nft":{"owner":{"address":"0x71562b71999873db5b286df957af199ec94617f7"},"creator":"0x71562b71999873db5b286df957af199ec94617f7","royalty":100,"metaURL":"/ipfs/test","mergeLevel":0,"exchanger":null}}}}`,
+		},
+		{
+			body: `{"query": "{block {nfts(owner: \"0x71562b71999873db5b286df957af199ec94617f7\") { nfts { address } nextCursor } missing: nft(address: \"0x0000000000000000000000000000000000000002\") { address } }}"}`,
+			want: `{"data":{"block":{"nfts":{"nfts":[{"address":"0x0000000000000000000000000000000000000001"}],"nextCursor":null},"missing":null}}}`,
+		},
+		{
+			body: `{"query": "{block(number: 0) {nfts { nfts { address } } }}"}`,
+			want: `{"data":{"block":{"nfts":{"nfts":[]}}}}`,
+		},
+	} {
+		resp, err := http.Post(fmt.Sprintf("%s/graphql", stack.HTTPEndpoint()), "application/json", strings.NewReader(tt.body))
+		if err != nil {
+			t.Fatalf("could not post: %v", err)
+		}
+		bodyBytes, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("could not read from response body: %v", err)
+		}
+		if have := string(bodyBytes); have != tt.want {
+			t.Errorf("testcase %d %s,\nhave:\n%v\nwant:\n%v", i, tt.body, have, tt.want)
+		}
+	}
+
+	// The pools are checked against the genesis allocation
+	resp, err := http.Post(fmt.Sprintf("%s/graphql", stack.HTTPEndpoint()), "application/json",
+		strings.NewReader(`{"query": "{block {validators { address balance } stakers { address balance } exchangers { address } }}"}`))
+	if err != nil {
+		t.Fatalf("could not post: %v", err)
+	}
+	var result struct {
+		Data struct {
+			Block struct {
+				Validators []struct{ Address common.Address }
+				Stakers    []struct{ Address common.Address }
+				Exchangers []struct{ Address common.Address }
+			}
+		}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	if have, want := len(result.Data.Block.Validators), len(decodePreWormholesInfoV2(simValidatorData_v2)); have != want {
+		t.Errorf("validator count mismatch: have %d, want %d", have, want)
+	}
+	if have, want := len(result.Data.Block.Stakers), len(decodePreWormholesInfoV3(simStakeData)); have != want {
+		t.Errorf("staker count mismatch: have %d, want %d", have, want)
+	}
+	if have, want := len(result.Data.Block.Exchangers), len(decodePreWormholesInfoV3(simStakeData)); have != want {
+		t.Errorf("exchanger count mismatch: have %d, want %d", have, want)
+	}
+}
+
+func createGQLServiceWithWormholes(t *testing.T, stack *node.Node) {
+	key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	ethConf := &ethconfig.Config{
+		Genesis: &core.Genesis{
+			Config:       params.AllEthashProtocolChanges,
+			ExtraData:    make([]byte, 32),
+			GasLimit:     10000000,
+			BaseFee:      big.NewInt(params.InitialBaseFee),
+			Difficulty:   big.NewInt(1),
+			Alloc:        decodePreWormholesInfo(simAllocData),
+			Stake:        decodePreWormholesInfoV3(simStakeData),
+			Validator:    decodePreWormholesInfoV2(simValidatorData_v2),
+			Dir:          "/ipfs/QmS2U6Mu2X5HaUbrbVp6JoLmdcFphXiD98avZnq1My8vef",
+			InjectNumber: 4096,
+			StartIndex:   big.NewInt(0),
+			Royalty:      100,
+			Creator:      "0x35636d53Ac3DfF2b2347dDfa37daD7077b3f5b6F",
+		},
+		Ethash: ethash.Config{
+			PowMode: ethash.ModeFake,
+		},
+		NetworkId:               1337,
+		TrieCleanCache:          5,
+		TrieCleanCacheJournal:   "triecache",
+		TrieCleanCacheRejournal: 60 * time.Minute,
+		TrieDirtyCache:          5,
+		TrieTimeout:             60 * time.Minute,
+		SnapshotCache:           5,
+	}
+	ethBackend, err := eth.New(stack, ethConf)
+	if err != nil {
+		t.Fatalf("could not create eth backend: %v", err)
+	}
+	signer := types.LatestSigner(ethConf.Genesis.Config)
+	mintTx, _ := types.SignNewTx(key, signer, &types.LegacyTx{
+		Nonce:    uint64(0),
+		To:       &address,
+		Value:    big.NewInt(0),
+		Gas:      100000,
+		GasPrice: big.NewInt(params.InitialBaseFee),
+		Data:     []byte(`wormholes:{"type":0,"royalty":100,"meta_url":"/ipfs/test","version":"v0.0.1"}`),
+	})
+
+	// Mint the first user nft in the first block
+	bc := ethBackend.BlockChain()
+	chain, _ := core.GenerateChain(params.AllEthashProtocolChanges, bc.Genesis(),
+		ethash.NewFaker(), ethBackend.ChainDb(), 1, func(i int, b *core.BlockGen) {
+			b.SetCoinbase(common.Address{1})
+			b.LoadWormholesPools(bc)
+			b.AddTxWithChain(bc, mintTx)
+		})
+	if _, err := bc.InsertChain(chain); err != nil {
+		t.Fatalf("could not create import blocks: %v", err)
+	}
+	// create gql service
+	if err := New(stack, ethBackend.APIBackend, []string{}, []string{}); err != nil {
+		t.Fatalf("could not create graphql service: %v", err)
+	}
+}
@@ -138,6 +138,132 @@ const schema string = `
         #Envelope transaction support
         type: Int
         accessList: [AccessTuple!]
+        # Wormholes is the wormholes operation decoded from the input data of
+        # the transaction, null if the transaction is not a wormholes transaction.
+        wormholes: WormholesOperation
+    }
+
+    # WormholesOperation is a native nft, exchanger or staking operation of a
+    # wormholes transaction. Fields not used by the operation are empty.
+    type WormholesOperation {
+        # Type is the type of the operation, e.g. 0 mints a nft and 1 transfers one.
+        type: Int!
+        # NFTAddress is the address of the nft the operation applies to.
+        nftAddress: Address
+        # Exchanger is the exchanger of a minted or traded nft.
+        exchanger: Address
+        # Royalty is the royalty of a minted nft, in ten thousandths.
+        royalty: Int!
+        # MetaURL is the metadata url of a minted nft.
+        metaURL: String!
+        # FeeRate is the fee rate of an exchanger, in ten thousandths.
+        feeRate: Int!
+        # Name is the name of an exchanger.
+        name: String!
+        # URL is the url of an exchanger.
+        url: String!
+        # Dir is the metadata directory of an official nft proposal.
+        dir: String!
+        # StartIndex is the first index of an official nft proposal.
+        startIndex: String!
+        # Number is the number of snfts of an official nft proposal.
+        number: Long!
+        # Creator is the creator of an official nft proposal or of an allowed collection.
+        creator: Address
+        # ProxyAddress is the proxy of a staking operation.
+        proxyAddress: Address
+        # RewardFlag is the reward method chosen by a validator.
+        rewardFlag: Int!
+        # ProposalID is the official nft proposal a vote is cast on.
+        proposalID: Long!
+        # Amount is the number of snft shares transferred.
+        amount: String!
+        # Version is the version of the payload.
+        version: String!
+        # Payload is the JSON payload of the operation, including the signed
+        # orders of the trading operations.
+        payload: String!
+    }
+
+    # NFT is a nft or a snft at a particular block.
+    type NFT {
+        # Address is the address of the nft.
+        address: Address!
+        # Owner is the account owning the nft.
+        owner: Account!
+        # Creator is the address of the creator of the nft.
+        creator: Address!
+        # Royalty is the royalty paid to the creator, in ten thousandths.
+        royalty: Int!
+        # Exchanger is the exchanger the nft was minted on, null if none.
+        exchanger: Exchanger
+        # ApprovedAddress is the address approved to transfer the nft, null if none.
+        approvedAddress: Address
+        # MergeLevel is the level of a merged snft, 0 if it is not merged.
+        mergeLevel: Int!
+        # MergeNumber is the number of snfts merged into the snft.
+        mergeNumber: Long!
+        # MetaURL is the url of the metadata of the nft.
+        metaURL: String!
+        # MetaURLFrozen is true once the creator froze the metadata.
+        metaURLFrozen: Boolean!
+    }
+
+    # NFTPage is a page of nfts in mint order.
+    type NFTPage {
+        # NFTs is the list of nfts of the page.
+        nfts: [NFT!]!
+        # NextCursor is the cursor of the next page, null on the last page.
+        nextCursor: Long
+    }
+
+    # Exchanger is an exchanger account at a particular block.
+    type Exchanger {
+        # Address is the address of the exchanger.
+        address: Address!
+        # Account is the account of the exchanger.
+        account: Account!
+        # Name is the name of the exchanger.
+        name: String!
+        # URL is the url of the exchanger.
+        url: String!
+        # FeeRate is the default fee rate of the exchanger, in ten thousandths.
+        feeRate: Int!
+        # Balance is the balance of the account of the exchanger, in wei.
+        balance: BigInt!
+        # StakedBalance is the balance staked to open the exchanger, in wei.
+        stakedBalance: BigInt!
+        # OpenBlockNumber is the block the exchanger was opened at.
+        openBlockNumber: Long!
+        # Volume is the trade volume of the exchanger since it was opened, null
+        # if the exchanger index is not available at the block.
+        volume: BigInt
+    }
+
+    # Validator is a member of the validator pool at a particular block.
+    type Validator {
+        # Address is the address of the validator.
+        address: Address!
+        # Account is the account of the validator.
+        account: Account!
+        # Proxy is the proxy signing for the validator, null if none.
+        proxy: Address
+        # Balance is the balance staked by the validator, in wei.
+        balance: BigInt!
+        # Coefficient is the online coefficient of the validator.
+        coefficient: Int!
+        # BLSPubKey is the bls public key registered by the validator, null if none.
+        blsPubKey: Bytes
+    }
+
+    # Staker is an account staking to open an exchanger at a particular block.
+    type Staker {
+        # Address is the address of the staker.
+        address: Address!
+        # Account is the account of the staker.
+        account: Account!
+        # Balance is the balance staked by the staker, in wei.
+        balance: BigInt!
     }
 
     # BlockFilterCriteria encapsulates log filter criteria for a filter applied
@@ -231,6 +357,24 @@ const schema string = `
         # EstimateGas estimates the amount of gas that will be required for
         # successful execution of a transaction at the current block's state.
         estimateGas(data: CallData!): Long!
+        # NFT fetches a nft at the current block's state, null if it doesn't exist.
+        nft(address: Address!): NFT
+        # NFTs returns a page of the nfts at the current block's state, in mint
+        # order. Official snfts are listed if official is true, user minted nfts
+        # otherwise. Only the nfts of owner are listed if it is supplied. cursor
+        # is the mint index to start from, a page holds at most limit nfts.
+        # Snfts merged into a higher level are not listed.
+        nfts(owner: Address, official: Boolean, cursor: Long, limit: Int): NFTPage!
+        # Exchanger fetches an exchanger at the current block's state, null if
+        # the exchanger is not open.
+        exchanger(address: Address!): Exchanger
+        # Exchangers returns the open exchangers at the current block, ordered by address.
+        exchangers: [Exchanger!]!
+        # Validators returns the validator pool at the current block.
+        validators: [Validator!]!
+        # Stakers returns the stakers of the open exchangers at the current
+        # block, ordered by address.
+        stakers: [Staker!]!
     }
 
     # CallData represents the data associated with a local contract call.
@@ -0,0 +1,582 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	defaultNFTPageLimit = 20
+	maxNFTPageLimit     = 100
+
+	// maxNFTPageScan is the maximum number of mint indexes scanned for a
+	// page of nfts, a shorter page is returned if it is reached.
+	maxNFTPageScan = 10000
+)
+
+// officialNFTMask is the address of the first official snft
+var officialNFTMask, _ = new(big.Int).SetString("8000000000000000000000000000000000000000", 16)
+
+// WormholesOperation represents the wormholes operation of a transaction.
+type WormholesOperation struct {
+	wormholes *types.Wormholes
+	payload   []byte
+}
+
+// optionalAddress returns nil if hex is not a valid hex address
+func optionalAddress(hex string) *common.Address {
+	if !common.IsHexAddress(hex) {
+		return nil
+	}
+	addr := common.HexToAddress(hex)
+	return &addr
+}
+
+func (w *WormholesOperation) Type() int32 {
+	return int32(w.wormholes.Type)
+}
+
+func (w *WormholesOperation) NFTAddress() *common.Address {
+	return optionalAddress(w.wormholes.NFTAddress)
+}
+
+func (w *WormholesOperation) Exchanger() *common.Address {
+	return optionalAddress(w.wormholes.Exchanger)
+}
+
+func (w *WormholesOperation) Royalty() int32 {
+	return int32(w.wormholes.Royalty)
+}
+
+func (w *WormholesOperation) MetaURL() string {
+	return w.wormholes.MetaURL
+}
+
+func (w *WormholesOperation) FeeRate() int32 {
+	return int32(w.wormholes.FeeRate)
+}
+
+func (w *WormholesOperation) Name() string {
+	return w.wormholes.Name
+}
+
+func (w *WormholesOperation) URL() string {
+	return w.wormholes.Url
+}
+
+func (w *WormholesOperation) Dir() string {
+	return w.wormholes.Dir
+}
+
+func (w *WormholesOperation) StartIndex() string {
+	return w.wormholes.StartIndex
+}
+
+func (w *WormholesOperation) Number() Long {
+	return Long(w.wormholes.Number)
+}
+
+func (w *WormholesOperation) Creator() *common.Address {
+	return optionalAddress(w.wormholes.Creator)
+}
+
+func (w *WormholesOperation) ProxyAddress() *common.Address {
+	return optionalAddress(w.wormholes.ProxyAddress)
+}
+
+func (w *WormholesOperation) RewardFlag() int32 {
+	return int32(w.wormholes.RewardFlag)
+}
+
+func (w *WormholesOperation) ProposalID() Long {
+	return Long(w.wormholes.ProposalID)
+}
+
+func (w *WormholesOperation) Amount() string {
+	return w.wormholes.Amount
+}
+
+func (w *WormholesOperation) Version() string {
+	return w.wormholes.Version
+}
+
+func (w *WormholesOperation) Payload() string {
+	return string(w.payload)
+}
+
+func (t *Transaction) Wormholes(ctx context.Context) (*WormholesOperation, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	data := tx.Data()
+	if len(data) <= 10 || string(data[:10]) != "wormholes:" {
+		return nil, nil
+	}
+	payload := data[10:]
+	var wormholes types.Wormholes
+	if err := json.Unmarshal(payload, &wormholes); err != nil {
+		return nil, nil
+	}
+	return &WormholesOperation{wormholes: &wormholes, payload: payload}, nil
+}
+
+// NFT represents a nft or a snft at a particular block.
+type NFT struct {
+	backend       ethapi.Backend
+	address       common.Address
+	blockNrOrHash rpc.BlockNumberOrHash
+}
+
+// getState fetches the StateDB object for a nft.
+func (n *NFT) getState(ctx context.Context) (*state.StateDB, error) {
+	state, _, err := n.backend.StateAndHeaderByNumberOrHash(ctx, n.blockNrOrHash)
+	return state, err
+}
+
+func (n *NFT) Address() common.Address {
+	return n.address
+}
+
+func (n *NFT) Owner(ctx context.Context) (*Account, error) {
+	state, err := n.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		backend:       n.backend,
+		address:       state.GetNFTOwner16(n.address),
+		blockNrOrHash: n.blockNrOrHash,
+	}, nil
+}
+
+func (n *NFT) Creator(ctx context.Context) (common.Address, error) {
+	state, err := n.getState(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return state.GetNFTCreator(n.address), nil
+}
+
+func (n *NFT) Royalty(ctx context.Context) (int32, error) {
+	state, err := n.getState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(state.GetNFTRoyalty(n.address)), nil
+}
+
+func (n *NFT) Exchanger(ctx context.Context) (*Exchanger, error) {
+	state, header, err := n.backend.StateAndHeaderByNumberOrHash(ctx, n.blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	exchanger := state.GetNFTExchanger(n.address)
+	if exchanger == (common.Address{}) {
+		return nil, nil
+	}
+	return newExchanger(n.backend, exchanger, n.blockNrOrHash, header), nil
+}
+
+func (n *NFT) ApprovedAddress(ctx context.Context) (*common.Address, error) {
+	state, err := n.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	approved := state.GetNFTApproveAddress(n.address)
+	if approved == (common.Address{}) {
+		return nil, nil
+	}
+	return &approved, nil
+}
+
+func (n *NFT) MergeLevel(ctx context.Context) (int32, error) {
+	state, err := n.getState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(state.GetNFTMergeLevel(n.address)), nil
+}
+
+func (n *NFT) MergeNumber(ctx context.Context) (Long, error) {
+	state, err := n.getState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return Long(state.GetMergeNumber(n.address)), nil
+}
+
+func (n *NFT) MetaURL(ctx context.Context) (string, error) {
+	state, err := n.getState(ctx)
+	if err != nil {
+		return "", err
+	}
+	return state.GetNFTMetaURL(n.address), nil
+}
+
+func (n *NFT) MetaURLFrozen(ctx context.Context) (bool, error) {
+	state, err := n.getState(ctx)
+	if err != nil {
+		return false, err
+	}
+	return state.GetNFTMetaURLFrozen(n.address), nil
+}
+
+// NFTPage represents a page of nfts in mint order.
+type NFTPage struct {
+	nfts       []*NFT
+	nextCursor *Long
+}
+
+func (p *NFTPage) NFTs() []*NFT {
+	return p.nfts
+}
+
+func (p *NFTPage) NextCursor() *Long {
+	return p.nextCursor
+}
+
+// Exchanger represents an exchanger account at a particular block.
+type Exchanger struct {
+	backend       ethapi.Backend
+	address       common.Address
+	blockNrOrHash rpc.BlockNumberOrHash
+	volume        *big.Int
+}
+
+// newExchanger returns the exchanger at address, its volume is read from the
+// exchanger index of header if available.
+func newExchanger(backend ethapi.Backend, address common.Address, blockNrOrHash rpc.BlockNumberOrHash, header *types.Header) *Exchanger {
+	exchanger := &Exchanger{
+		backend:       backend,
+		address:       address,
+		blockNrOrHash: blockNrOrHash,
+	}
+	if pool, err := rawdb.ReadExchangerPool(backend.ChainDb(), header.Hash(), header.Number.Uint64()); err == nil {
+		for _, v := range pool.Exchangers {
+			if v.Addr == address {
+				exchanger.volume = v.Volume
+				break
+			}
+		}
+	}
+	return exchanger
+}
+
+// getState fetches the StateDB object for an exchanger.
+func (e *Exchanger) getState(ctx context.Context) (*state.StateDB, error) {
+	state, _, err := e.backend.StateAndHeaderByNumberOrHash(ctx, e.blockNrOrHash)
+	return state, err
+}
+
+func (e *Exchanger) Address() common.Address {
+	return e.address
+}
+
+func (e *Exchanger) Account() *Account {
+	return &Account{
+		backend:       e.backend,
+		address:       e.address,
+		blockNrOrHash: e.blockNrOrHash,
+	}
+}
+
+func (e *Exchanger) Name(ctx context.Context) (string, error) {
+	state, err := e.getState(ctx)
+	if err != nil {
+		return "", err
+	}
+	return state.GetExchangerName(e.address), nil
+}
+
+func (e *Exchanger) URL(ctx context.Context) (string, error) {
+	state, err := e.getState(ctx)
+	if err != nil {
+		return "", err
+	}
+	return state.GetExchangerURL(e.address), nil
+}
+
+func (e *Exchanger) FeeRate(ctx context.Context) (int32, error) {
+	state, header, err := e.backend.StateAndHeaderByNumberOrHash(ctx, e.blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+	return int32(state.GetExchangerFeeRate(e.address, common.Address{}, header.Number)), nil
+}
+
+func (e *Exchanger) Balance(ctx context.Context) (hexutil.Big, error) {
+	state, err := e.getState(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*state.GetBalance(e.address)), nil
+}
+
+func (e *Exchanger) StakedBalance(ctx context.Context) (hexutil.Big, error) {
+	state, err := e.getState(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*state.GetExchangerBalance(e.address)), nil
+}
+
+func (e *Exchanger) OpenBlockNumber(ctx context.Context) (Long, error) {
+	state, err := e.getState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	number := state.GetAccountInfo(e.address).BlockNumber
+	if number == nil {
+		return 0, nil
+	}
+	return Long(number.Uint64()), nil
+}
+
+func (e *Exchanger) Volume() *hexutil.Big {
+	return (*hexutil.Big)(e.volume)
+}
+
+// Validator represents a member of the validator pool at a particular block.
+type Validator struct {
+	backend       ethapi.Backend
+	validator     *types.Validator
+	blockNrOrHash rpc.BlockNumberOrHash
+}
+
+func (v *Validator) Address() common.Address {
+	return v.validator.Addr
+}
+
+func (v *Validator) Account() *Account {
+	return &Account{
+		backend:       v.backend,
+		address:       v.validator.Addr,
+		blockNrOrHash: v.blockNrOrHash,
+	}
+}
+
+func (v *Validator) Proxy() *common.Address {
+	if v.validator.Proxy == (common.Address{}) {
+		return nil
+	}
+	proxy := v.validator.Proxy
+	return &proxy
+}
+
+func (v *Validator) Balance() hexutil.Big {
+	return hexutil.Big(*v.validator.Balance)
+}
+
+func (v *Validator) Coefficient(ctx context.Context) (int32, error) {
+	state, _, err := v.backend.StateAndHeaderByNumberOrHash(ctx, v.blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+	return int32(state.GetValidatorCoefficient(v.validator.Addr)), nil
+}
+
+func (v *Validator) BLSPubKey() *hexutil.Bytes {
+	if len(v.validator.BLSPubKey) == 0 {
+		return nil
+	}
+	key := hexutil.Bytes(v.validator.BLSPubKey)
+	return &key
+}
+
+// Staker represents an account staking to open an exchanger at a particular block.
+type Staker struct {
+	backend       ethapi.Backend
+	address       common.Address
+	blockNrOrHash rpc.BlockNumberOrHash
+}
+
+func (s *Staker) Address() common.Address {
+	return s.address
+}
+
+func (s *Staker) Account() *Account {
+	return &Account{
+		backend:       s.backend,
+		address:       s.address,
+		blockNrOrHash: s.blockNrOrHash,
+	}
+}
+
+func (s *Staker) Balance(ctx context.Context) (hexutil.Big, error) {
+	state, _, err := s.backend.StateAndHeaderByNumberOrHash(ctx, s.blockNrOrHash)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*state.GetExchangerBalance(s.address)), nil
+}
+
+// resolveState returns the state after the block, along with its header and
+// the reference the accounts of the block are resolved at.
+func (b *Block) resolveState(ctx context.Context) (*state.StateDB, *types.Header, rpc.BlockNumberOrHash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header == nil {
+		return nil, nil, rpc.BlockNumberOrHash{}, err
+	}
+	blockNrOrHash := rpc.BlockNumberOrHashWithHash(header.Hash(), false)
+	st, _, err := b.backend.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if st == nil || err != nil {
+		return nil, nil, rpc.BlockNumberOrHash{}, err
+	}
+	return st, header, blockNrOrHash, nil
+}
+
+func (b *Block) NFT(ctx context.Context, args struct{ Address common.Address }) (*NFT, error) {
+	st, _, blockNrOrHash, err := b.resolveState(ctx)
+	if st == nil || err != nil {
+		return nil, err
+	}
+	if st.GetNFTOwner16(args.Address) == (common.Address{}) {
+		return nil, nil
+	}
+	return &NFT{
+		backend:       b.backend,
+		address:       args.Address,
+		blockNrOrHash: blockNrOrHash,
+	}, nil
+}
+
+func (b *Block) NFTs(ctx context.Context, args struct {
+	Owner    *common.Address
+	Official *bool
+	Cursor   *Long
+	Limit    *int32
+}) (*NFTPage, error) {
+	page := &NFTPage{nfts: []*NFT{}}
+	st, header, blockNrOrHash, err := b.resolveState(ctx)
+	if st == nil || err != nil {
+		return page, err
+	}
+	// The mint deep holds the next indexes to mint, it is not stored for
+	// the genesis block where no nft has been minted yet.
+	mintDeep, err := rawdb.ReadMintDeep(b.backend.ChainDb(), header.Hash(), header.Number.Uint64())
+	if err != nil {
+		return page, nil
+	}
+	base, first, end := new(big.Int), uint64(1), mintDeep.UserMint
+	if args.Official != nil && *args.Official {
+		base, first, end = officialNFTMask, 0, new(big.Int).Sub(mintDeep.OfficialMint, officialNFTMask)
+	}
+	if !end.IsUint64() {
+		return page, nil
+	}
+	limit := defaultNFTPageLimit
+	if args.Limit != nil && *args.Limit > 0 {
+		limit = int(*args.Limit)
+		if limit > maxNFTPageLimit {
+			limit = maxNFTPageLimit
+		}
+	}
+	index := first
+	if args.Cursor != nil && uint64(*args.Cursor) > first {
+		index = uint64(*args.Cursor)
+	}
+	for scanned := 0; index < end.Uint64() && len(page.nfts) < limit && scanned < maxNFTPageScan; index, scanned = index+1, scanned+1 {
+		address := common.BigToAddress(new(big.Int).Add(base, new(big.Int).SetUint64(index)))
+		owner := st.GetNFTOwner16(address)
+		if owner == (common.Address{}) || (args.Owner != nil && owner != *args.Owner) {
+			continue
+		}
+		page.nfts = append(page.nfts, &NFT{
+			backend:       b.backend,
+			address:       address,
+			blockNrOrHash: blockNrOrHash,
+		})
+	}
+	if index < end.Uint64() {
+		next := Long(index)
+		page.nextCursor = &next
+	}
+	return page, nil
+}
+
+func (b *Block) Exchanger(ctx context.Context, args struct{ Address common.Address }) (*Exchanger, error) {
+	st, header, blockNrOrHash, err := b.resolveState(ctx)
+	if st == nil || err != nil {
+		return nil, err
+	}
+	if !st.GetExchangerFlag(args.Address) {
+		return nil, nil
+	}
+	return newExchanger(b.backend, args.Address, blockNrOrHash, header), nil
+}
+
+func (b *Block) Exchangers(ctx context.Context) ([]*Exchanger, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header == nil {
+		return []*Exchanger{}, err
+	}
+	pool, err := rawdb.ReadExchangerPool(b.backend.ChainDb(), header.Hash(), header.Number.Uint64())
+	if err != nil {
+		return []*Exchanger{}, nil
+	}
+	blockNrOrHash := rpc.BlockNumberOrHashWithHash(header.Hash(), false)
+	exchangers := make([]*Exchanger, 0, len(pool.Exchangers))
+	for _, v := range pool.Exchangers {
+		exchangers = append(exchangers, &Exchanger{
+			backend:       b.backend,
+			address:       v.Addr,
+			blockNrOrHash: blockNrOrHash,
+			volume:        v.Volume,
+		})
+	}
+	return exchangers, nil
+}
+
+func (b *Block) Validators(ctx context.Context) ([]*Validator, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header == nil {
+		return []*Validator{}, err
+	}
+	pool, err := rawdb.ReadValidatorPool(b.backend.ChainDb(), header.Hash(), header.Number.Uint64())
+	if err != nil {
+		return []*Validator{}, nil
+	}
+	blockNrOrHash := rpc.BlockNumberOrHashWithHash(header.Hash(), false)
+	validators := make([]*Validator, 0, len(pool.Validators))
+	for _, v := range pool.Validators {
+		validators = append(validators, &Validator{
+			backend:       b.backend,
+			validator:     v,
+			blockNrOrHash: blockNrOrHash,
+		})
+	}
+	return validators, nil
+}
+
+// Stakers returns the stakers at the block, the stakes of the staker pool
+// are the balances staked by the open exchangers.
+func (b *Block) Stakers(ctx context.Context) ([]*Staker, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header == nil {
+		return []*Staker{}, err
+	}
+	pool, err := rawdb.ReadExchangerPool(b.backend.ChainDb(), header.Hash(), header.Number.Uint64())
+	if err != nil {
+		return []*Staker{}, nil
+	}
+	blockNrOrHash := rpc.BlockNumberOrHashWithHash(header.Hash(), false)
+	stakers := make([]*Staker, 0, len(pool.Exchangers))
+	for _, v := range pool.Exchangers {
+		stakers = append(stakers, &Staker{
+			backend:       b.backend,
+			address:       v.Addr,
+			blockNrOrHash: blockNrOrHash,
+		})
+	}
+	return stakers, nil
+}
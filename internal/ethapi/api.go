@@ -744,7 +744,7 @@ func (s *PublicBlockChainAPI) GetBlockBeneficiaryAddressByNumber(ctx context.Con
 	}
 
 	header := block.Header()
-	istanbulExtra, err := types.ExtractIstanbulExtra(header)
+	istanbulExtra, err := types.ExtractWormholesExtra(header)
 	if err != nil {
 		return nil, err
 	}
@@ -1088,7 +1088,7 @@ func (s *PublicBlockChainAPI) GetRealParticipantsByNumber(ctx context.Context, n
 	}
 
 	header := block.Header()
-	istanbulExtra, err := types.ExtractIstanbulExtra(header)
+	istanbulExtra, err := types.ExtractWormholesExtra(header)
 	if err != nil {
 		return nil, err
 	}
@@ -1479,6 +1479,12 @@ func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash
 	if err := overrides.Apply(state); err != nil {
 		return nil, err
 	}
+	return applyCall(ctx, b, args, state, header, &vm.Config{NoBaseFee: true}, timeout, globalGasCap)
+}
+
+// applyCall executes the given transaction on top of the state with the given
+// evm configuration.
+func applyCall(ctx context.Context, b Backend, args TransactionArgs, state *state.StateDB, header *types.Header, vmConfig *vm.Config, timeout time.Duration, globalGasCap uint64) (*core.ExecutionResult, error) {
 	// Setup context so it may be cancelled the call has completed
 	// or, in case of unmetered gas, setup a context with a timeout.
 	var cancel context.CancelFunc
@@ -1496,7 +1502,7 @@ func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash
 	if err != nil {
 		return nil, err
 	}
-	evm, vmError, err := b.GetEVM(ctx, msg, state, header, &vm.Config{NoBaseFee: true})
+	evm, vmError, err := b.GetEVM(ctx, msg, state, header, vmConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -1569,6 +1575,9 @@ func (s *PublicBlockChainAPI) Call(ctx context.Context, args TransactionArgs, bl
 	if len(result.Revert()) > 0 {
 		return nil, newRevertError(result)
 	}
+	if wormholes, err := args.GetWormholes(); err == nil {
+		return result.Return(), newWormholesRevertError(wormholes.Type, result.Err)
+	}
 	return result.Return(), result.Err
 }
 
@@ -1617,44 +1626,12 @@ func DoEstimateGas(ctx context.Context, b Backend, args TransactionArgs, blockNr
 		balance := state.GetBalance(*args.From) // from can't be nil
 		available := new(big.Int).Set(balance)
 		if args.Value != nil {
-
-			wormholes, err := args.GetWormholes()
-			if err == nil {
-				switch wormholes.Type {
-				case 10:
-
-				case 14:
-
-				case 17:
-
-				case 18:
-
-				case 19:
-
-				case 20:
-
-				case 22:
-
-				case 24:
-
-				case 27:
-
-				case 28:
-
-				default:
-					if args.Value.ToInt().Cmp(available) >= 0 {
-						return 0, errors.New("insufficient funds for transfer")
-					}
-					available.Sub(available, args.Value.ToInt())
-				}
-
-			} else {
+			if wormholes, err := args.GetWormholes(); err != nil || wormholesSenderPaysValue(wormholes.Type) {
 				if args.Value.ToInt().Cmp(available) >= 0 {
 					return 0, errors.New("insufficient funds for transfer")
 				}
 				available.Sub(available, args.Value.ToInt())
 			}
-
 		}
 		allowance := new(big.Int).Div(available, feeCap)
 
@@ -1717,6 +1694,9 @@ func DoEstimateGas(ctx context.Context, b Backend, args TransactionArgs, blockNr
 				if len(result.Revert()) > 0 {
 					return 0, newRevertError(result)
 				}
+				if wormholes, err := args.GetWormholes(); err == nil {
+					return 0, newWormholesRevertError(wormholes.Type, result.Err)
+				}
 				return 0, result.Err
 			}
 			// Otherwise, the specified gas cap is too low
@@ -2104,10 +2084,11 @@ func AccessList(ctx context.Context, b Backend, blockNrOrHash rpc.BlockNumberOrH
 type PublicWormholesAPI struct {
 	b         Backend
 	nonceLock *AddrLocker
+	events    *wormholesEventHub
 }
 
 func NewPublicWormholesAPI(b Backend, nonceLock *AddrLocker) *PublicWormholesAPI {
-	return &PublicWormholesAPI{b, nonceLock}
+	return &PublicWormholesAPI{b, nonceLock, newWormholesEventHub(b)}
 }
 
 func (w *PublicWormholesAPI) QueryMinerProxy(ctx context.Context, number rpc.BlockNumber, addr common.Address) (MinerProxyList, error) {
@@ -2134,6 +2115,31 @@ func (w *PublicWormholesAPI) GetAccountInfo(ctx context.Context, address common.
 	return acc, st.Error()
 }
 
+// SNFTShareInfo is the balance of shares of a fractionalized snft
+type SNFTShareInfo struct {
+	NFTAddress common.Address `json:"nftAddress"`
+	Amount     *hexutil.Big   `json:"amount"`
+	Supply     *hexutil.Big   `json:"supply"`
+}
+
+// GetSNFTShares returns the shares of fractionalized snfts held by address.
+func (w *PublicWormholesAPI) GetSNFTShares(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]*SNFTShareInfo, error) {
+	st, _, err := w.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if st == nil || err != nil {
+		return nil, err
+	}
+	balances := st.GetSNFTShareBalances(address)
+	infos := make([]*SNFTShareInfo, 0, len(balances))
+	for _, v := range balances {
+		infos = append(infos, &SNFTShareInfo{
+			NFTAddress: v.NFTAddress,
+			Amount:     (*hexutil.Big)(v.Amount),
+			Supply:     (*hexutil.Big)(st.GetSNFTShareSupply(v.NFTAddress)),
+		})
+	}
+	return infos, st.Error()
+}
+
 func (w *PublicWormholesAPI) GetValidators(ctx context.Context, number rpc.BlockNumber) ([]common.Address, error) {
 	parent, err := w.b.BlockByNumber(ctx, number-1)
 	if err != nil {
@@ -2203,7 +2209,7 @@ func (w *PublicWormholesAPI) GetBlockBeneficiaryAddressByNumber(ctx context.Cont
 	}
 
 	header := block.Header()
-	istanbulExtra, err := types.ExtractIstanbulExtra(header)
+	istanbulExtra, err := types.ExtractWormholesExtra(header)
 	if err != nil {
 		return nil, err
 	}
@@ -2367,6 +2373,179 @@ func (w *PublicWormholesAPI) GetStakerLen(ctx context.Context, number rpc.BlockN
 	return len(stakeList.Stakers)
 }
 
+// OfficialNFTBallotInfo is a ballot of an official nft proposal, weighted by
+// the pledged balance of the voter
+type OfficialNFTBallotInfo struct {
+	Voter  common.Address `json:"voter"`
+	Weight *hexutil.Big   `json:"weight"`
+}
+
+// OfficialNFTProposalInfo is an official nft proposal with its tally
+type OfficialNFTProposalInfo struct {
+	ID            hexutil.Uint64           `json:"id"`
+	Proposer      common.Address           `json:"proposer"`
+	Dir           string                   `json:"dir"`
+	Number        uint64                   `json:"number"`
+	Royalty       uint16                   `json:"royalty"`
+	Creator       string                   `json:"creator"`
+	StartBlock    *hexutil.Big             `json:"startBlock"`
+	EndBlock      *hexutil.Big             `json:"endBlock"`
+	Open          bool                     `json:"open"`
+	ReachedQuorum bool                     `json:"reachedQuorum"`
+	Tally         *hexutil.Big             `json:"tally"`
+	Ballots       []*OfficialNFTBallotInfo `json:"ballots"`
+}
+
+// GetOfficialNFTProposals returns the official nft proposals which have not
+// been elected yet at the given block, ordered by ID.
+func (w *PublicWormholesAPI) GetOfficialNFTProposals(ctx context.Context, number rpc.BlockNumber) ([]*OfficialNFTProposalInfo, error) {
+	state, header, err := w.b.StateAndHeaderByNumber(ctx, number)
+	if state == nil || err != nil {
+		return nil, err
+	}
+
+	proposals, err := rawdb.ReadOfficialNFTProposals(w.b.ChainDb(), header.Hash(), header.Number.Uint64())
+	if err != nil {
+		proposals = types.NewOfficialNFTProposalList()
+	}
+	totalStake := big.NewInt(0)
+	if validators, err := rawdb.ReadValidatorPool(w.b.ChainDb(), header.Hash(), header.Number.Uint64()); err == nil {
+		totalStake = validators.TotalStakeBalance()
+	}
+
+	infos := make([]*OfficialNFTProposalInfo, 0, len(proposals.Proposals))
+	for _, p := range proposals.Proposals {
+		info := &OfficialNFTProposalInfo{
+			ID:            hexutil.Uint64(p.ID),
+			Proposer:      p.Address,
+			Dir:           p.Dir,
+			Number:        p.Number,
+			Royalty:       p.Royalty,
+			Creator:       p.Creator,
+			StartBlock:    (*hexutil.Big)(p.StartBlock),
+			EndBlock:      (*hexutil.Big)(p.EndBlock),
+			Open:          p.IsOpen(header.Number),
+			ReachedQuorum: p.ReachedQuorum(state.GetPledgedBalance, totalStake),
+			Tally:         (*hexutil.Big)(p.Tally(state.GetPledgedBalance)),
+			Ballots:       make([]*OfficialNFTBallotInfo, 0, len(p.Ballots)),
+		}
+		for _, ballot := range p.Ballots {
+			info.Ballots = append(info.Ballots, &OfficialNFTBallotInfo{
+				Voter:  ballot.Voter,
+				Weight: (*hexutil.Big)(state.GetPledgedBalance(ballot.Voter)),
+			})
+		}
+		infos = append(infos, info)
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos, nil
+}
+
+const (
+	defaultListExchangersLimit = 20
+	maxListExchangersLimit     = 100
+)
+
+// ExchangerInfo is an open exchanger returned by erb_listExchangers
+type ExchangerInfo struct {
+	Address         common.Address `json:"address"`
+	Name            string         `json:"name"`
+	URL             string         `json:"url"`
+	FeeRate         uint16         `json:"feeRate"`
+	StakedBalance   *hexutil.Big   `json:"stakedBalance"`
+	OpenBlockNumber *hexutil.Big   `json:"openBlockNumber"`
+	Volume          *hexutil.Big   `json:"volume"`
+}
+
+// ExchangerPage is a page of erb_listExchangers, NextCursor is nil on the last page
+type ExchangerPage struct {
+	Total      int              `json:"total"`
+	Exchangers []*ExchangerInfo `json:"exchangers"`
+	NextCursor *hexutil.Uint64  `json:"nextCursor"`
+}
+
+// ListExchangers returns a page of the open exchangers at the given block.
+// cursor is the position of the first exchanger of the page, sortBy is one of
+// "address" (default), "feeRate", "stakedBalance", "openBlockNumber" and "volume",
+// all orders except address are descending.
+func (w *PublicWormholesAPI) ListExchangers(ctx context.Context, number rpc.BlockNumber, cursor *hexutil.Uint64, limit *hexutil.Uint64, sortBy *string) (*ExchangerPage, error) {
+	st, header, err := w.b.StateAndHeaderByNumber(ctx, number)
+	if st == nil || err != nil {
+		return nil, err
+	}
+	exchangerPool, err := rawdb.ReadExchangerPool(w.b.ChainDb(), header.Hash(), header.Number.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("exchanger index not available at block %d", header.Number.Uint64())
+	}
+
+	exchangers := make([]*ExchangerInfo, 0, len(exchangerPool.Exchangers))
+	for _, v := range exchangerPool.Exchangers {
+		exchangers = append(exchangers, &ExchangerInfo{
+			Address:         v.Addr,
+			Name:            st.GetExchangerName(v.Addr),
+			URL:             st.GetExchangerURL(v.Addr),
+			FeeRate:         st.GetExchangerFeeRate(v.Addr, common.Address{}, header.Number),
+			StakedBalance:   (*hexutil.Big)(st.GetExchangerBalance(v.Addr)),
+			OpenBlockNumber: (*hexutil.Big)(v.OpenBlockNumber),
+			Volume:          (*hexutil.Big)(v.Volume),
+		})
+	}
+
+	// the index is sorted by address, a stable sort keeps it as the tie breaker
+	var less func(i, j int) bool
+	order := "address"
+	if sortBy != nil && *sortBy != "" {
+		order = *sortBy
+	}
+	switch order {
+	case "address":
+	case "feeRate":
+		less = func(i, j int) bool { return exchangers[i].FeeRate > exchangers[j].FeeRate }
+	case "stakedBalance":
+		less = func(i, j int) bool {
+			return exchangers[i].StakedBalance.ToInt().Cmp(exchangers[j].StakedBalance.ToInt()) > 0
+		}
+	case "openBlockNumber":
+		less = func(i, j int) bool {
+			return exchangers[i].OpenBlockNumber.ToInt().Cmp(exchangers[j].OpenBlockNumber.ToInt()) > 0
+		}
+	case "volume":
+		less = func(i, j int) bool { return exchangers[i].Volume.ToInt().Cmp(exchangers[j].Volume.ToInt()) > 0 }
+	default:
+		return nil, fmt.Errorf("invalid sortBy %q", order)
+	}
+	if less != nil {
+		sort.SliceStable(exchangers, less)
+	}
+
+	size := uint64(defaultListExchangersLimit)
+	if limit != nil && *limit > 0 {
+		size = uint64(*limit)
+		if size > maxListExchangersLimit {
+			size = maxListExchangersLimit
+		}
+	}
+	start := uint64(0)
+	if cursor != nil {
+		start = uint64(*cursor)
+	}
+	page := &ExchangerPage{Total: len(exchangers), Exchangers: []*ExchangerInfo{}}
+	if start >= uint64(len(exchangers)) {
+		return page, st.Error()
+	}
+	end := start + size
+	if end < uint64(len(exchangers)) {
+		next := hexutil.Uint64(end)
+		page.NextCursor = &next
+	} else {
+		end = uint64(len(exchangers))
+	}
+	page.Exchangers = exchangers[start:end]
+	return page, st.Error()
+}
+
 func (w *PublicWormholesAPI) GetValidator(ctx context.Context, number rpc.BlockNumber) types.ValidatorList {
 	header, err := w.b.HeaderByNumber(ctx, number)
 	if header == nil || err != nil {
@@ -2570,7 +2749,7 @@ func (w *PublicWormholesAPI) GetRealParticipantsByNumber(ctx context.Context, nu
 	}
 
 	header := block.Header()
-	istanbulExtra, err := types.ExtractIstanbulExtra(header)
+	istanbulExtra, err := types.ExtractWormholesExtra(header)
 	if err != nil {
 		return nil, err
 	}
@@ -2591,709 +2770,262 @@ func (w *PublicWormholesAPI) GetRealParticipantsByNumber(ctx context.Context, nu
 	return participants, nil
 }
 
+// ValidatorStats is the participation of a validator in the consensus over a
+// range of blocks
+type ValidatorStats struct {
+	Address               common.Address `json:"address"`
+	FromBlock             uint64         `json:"fromBlock"`
+	ToBlock               uint64         `json:"toBlock"`
+	Proposed              uint64         `json:"proposed"`
+	CommittedSeals        uint64         `json:"committedSeals"`
+	RewardSeals           uint64         `json:"rewardSeals"`
+	EmptyBlockVotes       uint64         `json:"emptyBlockVotes"`
+	CoefficientAdded      uint64         `json:"coefficientAdded"`
+	CoefficientSubtracted uint64         `json:"coefficientSubtracted"`
+}
+
+// GetValidatorStats returns the blocks proposed, the seals and empty block
+// votes contributed and the coefficient adjustments of the validator addr in
+// the blocks fromBlock..toBlock.
+func (w *PublicWormholesAPI) GetValidatorStats(ctx context.Context, addr common.Address, fromBlock rpc.BlockNumber, toBlock rpc.BlockNumber) (*ValidatorStats, error) {
+	from, err := w.b.HeaderByNumber(ctx, fromBlock)
+	if from == nil || err != nil {
+		return nil, fmt.Errorf("unknown block %d", fromBlock)
+	}
+	to, err := w.b.HeaderByNumber(ctx, toBlock)
+	if to == nil || err != nil {
+		return nil, fmt.Errorf("unknown block %d", toBlock)
+	}
+	if from.Number.Cmp(to.Number) > 0 {
+		return nil, errors.New("fromBlock after toBlock")
+	}
+	stats, err := w.b.GetValidatorStats(ctx, addr, from.Number.Uint64(), to.Number.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorStats{
+		Address:               addr,
+		FromBlock:             from.Number.Uint64(),
+		ToBlock:               to.Number.Uint64(),
+		Proposed:              stats.Proposed,
+		CommittedSeals:        stats.CommittedSeals,
+		RewardSeals:           stats.RewardSeals,
+		EmptyBlockVotes:       stats.EmptyBlockVotes,
+		CoefficientAdded:      stats.CoefficientAdded,
+		CoefficientSubtracted: stats.CoefficientSubtracted,
+	}, nil
+}
+
 func (w *PublicWormholesAPI) Version(ctx context.Context) string {
 	version := "wormholes v" + params.Version
 	return version
 }
 
 func (w *PublicWormholesAPI) Mint(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
+	return w.sendWormholesTransaction(ctx, args, 0, new(MintArgs))
+}
 
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
+func (w *PublicWormholesAPI) Transfer(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 1, new(NFTArgs))
+}
 
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
+func (w *PublicWormholesAPI) Author(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 2, new(NFTArgs))
+}
 
-	TxData := make(map[string]interface{})
-	err = json.Unmarshal(args.data(), &TxData)
-	if err != nil {
-		return common.Hash{}, err
-	}
+func (w *PublicWormholesAPI) AuthorRevoke(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 3, new(NFTArgs))
+}
 
-	transaction := types.Wormholes{
-		Type:      0,
-		Royalty:   uint16(TxData["royalty"].(float64)),
-		MetaURL:   TxData["metaUrl"].(string),
-		Exchanger: TxData["exchanger"].(string),
-		Version:   types.WormholesVersion,
-	}
+func (w *PublicWormholesAPI) AccountAuthor(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 4, nil)
+}
 
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
+func (w *PublicWormholesAPI) AccountAuthorRevoke(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 5, nil)
+}
 
-	args.Update(Txdata)
+func (w *PublicWormholesAPI) SNFTToERB(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 6, new(NFTArgs))
+}
 
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
+func (w *PublicWormholesAPI) TokenPledge(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 9, nil)
+}
 
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
+func (w *PublicWormholesAPI) TokenRevokesPledge(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 10, nil)
+}
 
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
+func (w *PublicWormholesAPI) OpenExchanger(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 11, new(OpenExchangerArgs))
 }
 
-func (w *PublicWormholesAPI) Transfer(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
+func (w *PublicWormholesAPI) CloseExchanger(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 12, nil)
+}
 
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
+func (w *PublicWormholesAPI) AdditionalPledgeAmount(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 21, nil)
+}
 
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
+func (w *PublicWormholesAPI) RevokesPledgeAmount(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 22, nil)
+}
 
-	TxData := make(map[string]interface{})
-	err = json.Unmarshal(args.data(), &TxData)
-	if err != nil {
-		return common.Hash{}, err
-	}
+func (w *PublicWormholesAPI) VoteOfficialNFT(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 23, new(VoteOfficialNFTArgs))
+}
 
-	transaction := types.Wormholes{
-		Type:       1,
-		NFTAddress: TxData["nftAddress"].(string),
-		Version:    types.WormholesVersion,
-	}
+func (w *PublicWormholesAPI) VoteOfficialNFTProposal(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 23, new(VoteOfficialNFTProposalArgs))
+}
 
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
+func (w *PublicWormholesAPI) Unfrozen(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 25, nil)
+}
 
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
+func (w *PublicWormholesAPI) UpdateMetaURL(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 32, new(UpdateMetaURLArgs))
 }
 
-func (w *PublicWormholesAPI) Author(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
+func (w *PublicWormholesAPI) FreezeMetaURL(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 33, new(NFTArgs))
+}
 
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
+func (w *PublicWormholesAPI) UpdateExchanger(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 34, new(UpdateExchangerArgs))
+}
 
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
+func (w *PublicWormholesAPI) SetCollectionFeeRate(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 35, new(CollectionFeeRateArgs))
+}
 
-	TxData := make(map[string]interface{})
-	err = json.Unmarshal(args.data(), &TxData)
-	if err != nil {
-		return common.Hash{}, err
-	}
+func (w *PublicWormholesAPI) AddAllowedCreator(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 36, new(CreatorArgs))
+}
 
-	transaction := types.Wormholes{
-		Type:       2,
-		NFTAddress: TxData["nftAddress"].(string),
-		Version:    types.WormholesVersion,
-	}
+func (w *PublicWormholesAPI) RemoveAllowedCreator(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 37, new(CreatorArgs))
+}
 
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
+// FractionalizeSNFT locks a merged snft and mints its shares to the sender.
+func (w *PublicWormholesAPI) FractionalizeSNFT(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 38, new(NFTArgs))
+}
 
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
+// RedeemSNFT burns all shares of a fractionalized snft and releases it to the sender.
+func (w *PublicWormholesAPI) RedeemSNFT(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 39, new(NFTArgs))
+}
 
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
+// TransferSNFTShares transfers shares of a fractionalized snft to args.To.
+func (w *PublicWormholesAPI) TransferSNFTShares(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 40, new(TransferSNFTSharesArgs))
 }
 
-func (w *PublicWormholesAPI) AuthorRevoke(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
+// RegisterBLSPubKey registers the bls public key the validator args.From
+// aggregates its seals with, together with the proof of possession of the key.
+func (w *PublicWormholesAPI) RegisterBLSPubKey(ctx context.Context, args TransactionArgs) (common.Hash, error) {
+	return w.sendWormholesTransaction(ctx, args, 41, new(RegisterBLSPubKeyArgs))
+}
 
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
+func (w *PublicWormholesAPI) RawMint(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
+	return SubmitTransaction(ctx, w.b, tx)
+}
 
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	TxData := make(map[string]interface{})
-	err = json.Unmarshal(args.data(), &TxData)
-	if err != nil {
+func (w *PublicWormholesAPI) RawTransfer(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
+	return SubmitTransaction(ctx, w.b, tx)
+}
 
-	transaction := types.Wormholes{
-		Type:       3,
-		NFTAddress: TxData["nftAddress"].(string),
-		Version:    types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
+func (w *PublicWormholesAPI) RawAuthor(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
+	return SubmitTransaction(ctx, w.b, tx)
+}
 
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
+func (w *PublicWormholesAPI) RawAuthorRevoke(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
-	return SubmitTransaction(ctx, w.b, signed)
+	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) AccountAuthor(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
-
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	transaction := types.Wormholes{
-		Type:    4,
-		Version: types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
-
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
-}
-
-func (w *PublicWormholesAPI) AccountAuthorRevoke(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
-
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	transaction := types.Wormholes{
-		Type:    5,
-		Version: types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
-
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
-}
-
-func (w *PublicWormholesAPI) SNFTToERB(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
-
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	TxData := make(map[string]interface{})
-	err = json.Unmarshal(args.data(), &TxData)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	transaction := types.Wormholes{
-		Type:       6,
-		NFTAddress: TxData["nftAddress"].(string),
-		Version:    types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
-
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
-}
-
-func (w *PublicWormholesAPI) TokenPledge(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
-
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	transaction := types.Wormholes{
-		Type:    9,
-		Version: types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
-
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
-}
-
-func (w *PublicWormholesAPI) TokenRevokesPledge(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
-
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	transaction := types.Wormholes{
-		Type:    10,
-		Version: types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
-
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
-}
-
-func (w *PublicWormholesAPI) OpenExchanger(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
-
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	TxData := make(map[string]interface{})
-	err = json.Unmarshal(args.data(), &TxData)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	transaction := types.Wormholes{
-		Type:    11,
-		FeeRate: uint16(TxData["royalty"].(float64)),
-		Name:    TxData["name"].(string),
-		Url:     TxData["url"].(string),
-		Version: types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
-
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
-}
-
-func (w *PublicWormholesAPI) CloseExchanger(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
-
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	transaction := types.Wormholes{
-		Type:    12,
-		Version: types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
-
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
-}
-
-func (w *PublicWormholesAPI) AdditionalPledgeAmount(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
-
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	transaction := types.Wormholes{
-		Type:    21,
-		Version: types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
-
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
-}
-
-func (w *PublicWormholesAPI) RevokesPledgeAmount(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
-
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	transaction := types.Wormholes{
-		Type:    22,
-		Version: types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
-		return common.Hash{}, err
-	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
-
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return SubmitTransaction(ctx, w.b, signed)
-}
-
-func (w *PublicWormholesAPI) VoteOfficialNFT(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
-
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
+func (w *PublicWormholesAPI) RawAccountAuthor(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
+	return SubmitTransaction(ctx, w.b, tx)
+}
 
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	TxData := make(map[string]interface{})
-	err = json.Unmarshal(args.data(), &TxData)
-	if err != nil {
+func (w *PublicWormholesAPI) RawAccountAuthorRevoke(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
+	return SubmitTransaction(ctx, w.b, tx)
+}
 
-	transaction := types.Wormholes{
-		Type:       23,
-		Dir:        TxData["dir"].(string),
-		StartIndex: TxData["startIndex"].(string),
-		Number:     uint64(TxData["number"].(float64)),
-		Royalty:    uint16(TxData["royalty"].(float64)),
-		Creator:    TxData["creater"].(string),
-		Version:    types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
+func (w *PublicWormholesAPI) RawSNFTToERB(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
-
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
+	return SubmitTransaction(ctx, w.b, tx)
+}
+func (w *PublicWormholesAPI) RawTokenPledge(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
-	return SubmitTransaction(ctx, w.b, signed)
+	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) Unfrozen(ctx context.Context, args TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	account := accounts.Account{Address: args.from()}
-
-	wallet, err := w.b.AccountManager().Find(account)
-	if err != nil {
+func (w *PublicWormholesAPI) RawTokenRevokesPledge(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
+	return SubmitTransaction(ctx, w.b, tx)
+}
 
-	if args.Nonce == nil {
-		// Hold the addresse's mutex around signing to prevent concurrent assignment of
-		// the same nonce to multiple accounts.
-		w.nonceLock.LockAddr(args.from())
-		defer w.nonceLock.UnlockAddr(args.from())
-	}
-
-	TxData := make(map[string]interface{})
-	err = json.Unmarshal(args.data(), &TxData)
-	if err != nil {
+func (w *PublicWormholesAPI) RawOpenExchanger(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
+	return SubmitTransaction(ctx, w.b, tx)
+}
 
-	transaction := types.Wormholes{
-		Type:    25,
-		Version: types.WormholesVersion,
-	}
-
-	tr, err := json.Marshal(transaction)
-	if err != nil {
-		log.Info("ethapi Mint() failed to format wormholes data", "err=", err)
-		return common.Hash{}, err
-	}
-	Txdata := append([]byte("wormholes:"), tr...)
-	args.Update(Txdata)
-	// Set some sanity defaults and terminate on failure
-	if err := args.setDefaults(ctx, w.b); err != nil {
+func (w *PublicWormholesAPI) RawCloseExchanger(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
-	// Assemble the transaction and sign with the wallet
-	tx := args.toTransaction()
+	return SubmitTransaction(ctx, w.b, tx)
+}
 
-	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
-	if err != nil {
+func (w *PublicWormholesAPI) RawTransactionNFT(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
-	return SubmitTransaction(ctx, w.b, signed)
+	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawMint(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawBuyerInitiatingTransaction(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3301,7 +3033,7 @@ func (w *PublicWormholesAPI) RawMint(ctx context.Context, input hexutil.Bytes) (
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawTransfer(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawFoundryTradeBuyer(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3309,7 +3041,7 @@ func (w *PublicWormholesAPI) RawTransfer(ctx context.Context, input hexutil.Byte
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawAuthor(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawNftExchangeMatch(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3317,7 +3049,7 @@ func (w *PublicWormholesAPI) RawAuthor(ctx context.Context, input hexutil.Bytes)
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawAuthorRevoke(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawFoundryExchangeInitiated(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3325,7 +3057,7 @@ func (w *PublicWormholesAPI) RawAuthorRevoke(ctx context.Context, input hexutil.
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawAccountAuthor(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawNFTDoesNotAuthorizeExchanges(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3333,7 +3065,7 @@ func (w *PublicWormholesAPI) RawAccountAuthor(ctx context.Context, input hexutil
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawAccountAuthorRevoke(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawAdditionalPledgeAmount(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3341,14 +3073,15 @@ func (w *PublicWormholesAPI) RawAccountAuthorRevoke(ctx context.Context, input h
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawSNFTToERB(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawRevokesPledgeAmount(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
 	return SubmitTransaction(ctx, w.b, tx)
 }
-func (w *PublicWormholesAPI) RawTokenPledge(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+
+func (w *PublicWormholesAPI) RawVoteOfficialNFT(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3356,7 +3089,7 @@ func (w *PublicWormholesAPI) RawTokenPledge(ctx context.Context, input hexutil.B
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawTokenRevokesPledge(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawVoteOfficialNFTByApprovedExchanger(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3364,7 +3097,7 @@ func (w *PublicWormholesAPI) RawTokenRevokesPledge(ctx context.Context, input he
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawOpenExchanger(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawUnfrozen(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3372,7 +3105,7 @@ func (w *PublicWormholesAPI) RawOpenExchanger(ctx context.Context, input hexutil
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawCloseExchanger(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawAccountDelegate(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3380,7 +3113,7 @@ func (w *PublicWormholesAPI) RawCloseExchanger(ctx context.Context, input hexuti
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawTransactionNFT(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawUpdateMetaURL(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3388,7 +3121,7 @@ func (w *PublicWormholesAPI) RawTransactionNFT(ctx context.Context, input hexuti
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawBuyerInitiatingTransaction(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawFreezeMetaURL(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3396,7 +3129,7 @@ func (w *PublicWormholesAPI) RawBuyerInitiatingTransaction(ctx context.Context,
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawFoundryTradeBuyer(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawUpdateExchanger(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3404,7 +3137,7 @@ func (w *PublicWormholesAPI) RawFoundryTradeBuyer(ctx context.Context, input hex
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawNftExchangeMatch(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawSetCollectionFeeRate(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3412,7 +3145,7 @@ func (w *PublicWormholesAPI) RawNftExchangeMatch(ctx context.Context, input hexu
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawFoundryExchangeInitiated(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawAddAllowedCreator(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3420,7 +3153,7 @@ func (w *PublicWormholesAPI) RawFoundryExchangeInitiated(ctx context.Context, in
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawNFTDoesNotAuthorizeExchanges(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawRemoveAllowedCreator(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3428,7 +3161,7 @@ func (w *PublicWormholesAPI) RawNFTDoesNotAuthorizeExchanges(ctx context.Context
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawAdditionalPledgeAmount(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawVoteOfficialNFTProposal(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3436,7 +3169,7 @@ func (w *PublicWormholesAPI) RawAdditionalPledgeAmount(ctx context.Context, inpu
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawRevokesPledgeAmount(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawFractionalizeSNFT(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3444,7 +3177,7 @@ func (w *PublicWormholesAPI) RawRevokesPledgeAmount(ctx context.Context, input h
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawVoteOfficialNFT(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawRedeemSNFT(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3452,7 +3185,7 @@ func (w *PublicWormholesAPI) RawVoteOfficialNFT(ctx context.Context, input hexut
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawVoteOfficialNFTByApprovedExchanger(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawTransferSNFTShares(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3460,7 +3193,7 @@ func (w *PublicWormholesAPI) RawVoteOfficialNFTByApprovedExchanger(ctx context.C
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawUnfrozen(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+func (w *PublicWormholesAPI) RawRegisterBLSPubKey(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -3468,12 +3201,279 @@ func (w *PublicWormholesAPI) RawUnfrozen(ctx context.Context, input hexutil.Byte
 	return SubmitTransaction(ctx, w.b, tx)
 }
 
-func (w *PublicWormholesAPI) RawAccountDelegate(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+// sendWormholesTransaction signs the transaction carrying the wormholes
+// operation typ with the wallet of args.From and submits it to the pool.
+func (w *PublicWormholesAPI) sendWormholesTransaction(ctx context.Context, args TransactionArgs, typ uint8, wargs wormholesArgs) (common.Hash, error) {
+	// Look up the wallet containing the requested signer
+	account := accounts.Account{Address: args.from()}
+
+	wallet, err := w.b.AccountManager().Find(account)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if args.Nonce == nil {
+		// Hold the addresse's mutex around signing to prevent concurrent assignment of
+		// the same nonce to multiple accounts.
+		w.nonceLock.LockAddr(args.from())
+		defer w.nonceLock.UnlockAddr(args.from())
+	}
+
+	Txdata, err := encodeWormholes(args.data(), typ, wargs)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	args.Input = nil
+	args.Update(Txdata)
+	// Set some sanity defaults and terminate on failure
+	if err := args.setDefaults(ctx, w.b); err != nil {
+		return common.Hash{}, newWormholesVMError(err)
+	}
+	// Assemble the transaction and sign with the wallet
+	tx := args.toTransaction()
+
+	signed, err := wallet.SignTx(account, tx, w.b.ChainConfig().ChainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return SubmitTransaction(ctx, w.b, signed)
+}
+
+// buildWormholesTransaction assembles the unsigned transaction carrying the
+// wormholes operation typ. The nonce and the fee fields are filled in like
+// eth_fillTransaction, the gas defaults to the intrinsic gas of the
+// operation. The returned transaction is meant to be signed by an external
+// signer and submitted with eth_sendRawTransaction.
+func (w *PublicWormholesAPI) buildWormholesTransaction(ctx context.Context, args TransactionArgs, typ uint8, wargs wormholesArgs) (*SignTransactionResult, error) {
+	if args.From == nil {
+		return nil, errors.New("from not specified")
+	}
+	if args.To == nil {
+		return nil, errors.New("to not specified")
+	}
+
+	Txdata, err := encodeWormholes(args.data(), typ, wargs)
+	if err != nil {
+		return nil, err
+	}
+	args.Input = nil
+	args.Update(Txdata)
+
+	if args.Gas == nil {
+		var accessList types.AccessList
+		if args.AccessList != nil {
+			accessList = *args.AccessList
+		}
+		head := w.b.CurrentHeader()
+		gas, err := core.IntrinsicGas(Txdata, accessList, false, true, w.b.ChainConfig().IsIstanbul(head.Number))
+		if err != nil {
+			return nil, err
+		}
+		args.Gas = (*hexutil.Uint64)(&gas)
+	}
+	// Set some sanity defaults and terminate on failure
+	if err := args.setDefaults(ctx, w.b); err != nil {
+		return nil, err
+	}
+	// Assemble the transaction and obtain rlp
+	tx := args.toTransaction()
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &SignTransactionResult{data, tx}, nil
+}
+
+// BuildMint returns the unsigned transaction of Mint.
+func (w *PublicWormholesAPI) BuildMint(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 0, new(MintArgs))
+}
+
+// BuildTransfer returns the unsigned transaction of Transfer.
+func (w *PublicWormholesAPI) BuildTransfer(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 1, new(NFTArgs))
+}
+
+// BuildAuthor returns the unsigned transaction of Author.
+func (w *PublicWormholesAPI) BuildAuthor(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 2, new(NFTArgs))
+}
+
+// BuildAuthorRevoke returns the unsigned transaction of AuthorRevoke.
+func (w *PublicWormholesAPI) BuildAuthorRevoke(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 3, new(NFTArgs))
+}
+
+// BuildAccountAuthor returns the unsigned transaction of AccountAuthor.
+func (w *PublicWormholesAPI) BuildAccountAuthor(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 4, nil)
+}
+
+// BuildAccountAuthorRevoke returns the unsigned transaction of AccountAuthorRevoke.
+func (w *PublicWormholesAPI) BuildAccountAuthorRevoke(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 5, nil)
+}
+
+// BuildSNFTToERB returns the unsigned transaction of SNFTToERB.
+func (w *PublicWormholesAPI) BuildSNFTToERB(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 6, new(NFTArgs))
+}
+
+// BuildTokenPledge returns the unsigned transaction of TokenPledge.
+func (w *PublicWormholesAPI) BuildTokenPledge(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 9, nil)
+}
+
+// BuildTokenRevokesPledge returns the unsigned transaction of TokenRevokesPledge.
+func (w *PublicWormholesAPI) BuildTokenRevokesPledge(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 10, nil)
+}
+
+// BuildOpenExchanger returns the unsigned transaction of OpenExchanger.
+func (w *PublicWormholesAPI) BuildOpenExchanger(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 11, new(OpenExchangerArgs))
+}
+
+// BuildCloseExchanger returns the unsigned transaction of CloseExchanger.
+func (w *PublicWormholesAPI) BuildCloseExchanger(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 12, nil)
+}
+
+// BuildAdditionalPledgeAmount returns the unsigned transaction of AdditionalPledgeAmount.
+func (w *PublicWormholesAPI) BuildAdditionalPledgeAmount(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 21, nil)
+}
+
+// BuildRevokesPledgeAmount returns the unsigned transaction of RevokesPledgeAmount.
+func (w *PublicWormholesAPI) BuildRevokesPledgeAmount(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 22, nil)
+}
+
+// BuildVoteOfficialNFT returns the unsigned transaction of VoteOfficialNFT.
+func (w *PublicWormholesAPI) BuildVoteOfficialNFT(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 23, new(VoteOfficialNFTArgs))
+}
+
+// BuildVoteOfficialNFTProposal returns the unsigned transaction of VoteOfficialNFTProposal.
+func (w *PublicWormholesAPI) BuildVoteOfficialNFTProposal(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 23, new(VoteOfficialNFTProposalArgs))
+}
+
+// BuildUnfrozen returns the unsigned transaction of Unfrozen.
+func (w *PublicWormholesAPI) BuildUnfrozen(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 25, nil)
+}
+
+// BuildUpdateMetaURL returns the unsigned transaction of UpdateMetaURL.
+func (w *PublicWormholesAPI) BuildUpdateMetaURL(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 32, new(UpdateMetaURLArgs))
+}
+
+// BuildFreezeMetaURL returns the unsigned transaction of FreezeMetaURL.
+func (w *PublicWormholesAPI) BuildFreezeMetaURL(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 33, new(NFTArgs))
+}
+
+// BuildUpdateExchanger returns the unsigned transaction of UpdateExchanger.
+func (w *PublicWormholesAPI) BuildUpdateExchanger(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 34, new(UpdateExchangerArgs))
+}
+
+// BuildSetCollectionFeeRate returns the unsigned transaction of SetCollectionFeeRate.
+func (w *PublicWormholesAPI) BuildSetCollectionFeeRate(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 35, new(CollectionFeeRateArgs))
+}
+
+// BuildAddAllowedCreator returns the unsigned transaction of AddAllowedCreator.
+func (w *PublicWormholesAPI) BuildAddAllowedCreator(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 36, new(CreatorArgs))
+}
+
+// BuildRemoveAllowedCreator returns the unsigned transaction of RemoveAllowedCreator.
+func (w *PublicWormholesAPI) BuildRemoveAllowedCreator(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 37, new(CreatorArgs))
+}
+
+// BuildFractionalizeSNFT returns the unsigned transaction of FractionalizeSNFT.
+func (w *PublicWormholesAPI) BuildFractionalizeSNFT(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 38, new(NFTArgs))
+}
+
+// BuildRedeemSNFT returns the unsigned transaction of RedeemSNFT.
+func (w *PublicWormholesAPI) BuildRedeemSNFT(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 39, new(NFTArgs))
+}
+
+// BuildTransferSNFTShares returns the unsigned transaction of TransferSNFTShares.
+func (w *PublicWormholesAPI) BuildTransferSNFTShares(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 40, new(TransferSNFTSharesArgs))
+}
+
+// BuildRegisterBLSPubKey returns the unsigned transaction of RegisterBLSPubKey.
+func (w *PublicWormholesAPI) BuildRegisterBLSPubKey(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
+	return w.buildWormholesTransaction(ctx, args, 41, new(RegisterBLSPubKeyArgs))
+}
+
+// DecodedWormholesTransaction is a raw transaction decoded by
+// erb_decodeTransaction.
+type DecodedWormholesTransaction struct {
+	Hash common.Hash        `json:"hash"`
+	Tx   *types.Transaction `json:"tx"`
+	// From is nil if the transaction is not signed.
+	From *common.Address `json:"from"`
+	// Wormholes is nil if the transaction carries no wormholes operation.
+	Wormholes *types.Wormholes `json:"wormholes"`
+	// Error is the reason the wormholes operation would be rejected.
+	Error string `json:"error,omitempty"`
+}
+
+// DecodeTransaction decodes a signed or unsigned raw transaction and the
+// wormholes operation it carries, validating the operation like the
+// transaction pool does.
+func (w *PublicWormholesAPI) DecodeTransaction(ctx context.Context, input hexutil.Bytes) (*DecodedWormholesTransaction, error) {
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
-		return common.Hash{}, err
+		return nil, err
 	}
-	return SubmitTransaction(ctx, w.b, tx)
+	decoded := &DecodedWormholesTransaction{
+		Hash: tx.Hash(),
+		Tx:   tx,
+	}
+	if v, r, s := tx.RawSignatureValues(); v.Sign() != 0 || r.Sign() != 0 || s.Sign() != 0 {
+		from, err := types.Sender(types.LatestSigner(w.b.ChainConfig()), tx)
+		if err != nil {
+			decoded.Error = err.Error()
+			return decoded, nil
+		}
+		decoded.From = &from
+	}
+	if !tx.IsWormholesNFTTx() {
+		return decoded, nil
+	}
+
+	var wormholes types.Wormholes
+	if err := json.Unmarshal(tx.Data()[10:], &wormholes); err != nil {
+		decoded.Error = err.Error()
+		return decoded, nil
+	}
+	decoded.Wormholes = &wormholes
+	// CheckFormat truncates overlong fields, validate a copy to report
+	// the payload as it was signed
+	check := wormholes
+	if err := check.CheckFormat(); err != nil {
+		decoded.Error = err.Error()
+		return decoded, nil
+	}
+	head := w.b.CurrentHeader()
+	gas, err := core.IntrinsicGas(tx.Data(), tx.AccessList(), tx.To() == nil, true, w.b.ChainConfig().IsIstanbul(head.Number))
+	if err != nil {
+		decoded.Error = err.Error()
+		return decoded, nil
+	}
+	if tx.Gas() < gas {
+		decoded.Error = core.ErrIntrinsicGas.Error()
+	}
+	return decoded, nil
 }
 
 // PublicTransactionPoolAPI exposes methods for the RPC interface
@@ -73,6 +73,7 @@ type Backend interface {
 	Random11ValidatorFromPoolWithProxy(ctx context.Context, header *types.Header) (*types.ValidatorList, error)
 	GetAllStakers(ctx context.Context) *types.StakerList
 	GetAllValidators(ctx context.Context, header *types.Header) (*types.ValidatorList, error)
+	GetValidatorStats(ctx context.Context, addr common.Address, from, to uint64) (*types.ValidatorStats, error)
 
 	// Transaction pool API
 	SendTx(ctx context.Context, signedTx *types.Transaction) error
@@ -0,0 +1,380 @@
+package ethapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// wormholesArgs are the arguments of a wormholes operation, passed by the
+// erb_ methods as the json object in the transaction data.
+type wormholesArgs interface {
+	// fill validates the arguments and sets them on the operation.
+	fill(w *types.Wormholes) error
+}
+
+// MintArgs are the arguments of erb_mint.
+type MintArgs struct {
+	Royalty   *uint16 `json:"royalty"`
+	MetaURL   *string `json:"metaUrl"`
+	Exchanger *string `json:"exchanger"`
+}
+
+func (args *MintArgs) fill(w *types.Wormholes) error {
+	if args.Royalty == nil {
+		return missingWormholesArg("royalty")
+	}
+	if args.MetaURL == nil {
+		return missingWormholesArg("metaUrl")
+	}
+	if args.Exchanger == nil {
+		return missingWormholesArg("exchanger")
+	}
+	w.Royalty = *args.Royalty
+	w.MetaURL = *args.MetaURL
+	w.Exchanger = *args.Exchanger
+	return nil
+}
+
+// NFTArgs are the arguments of the operations on a single nft, e.g.
+// erb_transfer or erb_freezeMetaURL.
+type NFTArgs struct {
+	NFTAddress *string `json:"nftAddress"`
+}
+
+func (args *NFTArgs) fill(w *types.Wormholes) error {
+	if args.NFTAddress == nil {
+		return missingWormholesArg("nftAddress")
+	}
+	w.NFTAddress = *args.NFTAddress
+	return nil
+}
+
+// OpenExchangerArgs are the arguments of erb_openExchanger.
+type OpenExchangerArgs struct {
+	// FeeRate is passed as royalty for compatibility with existing clients.
+	FeeRate *uint16 `json:"royalty"`
+	Name    *string `json:"name"`
+	Url     *string `json:"url"`
+}
+
+func (args *OpenExchangerArgs) fill(w *types.Wormholes) error {
+	if args.FeeRate == nil {
+		return missingWormholesArg("royalty")
+	}
+	if args.Name == nil {
+		return missingWormholesArg("name")
+	}
+	if args.Url == nil {
+		return missingWormholesArg("url")
+	}
+	w.FeeRate = *args.FeeRate
+	w.Name = *args.Name
+	w.Url = *args.Url
+	return nil
+}
+
+// UpdateExchangerArgs are the arguments of erb_updateExchanger. All fields
+// are optional, a missing field leaves the value unchanged.
+type UpdateExchangerArgs struct {
+	FeeRate *uint16 `json:"feeRate"`
+	Name    *string `json:"name"`
+	Url     *string `json:"url"`
+}
+
+func (args *UpdateExchangerArgs) fill(w *types.Wormholes) error {
+	w.SetExchangerUpdate(&types.ExchangerUpdate{FeeRate: args.FeeRate, Name: args.Name, URL: args.Url})
+	return nil
+}
+
+// VoteOfficialNFTArgs are the arguments of erb_voteOfficialNFT.
+type VoteOfficialNFTArgs struct {
+	Dir        *string `json:"dir"`
+	StartIndex *string `json:"startIndex"`
+	Number     *uint64 `json:"number"`
+	Royalty    *uint16 `json:"royalty"`
+	Creator    *string `json:"creater"`
+}
+
+func (args *VoteOfficialNFTArgs) fill(w *types.Wormholes) error {
+	if args.Dir == nil {
+		return missingWormholesArg("dir")
+	}
+	if args.StartIndex == nil {
+		return missingWormholesArg("startIndex")
+	}
+	if args.Number == nil {
+		return missingWormholesArg("number")
+	}
+	if args.Royalty == nil {
+		return missingWormholesArg("royalty")
+	}
+	if args.Creator == nil {
+		return missingWormholesArg("creater")
+	}
+	w.Dir = *args.Dir
+	w.StartIndex = *args.StartIndex
+	w.Number = *args.Number
+	w.Royalty = *args.Royalty
+	w.Creator = *args.Creator
+	return nil
+}
+
+// VoteOfficialNFTProposalArgs are the arguments of erb_voteOfficialNFTProposal.
+type VoteOfficialNFTProposalArgs struct {
+	ProposalID *uint64 `json:"proposalId"`
+}
+
+func (args *VoteOfficialNFTProposalArgs) fill(w *types.Wormholes) error {
+	if args.ProposalID == nil {
+		return missingWormholesArg("proposalId")
+	}
+	if *args.ProposalID < 1 {
+		return &invalidWormholesArgsError{field: "proposalId", err: errors.New("proposal ids start at 1")}
+	}
+	w.ProposalID = *args.ProposalID
+	return nil
+}
+
+// UpdateMetaURLArgs are the arguments of erb_updateMetaURL.
+type UpdateMetaURLArgs struct {
+	NFTAddress *string `json:"nftAddress"`
+	MetaURL    *string `json:"metaUrl"`
+}
+
+func (args *UpdateMetaURLArgs) fill(w *types.Wormholes) error {
+	if args.NFTAddress == nil {
+		return missingWormholesArg("nftAddress")
+	}
+	if args.MetaURL == nil {
+		return missingWormholesArg("metaUrl")
+	}
+	w.NFTAddress = *args.NFTAddress
+	w.MetaURL = *args.MetaURL
+	return nil
+}
+
+// CreatorArgs are the arguments of the exchanger allowlist operations.
+type CreatorArgs struct {
+	Creator *string `json:"creator"`
+}
+
+func (args *CreatorArgs) fill(w *types.Wormholes) error {
+	if args.Creator == nil {
+		return missingWormholesArg("creator")
+	}
+	w.Creator = *args.Creator
+	return nil
+}
+
+// CollectionFeeRateArgs are the arguments of erb_setCollectionFeeRate. A
+// missing or zero feeRate removes the collection fee rate.
+type CollectionFeeRateArgs struct {
+	Creator *string `json:"creator"`
+	FeeRate *uint16 `json:"feeRate"`
+}
+
+func (args *CollectionFeeRateArgs) fill(w *types.Wormholes) error {
+	if args.Creator == nil {
+		return missingWormholesArg("creator")
+	}
+	w.Creator = *args.Creator
+	if args.FeeRate != nil {
+		w.FeeRate = *args.FeeRate
+	}
+	return nil
+}
+
+// TransferSNFTSharesArgs are the arguments of erb_transferSNFTShares.
+type TransferSNFTSharesArgs struct {
+	NFTAddress *string      `json:"nftAddress"`
+	Amount     *hexutil.Big `json:"amount"`
+}
+
+func (args *TransferSNFTSharesArgs) fill(w *types.Wormholes) error {
+	if args.NFTAddress == nil {
+		return missingWormholesArg("nftAddress")
+	}
+	if args.Amount == nil {
+		return missingWormholesArg("amount")
+	}
+	w.NFTAddress = *args.NFTAddress
+	w.Amount = args.Amount.String()
+	return nil
+}
+
+// RegisterBLSPubKeyArgs are the arguments of erb_registerBLSPubKey.
+type RegisterBLSPubKeyArgs struct {
+	BLSPubKey *hexutil.Bytes `json:"blsPubKey"`
+	BLSProof  *hexutil.Bytes `json:"blsProof"`
+}
+
+func (args *RegisterBLSPubKeyArgs) fill(w *types.Wormholes) error {
+	if args.BLSPubKey == nil {
+		return missingWormholesArg("blsPubKey")
+	}
+	if args.BLSProof == nil {
+		return missingWormholesArg("blsProof")
+	}
+	w.BLSPubKey = args.BLSPubKey.String()
+	w.BLSProof = args.BLSProof.String()
+	return nil
+}
+
+// encodeWormholes decodes the arguments of the wormholes operation typ from
+// data into args and returns the encoded operation, ready to be used as the
+// data of a transaction. A nil args is used by the operations that take no
+// arguments, their data is ignored.
+func encodeWormholes(data []byte, typ uint8, args wormholesArgs) ([]byte, error) {
+	transaction := types.Wormholes{
+		Type:    typ,
+		Version: types.WormholesVersion,
+	}
+	if args != nil {
+		if len(data) == 0 {
+			return nil, &invalidWormholesArgsError{err: errors.New("missing arguments")}
+		}
+		if err := json.Unmarshal(data, args); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &typeErr) {
+				return nil, &invalidWormholesArgsError{field: typeErr.Field, err: fmt.Errorf("cannot use %s as %v", typeErr.Value, typeErr.Type)}
+			}
+			return nil, &invalidWormholesArgsError{err: err}
+		}
+		if err := args.fill(&transaction); err != nil {
+			return nil, err
+		}
+	}
+	if err := transaction.CheckFormat(); err != nil {
+		return nil, &invalidWormholesArgsError{err: err}
+	}
+	tr, err := json.Marshal(transaction)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("wormholes:"), tr...), nil
+}
+
+// invalidWormholesArgsError is returned for the arguments of a wormholes
+// operation that are malformed or rejected by Wormholes.CheckFormat.
+type invalidWormholesArgsError struct {
+	field string // json name of the offending argument, if known
+	err   error
+}
+
+func missingWormholesArg(field string) error {
+	return &invalidWormholesArgsError{field: field, err: errors.New("missing value")}
+}
+
+func (e *invalidWormholesArgsError) Error() string {
+	if e.field == "" {
+		return fmt.Sprintf("invalid wormholes arguments: %v", e.err)
+	}
+	return fmt.Sprintf("invalid wormholes argument %s: %v", e.field, e.err)
+}
+
+func (e *invalidWormholesArgsError) Unwrap() error { return e.err }
+
+// ErrorCode returns the JSON-RPC invalid params code.
+func (e *invalidWormholesArgsError) ErrorCode() int { return -32602 }
+
+// ErrorData returns the offending argument and the reason it was rejected.
+func (e *invalidWormholesArgsError) ErrorData() interface{} {
+	return map[string]string{
+		"field":  e.field,
+		"reason": e.err.Error(),
+	}
+}
+
+// wormholesVMErrors assigns the nft errors of the evm their JSON-RPC error
+// code and name. Codes are stable, new errors get the next free code.
+var wormholesVMErrors = map[error]struct {
+	code int
+	name string
+}{
+	vm.ErrNotOwner:                     {-33001, "ErrNotOwner"},
+	vm.ErrNotExistNFTType:              {-33002, "ErrNotExistNFTType"},
+	vm.ErrInsufficientPledgedBalance:   {-33003, "ErrInsufficientPledgedBalance"},
+	vm.ErrStartIndex:                   {-33004, "ErrStartIndex"},
+	vm.ErrNotExchanger:                 {-33005, "ErrNotExchanger"},
+	vm.ErrWormholesFormat:              {-33006, "ErrWormholesFormat"},
+	vm.ErrInsufficientExchangerBalance: {-33007, "ErrInsufficientExchangerBalance"},
+	vm.ErrNotMoreThan100ERB:            {-33008, "ErrNotMoreThan100ERB"},
+	vm.ErrTooCloseWithOpenExchanger:    {-33009, "ErrTooCloseWithOpenExchanger"},
+	vm.ErrTooCloseForWithdraw:          {-33010, "ErrTooCloseForWithdraw"},
+	vm.ErrTooCloseToCancel:             {-33011, "ErrTooCloseToCancel"},
+	vm.ErrRoyaltyNotMoreThan0:          {-33012, "ErrRoyaltyNotMoreThan0"},
+	vm.ErrRoyaltyNotLessthan10000:      {-33013, "ErrRoyaltyNotLessthan10000"},
+	vm.ErrFeeRateNotMoreThan0:          {-33014, "ErrFeeRateNotMoreThan0"},
+	vm.ErrFeeRateNotLessThan10000:      {-33015, "ErrFeeRateNotLessThan10000"},
+	vm.ErrNotMintByOfficial:            {-33016, "ErrNotMintByOfficial"},
+	vm.ErrTransAmount:                  {-33017, "ErrTransAmount"},
+	vm.ErrNotMoreThan100000ERB:         {-33018, "ErrNotMoreThan100000ERB"},
+	vm.ErrNotAllowedOfficialNFT:        {-33019, "ErrNotAllowedOfficialNFT"},
+	vm.ErrExchangerFormat:              {-33020, "ErrExchangerFormat"},
+	vm.ErrNotExistNft:                  {-33021, "ErrNotExistNft"},
+	vm.ErrMinerProxy:                   {-33022, "ErrMinerProxy"},
+	vm.ErrRepeatedPledge:               {-33023, "ErrRepeatedPledge"},
+	vm.ErrReopenExchanger:              {-33024, "ErrReopenExchanger"},
+	vm.ErrNotPledge:                    {-33025, "ErrNotPledge"},
+	vm.ErrNotMergedSNFT:                {-33026, "ErrNotMergedSNFT"},
+	vm.ErrHasBeenPledged:               {-33027, "ErrHasBeenPledged"},
+	vm.ErrNotExistFrozenAccount:        {-33028, "ErrNotExistFrozenAccount"},
+	vm.ErrNotCreator:                   {-33029, "ErrNotCreator"},
+	vm.ErrMetaURLFrozen:                {-33030, "ErrMetaURLFrozen"},
+	vm.ErrNotAllowedUpdateOfficialNFT:  {-33031, "ErrNotAllowedUpdateOfficialNFT"},
+	vm.ErrCreatorNotAllowed:            {-33032, "ErrCreatorNotAllowed"},
+	vm.ErrCreatorAlreadyAllowed:        {-33033, "ErrCreatorAlreadyAllowed"},
+	vm.ErrTooManyAllowedCreators:       {-33034, "ErrTooManyAllowedCreators"},
+	vm.ErrTooManyCollectionFeeRates:    {-33035, "ErrTooManyCollectionFeeRates"},
+	vm.ErrNotFractionalized:            {-33036, "ErrNotFractionalized"},
+	vm.ErrInsufficientShares:           {-33037, "ErrInsufficientShares"},
+	vm.ErrInvalidBLSProof:              {-33038, "ErrInvalidBLSProof"},
+	vm.ErrBuyerDataExpired:             {-33039, "ErrBuyerDataExpired"},
+	vm.ErrSellerDataExpired:            {-33040, "ErrSellerDataExpired"},
+	vm.ErrExchangerDataExpired:         {-33041, "ErrExchangerDataExpired"},
+	vm.ErrInsufficientBuyerBalance:     {-33042, "ErrInsufficientBuyerBalance"},
+	vm.ErrTxAmount:                     {-33043, "ErrTxAmount"},
+	vm.ErrNotBuyer:                     {-33044, "ErrNotBuyer"},
+	vm.ErrNotApproved:                  {-33045, "ErrNotApproved"},
+	vm.ErrNoRightToSell:                {-33046, "ErrNoRightToSell"},
+	vm.ErrNFTAddressMismatch:           {-33047, "ErrNFTAddressMismatch"},
+	vm.ErrGetNFTOwner:                  {-33048, "ErrGetNFTOwner"},
+	core.ErrRecoverAddress:             {-33049, "ErrRecoverAddress"},
+	core.ErrNotMatchAddress:            {-33050, "ErrNotMatchAddress"},
+}
+
+// wormholesVMError is an API error that encompasses an nft error of the evm
+// with its JSON-RPC error code and name.
+type wormholesVMError struct {
+	error
+	code int
+	name string
+}
+
+// ErrorCode returns the JSON-RPC error code of the nft error.
+func (e *wormholesVMError) ErrorCode() int { return e.code }
+
+// ErrorData returns the name of the nft error, e.g. ErrNotOwner.
+func (e *wormholesVMError) ErrorData() interface{} { return e.name }
+
+func (e *wormholesVMError) Unwrap() error { return e.error }
+
+// newWormholesVMError wraps err with its JSON-RPC error code and name if it
+// is an nft error of the evm, other errors are returned unchanged.
+func newWormholesVMError(err error) error {
+	if err == nil {
+		return nil
+	}
+	for vmErr, info := range wormholesVMErrors {
+		if errors.Is(err, vmErr) {
+			return &wormholesVMError{error: err, code: info.code, name: info.name}
+		}
+	}
+	return err
+}
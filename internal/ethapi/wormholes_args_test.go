@@ -0,0 +1,74 @@
+package ethapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func TestEncodeWormholes(t *testing.T) {
+	data, err := encodeWormholes([]byte(`{"royalty":100,"metaUrl":"ipfs://a","exchanger":"0x01"}`), 0, new(MintArgs))
+	if err != nil {
+		t.Fatalf("failed to encode mint: %v", err)
+	}
+	var w types.Wormholes
+	if err := json.Unmarshal(data[len("wormholes:"):], &w); err != nil {
+		t.Fatalf("failed to decode mint: %v", err)
+	}
+	if w.Type != 0 || w.Royalty != 100 || w.MetaURL != "ipfs://a" || w.Version != types.WormholesVersion {
+		t.Errorf("mint mismatch: %+v", w)
+	}
+
+	tests := []struct {
+		data  string
+		args  wormholesArgs
+		typ   uint8
+		field string
+	}{
+		{`{"royalty":100,"exchanger":"0x01"}`, new(MintArgs), 0, "metaUrl"},
+		{`{"royalty":"100","metaUrl":"","exchanger":""}`, new(MintArgs), 0, "royalty"},
+		{`{"royalty":-1,"metaUrl":"","exchanger":""}`, new(MintArgs), 0, "royalty"},
+		{`{"proposalId":0}`, new(VoteOfficialNFTProposalArgs), 23, "proposalId"},
+		{`{"creator":"0x01"}`, new(CreatorArgs), 36, ""},
+		{`{"nftAddress":"0x01","amount":"0x0"}`, new(TransferSNFTSharesArgs), 40, ""},
+	}
+	for i, tt := range tests {
+		_, err := encodeWormholes([]byte(tt.data), tt.typ, tt.args)
+		var argsErr *invalidWormholesArgsError
+		if !errors.As(err, &argsErr) {
+			t.Errorf("test %d: expected invalid arguments error, got %v", i, err)
+			continue
+		}
+		if argsErr.field != tt.field {
+			t.Errorf("test %d: field mismatch: have %q, want %q", i, argsErr.field, tt.field)
+		}
+		if argsErr.ErrorCode() != -32602 {
+			t.Errorf("test %d: code mismatch: have %d", i, argsErr.ErrorCode())
+		}
+	}
+}
+
+func TestWormholesVMError(t *testing.T) {
+	err := newWormholesVMError(fmt.Errorf("err: %w (supplied gas %d)", vm.ErrNotOwner, 21000))
+	rpcErr, ok := err.(rpc.DataError)
+	if !ok {
+		t.Fatalf("expected data error, got %T", err)
+	}
+	if rpcErr.ErrorData() != "ErrNotOwner" {
+		t.Errorf("data mismatch: have %v", rpcErr.ErrorData())
+	}
+	if err.(rpc.Error).ErrorCode() != -33001 {
+		t.Errorf("code mismatch: have %d", err.(rpc.Error).ErrorCode())
+	}
+	if !errors.Is(err, vm.ErrNotOwner) {
+		t.Error("wrapped error lost")
+	}
+	if other := errors.New("other"); newWormholesVMError(other) != other {
+		t.Error("non nft error wrapped")
+	}
+}
@@ -0,0 +1,767 @@
+package ethapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// maxWormholesReplayBlocks is the number of past blocks a subscription
+	// may replay before it follows the chain head. The events of a block are
+	// computed from its state and the one of its parent, a non-archive node
+	// only keeps the states of the last core.TriesInMemory blocks.
+	maxWormholesReplayBlocks = core.TriesInMemory - 1
+
+	// wormholesEventCacheSize is the number of recent blocks whose events are
+	// kept, the subscriptions replaying or retracting them share them.
+	wormholesEventCacheSize = 128
+
+	// chainEvChanSize is the size of channel listening to ChainEvent.
+	chainEvChanSize = 10
+)
+
+// mergeSNFTTopic is the topic of MergeSNFT(address indexed snft,address indexed owner,uint256 pieces)
+var mergeSNFTTopic = common.HexToHash("2b2711f6ad8adbb2fc8751c8400b9c6ebdaf9ea371995641808a7c692d89d46a")
+
+// WormholesEventCriteria selects the events of an erb subscription.
+// Addresses filters by owner for nftTransfers, by staker for stakingChanges,
+// by validator for validatorSetChanged and by exchanger for exchangerEvents,
+// NFTs is only used by nftTransfers. FromBlock replays the events of the
+// blocks from it up to the current head before following new blocks.
+type WormholesEventCriteria struct {
+	Addresses []common.Address `json:"addresses"`
+	NFTs      []common.Address `json:"nfts"`
+	FromBlock *rpc.BlockNumber `json:"fromBlock"`
+}
+
+func (crit *WormholesEventCriteria) matchAddress(addrs ...common.Address) bool {
+	if crit == nil || len(crit.Addresses) == 0 {
+		return true
+	}
+	for _, want := range crit.Addresses {
+		for _, addr := range addrs {
+			if want == addr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (crit *WormholesEventCriteria) matchNFT(nft common.Address) bool {
+	if crit == nil || len(crit.NFTs) == 0 {
+		return true
+	}
+	for _, want := range crit.NFTs {
+		if want == nft {
+			return true
+		}
+	}
+	return false
+}
+
+// NFTTransferEvent is an nft changing owner. Kind is "mint" for new nfts,
+// "merge" for snfts merged into a higher level, "burn" for nfts that no longer
+// exist at their address and "transfer" for everything else. TransactionHash
+// and Type are null for changes made by the block itself, like official
+// rewards, or that can't be attributed to one transaction, like mints.
+// Retracted is set if the block left the canonical chain in a reorg, as for
+// the other events.
+type NFTTransferEvent struct {
+	BlockNumber     hexutil.Uint64  `json:"blockNumber"`
+	BlockHash       common.Hash     `json:"blockHash"`
+	TransactionHash *common.Hash    `json:"transactionHash"`
+	Type            *hexutil.Uint64 `json:"type"`
+	Kind            string          `json:"kind"`
+	NFTAddress      common.Address  `json:"nftAddress"`
+	From            common.Address  `json:"from"`
+	To              common.Address  `json:"to"`
+	Retracted       bool            `json:"retracted"`
+}
+
+// StakingChangeEvent is a change of the validator or exchanger stake of an
+// account, the balances are the ones before and after the block.
+type StakingChangeEvent struct {
+	BlockNumber              hexutil.Uint64 `json:"blockNumber"`
+	BlockHash                common.Hash    `json:"blockHash"`
+	TransactionHash          common.Hash    `json:"transactionHash"`
+	Type                     hexutil.Uint64 `json:"type"`
+	Address                  common.Address `json:"address"`
+	PledgedBalance           *hexutil.Big   `json:"pledgedBalance"`
+	PreviousPledgedBalance   *hexutil.Big   `json:"previousPledgedBalance"`
+	ExchangerBalance         *hexutil.Big   `json:"exchangerBalance"`
+	PreviousExchangerBalance *hexutil.Big   `json:"previousExchangerBalance"`
+	Retracted                bool           `json:"retracted"`
+}
+
+// ValidatorSetEvent is a change of the validator pool made by a block,
+// Updated are the validators whose stake, proxy or bls key changed.
+type ValidatorSetEvent struct {
+	BlockNumber hexutil.Uint64   `json:"blockNumber"`
+	BlockHash   common.Hash      `json:"blockHash"`
+	Added       []common.Address `json:"added"`
+	Removed     []common.Address `json:"removed"`
+	Updated     []common.Address `json:"updated"`
+	Size        hexutil.Uint64   `json:"size"`
+	Retracted   bool             `json:"retracted"`
+}
+
+// ExchangerEvent is a wormholes transaction managing an exchanger or trading
+// through it.
+type ExchangerEvent struct {
+	BlockNumber     hexutil.Uint64  `json:"blockNumber"`
+	BlockHash       common.Hash     `json:"blockHash"`
+	TransactionHash common.Hash     `json:"transactionHash"`
+	Type            hexutil.Uint64  `json:"type"`
+	Exchanger       common.Address  `json:"exchanger"`
+	From            common.Address  `json:"from"`
+	NFTAddress      *common.Address `json:"nftAddress"`
+	Retracted       bool            `json:"retracted"`
+}
+
+// WormholesEventsError is notified in place of the events of a block that
+// can't be computed, e.g. as its state was pruned or its receipts are missing.
+type WormholesEventsError struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	Error       string         `json:"error"`
+	Retracted   bool           `json:"retracted"`
+}
+
+// wormholesBlockEvents are the events of all topics of one block.
+type wormholesBlockEvents struct {
+	number    uint64
+	hash      common.Hash
+	err       error // Set if the events of the block can't be computed
+	retracted bool  // Set if the block left the canonical chain
+
+	transfers    []*NFTTransferEvent
+	staking      []*StakingChangeEvent
+	validatorSet *ValidatorSetEvent
+	exchanger    []*ExchangerEvent
+}
+
+// retract returns a copy of the events marked as retracted from the
+// canonical chain.
+func (e *wormholesBlockEvents) retract() *wormholesBlockEvents {
+	r := &wormholesBlockEvents{number: e.number, hash: e.hash, err: e.err, retracted: true}
+	for _, ev := range e.transfers {
+		cpy := *ev
+		cpy.Retracted = true
+		r.transfers = append(r.transfers, &cpy)
+	}
+	for _, ev := range e.staking {
+		cpy := *ev
+		cpy.Retracted = true
+		r.staking = append(r.staking, &cpy)
+	}
+	if e.validatorSet != nil {
+		cpy := *e.validatorSet
+		cpy.Retracted = true
+		r.validatorSet = &cpy
+	}
+	for _, ev := range e.exchanger {
+		cpy := *ev
+		cpy.Retracted = true
+		r.exchanger = append(r.exchanger, &cpy)
+	}
+	return r
+}
+
+// decodeWormholesTx returns the wormholes payload of tx or nil.
+func decodeWormholesTx(tx *types.Transaction) *types.Wormholes {
+	data := tx.Data()
+	if len(data) <= 10 || string(data[:10]) != "wormholes:" {
+		return nil
+	}
+	var w types.Wormholes
+	if err := json.Unmarshal(data[10:], &w); err != nil {
+		return nil
+	}
+	return &w
+}
+
+// wormholesNFTs returns the nfts referenced by a wormholes transaction.
+func wormholesNFTs(w *types.Wormholes) []common.Address {
+	var nfts []common.Address
+	for _, s := range []string{w.NFTAddress, w.Buyer.NFTAddress, w.Seller1.NFTAddress} {
+		if common.IsHexAddress(s) {
+			nfts = append(nfts, common.HexToAddress(s))
+		}
+	}
+	return nfts
+}
+
+// wormholesExchanger returns the exchanger a wormholes transaction sent by
+// sender acts on.
+func wormholesExchanger(w *types.Wormholes, sender common.Address) (common.Address, bool) {
+	switch w.Type {
+	case 11, 12, 34, 35, 36, 37:
+		return sender, true
+	case 14, 15, 16, 17, 18, 19, 20, 27, 28:
+		for _, s := range []string{w.Exchanger, w.Buyer.Exchanger, w.Seller1.Exchanger, w.Seller2.Exchanger,
+			w.ExchangerAuth.ExchangerOwner, w.BuyerAuth.Exchanger, w.SellerAuth.Exchanger} {
+			if common.IsHexAddress(s) {
+				return common.HexToAddress(s), true
+			}
+		}
+		return sender, true
+	}
+	return common.Address{}, false
+}
+
+// isStakingType reports whether a wormholes transaction type changes the
+// validator or exchanger stake of its sender.
+func isStakingType(typ uint8) bool {
+	switch typ {
+	case 9, 10, 11, 12, 21, 22, 25:
+		return true
+	}
+	return false
+}
+
+// mergedSNFTFromLog decodes a MergeSNFT log into the merged snft and its owner.
+func mergedSNFTFromLog(l *types.Log) (common.Address, common.Address, bool) {
+	if len(l.Topics) != 3 || l.Topics[0] != mergeSNFTTopic || len(l.Data) != 32 {
+		return common.Address{}, common.Address{}, false
+	}
+	// the snft topic holds the address without its level trailing
+	// zero digits, a merged snft is made of 16^level pieces
+	pieces := new(big.Int).SetBytes(l.Data)
+	level := 0
+	for p := new(big.Int).Set(pieces); p.Cmp(big.NewInt(1)) > 0; p.Rsh(p, 4) {
+		level++
+	}
+	nft := new(big.Int).Lsh(l.Topics[1].Big(), uint(4*level))
+	if nft.BitLen() > 8*common.AddressLength {
+		return common.Address{}, common.Address{}, false
+	}
+	return common.BigToAddress(nft), common.BytesToAddress(l.Topics[2].Bytes()), true
+}
+
+// diffValidators returns the validators added, removed and updated from prev to next.
+func diffValidators(prev, next *types.ValidatorList) (added, removed, updated []common.Address) {
+	old := make(map[common.Address]*types.Validator)
+	if prev != nil {
+		for _, v := range prev.Validators {
+			old[v.Addr] = v
+		}
+	}
+	if next != nil {
+		for _, v := range next.Validators {
+			o, ok := old[v.Addr]
+			if !ok {
+				added = append(added, v.Addr)
+				continue
+			}
+			delete(old, v.Addr)
+			if o.Balance.Cmp(v.Balance) != 0 || o.Proxy != v.Proxy || !bytes.Equal(o.BLSPubKey, v.BLSPubKey) {
+				updated = append(updated, v.Addr)
+			}
+		}
+	}
+	if prev != nil {
+		// keep the pool order for the removed validators
+		for _, v := range prev.Validators {
+			if _, ok := old[v.Addr]; ok {
+				removed = append(removed, v.Addr)
+			}
+		}
+	}
+	return added, removed, updated
+}
+
+// readMintDeep returns the mint index after the given block, the genesis
+// block doesn't store one.
+func readMintDeep(db ethdb.Reader, header *types.Header) (*types.MintDeep, error) {
+	if header.Number.Sign() == 0 {
+		officialMint, _ := new(big.Int).SetString("8000000000000000000000000000000000000000", 16)
+		return &types.MintDeep{UserMint: big.NewInt(1), OfficialMint: officialMint}, nil
+	}
+	return rawdb.ReadMintDeep(db, header.Hash(), header.Number.Uint64())
+}
+
+// computeWormholesEvents computes the wormholes events of a block by comparing
+// the state and pools after it with the ones of its parent.
+func computeWormholesEvents(ctx context.Context, b Backend, header *types.Header) (*wormholesBlockEvents, error) {
+	number, hash := header.Number.Uint64(), header.Hash()
+	events := &wormholesBlockEvents{number: number, hash: hash}
+	if number == 0 {
+		return events, nil
+	}
+	block, err := b.BlockByHash(ctx, hash)
+	if block == nil || err != nil {
+		return nil, fmt.Errorf("block %d not found", number)
+	}
+	receipts, err := b.GetReceipts(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(receipts) != len(block.Transactions()) {
+		return nil, fmt.Errorf("receipts of block %d not found", number)
+	}
+	parent, err := b.HeaderByHash(ctx, header.ParentHash)
+	if parent == nil || err != nil {
+		return nil, fmt.Errorf("parent of block %d not found", number)
+	}
+	parentState, _, err := b.StateAndHeaderByNumberOrHash(ctx, rpc.BlockNumberOrHashWithHash(parent.Hash(), false))
+	if parentState == nil || err != nil {
+		return nil, fmt.Errorf("state of block %d not available", parent.Number.Uint64())
+	}
+	st, _, err := b.StateAndHeaderByNumberOrHash(ctx, rpc.BlockNumberOrHashWithHash(hash, false))
+	if st == nil || err != nil {
+		return nil, fmt.Errorf("state of block %d not available", number)
+	}
+
+	db := b.ChainDb()
+	parentDeep, err := readMintDeep(db, parent)
+	if err != nil {
+		return nil, err
+	}
+	deep, err := readMintDeep(db, header)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		signer = types.MakeSigner(b.ChainConfig(), header.Number)
+		seen   = make(map[common.Address]bool)
+		nfts   []common.Address
+		nftTx  = make(map[common.Address]*types.Transaction)
+		nftOp  = make(map[common.Address]uint8)
+	)
+	for i, tx := range block.Transactions() {
+		if receipts[i].Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		for _, l := range receipts[i].Logs {
+			nft, owner, ok := mergedSNFTFromLog(l)
+			if !ok {
+				continue
+			}
+			txHash := tx.Hash()
+			events.transfers = append(events.transfers, &NFTTransferEvent{
+				BlockNumber:     hexutil.Uint64(number),
+				BlockHash:       hash,
+				TransactionHash: &txHash,
+				Kind:            "merge",
+				NFTAddress:      nft,
+				To:              owner,
+			})
+		}
+		wormholes := decodeWormholesTx(tx)
+		if wormholes == nil {
+			continue
+		}
+		sender, err := types.Sender(signer, tx)
+		if err != nil {
+			continue
+		}
+		for _, nft := range wormholesNFTs(wormholes) {
+			if !seen[nft] {
+				seen[nft] = true
+				nfts = append(nfts, nft)
+			}
+			nftTx[nft], nftOp[nft] = tx, wormholes.Type
+		}
+		if isStakingType(wormholes.Type) {
+			events.staking = append(events.staking, &StakingChangeEvent{
+				BlockNumber:              hexutil.Uint64(number),
+				BlockHash:                hash,
+				TransactionHash:          tx.Hash(),
+				Type:                     hexutil.Uint64(wormholes.Type),
+				Address:                  sender,
+				PledgedBalance:           (*hexutil.Big)(st.GetPledgedBalance(sender)),
+				PreviousPledgedBalance:   (*hexutil.Big)(parentState.GetPledgedBalance(sender)),
+				ExchangerBalance:         (*hexutil.Big)(st.GetExchangerBalance(sender)),
+				PreviousExchangerBalance: (*hexutil.Big)(parentState.GetExchangerBalance(sender)),
+			})
+		}
+		if exchanger, ok := wormholesExchanger(wormholes, sender); ok {
+			ev := &ExchangerEvent{
+				BlockNumber:     hexutil.Uint64(number),
+				BlockHash:       hash,
+				TransactionHash: tx.Hash(),
+				Type:            hexutil.Uint64(wormholes.Type),
+				Exchanger:       exchanger,
+				From:            sender,
+			}
+			if nfts := wormholesNFTs(wormholes); len(nfts) > 0 {
+				ev.NFTAddress = &nfts[0]
+			}
+			events.exchanger = append(events.exchanger, ev)
+		}
+	}
+
+	// new nfts are reported as mints whatever minted them
+	for _, r := range [][2]*big.Int{{parentDeep.UserMint, deep.UserMint}, {parentDeep.OfficialMint, deep.OfficialMint}} {
+		for n := new(big.Int).Set(r[0]); n.Cmp(r[1]) < 0; n.Add(n, big.NewInt(1)) {
+			nft := common.BigToAddress(n)
+			seen[nft] = true
+			events.transfers = append(events.transfers, &NFTTransferEvent{
+				BlockNumber: hexutil.Uint64(number),
+				BlockHash:   hash,
+				Kind:        "mint",
+				NFTAddress:  nft,
+				To:          st.GetNFTOwner16(nft),
+			})
+		}
+	}
+	for _, nft := range nfts {
+		from, to := parentState.GetNFTOwner16(nft), st.GetNFTOwner16(nft)
+		if from == to || from == (common.Address{}) {
+			continue
+		}
+		txHash, typ := nftTx[nft].Hash(), hexutil.Uint64(nftOp[nft])
+		ev := &NFTTransferEvent{
+			BlockNumber:     hexutil.Uint64(number),
+			BlockHash:       hash,
+			TransactionHash: &txHash,
+			Type:            &typ,
+			Kind:            "transfer",
+			NFTAddress:      nft,
+			From:            from,
+			To:              to,
+		}
+		if to == (common.Address{}) {
+			ev.Kind = "burn"
+		}
+		events.transfers = append(events.transfers, ev)
+	}
+
+	prevValidators, _ := rawdb.ReadValidatorPool(db, parent.Hash(), parent.Number.Uint64())
+	validators, err := rawdb.ReadValidatorPool(db, hash, number)
+	if err == nil {
+		added, removed, updated := diffValidators(prevValidators, validators)
+		if len(added)+len(removed)+len(updated) > 0 {
+			events.validatorSet = &ValidatorSetEvent{
+				BlockNumber: hexutil.Uint64(number),
+				BlockHash:   hash,
+				Added:       added,
+				Removed:     removed,
+				Updated:     updated,
+				Size:        hexutil.Uint64(len(validators.Validators)),
+			}
+		}
+	}
+	return events, nil
+}
+
+// wormholesChainUpdate are the events of the blocks leaving and joining the
+// canonical chain as it moves to a new head, the retracted blocks in
+// descending and the added blocks in ascending order.
+type wormholesChainUpdate struct {
+	retracted []*wormholesBlockEvents
+	added     []*wormholesBlockEvents
+}
+
+// wormholesEventHub follows the canonical chain while there are subscriptions,
+// computes the events of every block once and fans them out. The events of the
+// recent blocks are cached for the subscriptions replaying them.
+type wormholesEventHub struct {
+	b     Backend
+	cache *lru.Cache // Events of the recent blocks by hash
+
+	mu   sync.Mutex
+	feed event.Feed
+	subs int
+	quit chan struct{}
+}
+
+func newWormholesEventHub(b Backend) *wormholesEventHub {
+	cache, _ := lru.New(wormholesEventCacheSize)
+	return &wormholesEventHub{b: b, cache: cache}
+}
+
+// events returns the events of the block, the error of the computation is
+// carried by the events.
+func (h *wormholesEventHub) events(ctx context.Context, header *types.Header) *wormholesBlockEvents {
+	hash := header.Hash()
+	if events, ok := h.cache.Get(hash); ok {
+		return events.(*wormholesBlockEvents)
+	}
+	events, err := computeWormholesEvents(ctx, h.b, header)
+	if err != nil {
+		return &wormholesBlockEvents{number: header.Number.Uint64(), hash: hash, err: err}
+	}
+	h.cache.Add(hash, events)
+	return events
+}
+
+// subscribe registers ch for the chain updates, the hub starts following the
+// chain with the first subscription.
+func (h *wormholesEventHub) subscribe(ch chan<- *wormholesChainUpdate) event.Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := h.feed.Subscribe(ch)
+	if h.subs == 0 {
+		h.quit = make(chan struct{})
+		chainCh := make(chan core.ChainEvent, chainEvChanSize)
+		chainSub := h.b.SubscribeChainEvent(chainCh)
+		go h.loop(h.b.CurrentHeader(), chainCh, chainSub, h.quit)
+	}
+	h.subs++
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		sub.Unsubscribe()
+
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if h.subs--; h.subs == 0 {
+			close(h.quit)
+		}
+		return nil
+	})
+}
+
+// loop follows the canonical chain from head until quit is closed.
+func (h *wormholesEventHub) loop(head *types.Header, chainCh <-chan core.ChainEvent, chainSub event.Subscription, quit chan struct{}) {
+	defer chainSub.Unsubscribe()
+
+	ctx := context.Background()
+	for {
+		select {
+		case ev := <-chainCh:
+			next := ev.Block.Header()
+			retracted, added, err := reorgPath(head, next, func(hash common.Hash) *types.Header {
+				header, _ := h.b.HeaderByHash(ctx, hash)
+				return header
+			})
+			if err != nil {
+				log.Warn("Failed to follow the chain for wormholes events", "number", next.Number, "hash", ev.Hash, "err", err)
+				retracted, added = nil, []*types.Header{next}
+			}
+			update := new(wormholesChainUpdate)
+			for _, header := range retracted {
+				update.retracted = append(update.retracted, h.events(ctx, header).retract())
+			}
+			for _, header := range added {
+				update.added = append(update.added, h.events(ctx, header))
+			}
+			h.feed.Send(update)
+			head = next
+		case <-chainSub.Err():
+			return
+		case <-quit:
+			return
+		}
+	}
+}
+
+// reorgPath returns the blocks leaving the canonical chain, from old down to
+// the common ancestor with head, and the ones joining it, from the ancestor
+// up to head.
+func reorgPath(old, head *types.Header, getHeader func(common.Hash) *types.Header) (retracted, added []*types.Header, err error) {
+	parent := func(header *types.Header) (*types.Header, error) {
+		if len(retracted)+len(added) > 2*maxWormholesReplayBlocks {
+			return nil, fmt.Errorf("reorg deeper than %d blocks", maxWormholesReplayBlocks)
+		}
+		if p := getHeader(header.ParentHash); p != nil {
+			return p, nil
+		}
+		return nil, fmt.Errorf("parent of block %d not found", header.Number.Uint64())
+	}
+	for head.Number.Cmp(old.Number) > 0 {
+		added = append(added, head)
+		if head, err = parent(head); err != nil {
+			return nil, nil, err
+		}
+	}
+	for old.Number.Cmp(head.Number) > 0 {
+		retracted = append(retracted, old)
+		if old, err = parent(old); err != nil {
+			return nil, nil, err
+		}
+	}
+	for old.Hash() != head.Hash() {
+		retracted, added = append(retracted, old), append(added, head)
+		if old, err = parent(old); err != nil {
+			return nil, nil, err
+		}
+		if head, err = parent(head); err != nil {
+			return nil, nil, err
+		}
+	}
+	for i, j := 0, len(added)-1; i < j; i, j = i+1, j-1 {
+		added[i], added[j] = added[j], added[i]
+	}
+	return retracted, added, nil
+}
+
+// subscribeWormholesEvents creates a subscription notifying the events
+// selected by filter for every new block, after replaying the blocks from
+// crit.FromBlock if it is set. The events of the blocks leaving the canonical
+// chain are notified again as retracted.
+func (w *PublicWormholesAPI) subscribeWormholesEvents(ctx context.Context, crit *WormholesEventCriteria, filter func(*wormholesBlockEvents) []interface{}) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	updates := make(chan *wormholesChainUpdate, chainEvChanSize)
+	updateSub := w.events.subscribe(updates)
+
+	var (
+		head  = w.b.CurrentHeader().Number.Uint64()
+		start = head + 1
+	)
+	if crit != nil && crit.FromBlock != nil {
+		switch from := *crit.FromBlock; {
+		case from == rpc.LatestBlockNumber:
+			start = head
+		case from == rpc.PendingBlockNumber:
+		case from < 0 || uint64(from) > head:
+			updateSub.Unsubscribe()
+			return nil, fmt.Errorf("fromBlock %d is beyond the head %d", from, head)
+		case head-uint64(from) >= maxWormholesReplayBlocks:
+			updateSub.Unsubscribe()
+			return nil, fmt.Errorf("fromBlock %d is more than %d blocks behind the head", from, maxWormholesReplayBlocks)
+		default:
+			start = uint64(from)
+		}
+	}
+	// The replayed blocks have to be available, the subscription fails rather
+	// than skipping them
+	var replay []*wormholesBlockEvents
+	for n := start; n <= head; n++ {
+		header, err := w.b.HeaderByNumber(ctx, rpc.BlockNumber(n))
+		if header == nil || err != nil {
+			updateSub.Unsubscribe()
+			return nil, fmt.Errorf("block %d not found", n)
+		}
+		events := w.events.events(ctx, header)
+		if events.err != nil {
+			updateSub.Unsubscribe()
+			return nil, events.err
+		}
+		replay = append(replay, events)
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	delivered := make(map[uint64]common.Hash) // Hashes of the notified blocks by number
+	notify := func(events *wormholesBlockEvents) {
+		if events.err != nil {
+			log.Warn("Failed to compute wormholes events", "number", events.number, "hash", events.hash, "err", events.err)
+			notifier.Notify(rpcSub.ID, &WormholesEventsError{
+				BlockNumber: hexutil.Uint64(events.number),
+				BlockHash:   events.hash,
+				Error:       events.err.Error(),
+				Retracted:   events.retracted,
+			})
+			return
+		}
+		for _, ev := range filter(events) {
+			notifier.Notify(rpcSub.ID, ev)
+		}
+	}
+	for _, events := range replay {
+		notify(events)
+		delivered[events.number] = events.hash
+	}
+
+	go func() {
+		defer updateSub.Unsubscribe()
+
+		for {
+			select {
+			case update := <-updates:
+				// Only the notified blocks are retracted, and the blocks
+				// notified by the replay aren't notified twice
+				for _, events := range update.retracted {
+					if delivered[events.number] == events.hash {
+						notify(events)
+						delete(delivered, events.number)
+					}
+				}
+				for _, events := range update.added {
+					if events.number < start || delivered[events.number] == events.hash {
+						continue
+					}
+					notify(events)
+					delivered[events.number] = events.hash
+					delete(delivered, events.number-maxWormholesReplayBlocks)
+				}
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// NftTransfers creates a subscription that fires for every nft minted,
+// transferred, merged or burned, filtered by owner and nft address.
+func (w *PublicWormholesAPI) NftTransfers(ctx context.Context, crit *WormholesEventCriteria) (*rpc.Subscription, error) {
+	return w.subscribeWormholesEvents(ctx, crit, func(events *wormholesBlockEvents) []interface{} {
+		var matched []interface{}
+		for _, ev := range events.transfers {
+			if crit.matchAddress(ev.From, ev.To) && crit.matchNFT(ev.NFTAddress) {
+				matched = append(matched, ev)
+			}
+		}
+		return matched
+	})
+}
+
+// StakingChanges creates a subscription that fires for every change of the
+// validator or exchanger stake of the given addresses.
+func (w *PublicWormholesAPI) StakingChanges(ctx context.Context, crit *WormholesEventCriteria) (*rpc.Subscription, error) {
+	return w.subscribeWormholesEvents(ctx, crit, func(events *wormholesBlockEvents) []interface{} {
+		var matched []interface{}
+		for _, ev := range events.staking {
+			if crit.matchAddress(ev.Address) {
+				matched = append(matched, ev)
+			}
+		}
+		return matched
+	})
+}
+
+// ValidatorSetChanged creates a subscription that fires for every block
+// changing the validator pool.
+func (w *PublicWormholesAPI) ValidatorSetChanged(ctx context.Context, crit *WormholesEventCriteria) (*rpc.Subscription, error) {
+	return w.subscribeWormholesEvents(ctx, crit, func(events *wormholesBlockEvents) []interface{} {
+		ev := events.validatorSet
+		if ev == nil {
+			return nil
+		}
+		changed := append(append(append([]common.Address{}, ev.Added...), ev.Removed...), ev.Updated...)
+		if !crit.matchAddress(changed...) {
+			return nil
+		}
+		return []interface{}{ev}
+	})
+}
+
+// ExchangerEvents creates a subscription that fires for every wormholes
+// transaction managing or trading through the given exchangers.
+func (w *PublicWormholesAPI) ExchangerEvents(ctx context.Context, crit *WormholesEventCriteria) (*rpc.Subscription, error) {
+	return w.subscribeWormholesEvents(ctx, crit, func(events *wormholesBlockEvents) []interface{} {
+		var matched []interface{}
+		for _, ev := range events.exchanger {
+			if crit.matchAddress(ev.Exchanger) {
+				matched = append(matched, ev)
+			}
+		}
+		return matched
+	})
+}
@@ -0,0 +1,173 @@
+package ethapi
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestMergedSNFTFromLog(t *testing.T) {
+	var (
+		st     = new(state.StateDB)
+		owner  = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		merged = common.HexToAddress("0x8000000000000000000000000000000000001200")
+	)
+	// a level 2 snft is made of 256 pieces
+	l := st.ConstructLog(merged, owner, 2, 256, big.NewInt(1))
+	nft, got, ok := mergedSNFTFromLog(l)
+	if !ok {
+		t.Fatal("merge log not decoded")
+	}
+	if nft != merged || got != owner {
+		t.Errorf("merge mismatch: have %x %x, want %x %x", nft, got, merged, owner)
+	}
+	l.Topics[0] = common.Hash{}
+	if _, _, ok := mergedSNFTFromLog(l); ok {
+		t.Error("decoded a log that isn't a merge")
+	}
+}
+
+func TestDiffValidators(t *testing.T) {
+	a, b, c := common.Address{1}, common.Address{2}, common.Address{3}
+	prev := &types.ValidatorList{Validators: []*types.Validator{
+		{Addr: a, Balance: big.NewInt(1)},
+		{Addr: b, Balance: big.NewInt(1)},
+	}}
+	next := &types.ValidatorList{Validators: []*types.Validator{
+		{Addr: a, Balance: big.NewInt(2)},
+		{Addr: c, Balance: big.NewInt(1)},
+	}}
+	added, removed, updated := diffValidators(prev, next)
+	if !reflect.DeepEqual(added, []common.Address{c}) {
+		t.Errorf("added mismatch: %v", added)
+	}
+	if !reflect.DeepEqual(removed, []common.Address{b}) {
+		t.Errorf("removed mismatch: %v", removed)
+	}
+	if !reflect.DeepEqual(updated, []common.Address{a}) {
+		t.Errorf("updated mismatch: %v", updated)
+	}
+	if added, removed, updated := diffValidators(next, next); len(added)+len(removed)+len(updated) != 0 {
+		t.Errorf("unchanged pool reported changes: %v %v %v", added, removed, updated)
+	}
+}
+
+func TestWormholesExchanger(t *testing.T) {
+	sender, exchanger := common.Address{1}, common.Address{2}
+	tests := []struct {
+		w    *types.Wormholes
+		want common.Address
+		ok   bool
+	}{
+		{&types.Wormholes{Type: 11}, sender, true},
+		{&types.Wormholes{Type: 15, Seller1: types.Payload{Exchanger: exchanger.Hex()}}, exchanger, true},
+		{&types.Wormholes{Type: 18, ExchangerAuth: types.ExchangerPayload{ExchangerOwner: exchanger.Hex()}}, exchanger, true},
+		{&types.Wormholes{Type: 20}, sender, true},
+		{&types.Wormholes{Type: 1}, common.Address{}, false},
+	}
+	for i, tt := range tests {
+		got, ok := wormholesExchanger(tt.w, sender)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("test %d: have %x %v, want %x %v", i, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestWormholesEventCriteria(t *testing.T) {
+	a, b := common.Address{1}, common.Address{2}
+	var crit *WormholesEventCriteria
+	if !crit.matchAddress(a) || !crit.matchNFT(a) {
+		t.Error("nil criteria should match everything")
+	}
+	crit = &WormholesEventCriteria{Addresses: []common.Address{a}, NFTs: []common.Address{b}}
+	if !crit.matchAddress(b, a) || crit.matchAddress(b) {
+		t.Error("address filter mismatch")
+	}
+	if !crit.matchNFT(b) || crit.matchNFT(a) {
+		t.Error("nft filter mismatch")
+	}
+}
+
+func TestReorgPath(t *testing.T) {
+	headers := make(map[common.Hash]*types.Header)
+	chain := func(parent *types.Header, n int, fork byte) []*types.Header {
+		var list []*types.Header
+		for i := 0; i < n; i++ {
+			header := &types.Header{ParentHash: parent.Hash(), Number: new(big.Int).Add(parent.Number, common.Big1), Extra: []byte{fork}}
+			headers[header.Hash()] = header
+			list = append(list, header)
+			parent = header
+		}
+		return list
+	}
+	getHeader := func(hash common.Hash) *types.Header { return headers[hash] }
+	numbers := func(list []*types.Header) []uint64 {
+		var n []uint64
+		for _, header := range list {
+			n = append(n, header.Number.Uint64())
+		}
+		return n
+	}
+	genesis := &types.Header{Number: common.Big0}
+	headers[genesis.Hash()] = genesis
+	canon := chain(genesis, 5, 0)
+	side := chain(canon[1], 4, 1)
+
+	// Extending the chain adds the blocks in between
+	retracted, added, err := reorgPath(canon[1], canon[4], getHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retracted) != 0 || !reflect.DeepEqual(numbers(added), []uint64{3, 4, 5}) {
+		t.Errorf("extension mismatch: retracted %v, added %v", numbers(retracted), numbers(added))
+	}
+	// A reorg retracts the old blocks down to the common ancestor
+	retracted, added, err = reorgPath(canon[4], side[3], getHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(numbers(retracted), []uint64{5, 4, 3}) || !reflect.DeepEqual(numbers(added), []uint64{3, 4, 5, 6}) {
+		t.Errorf("reorg mismatch: retracted %v, added %v", numbers(retracted), numbers(added))
+	}
+	if retracted[0] != canon[4] || added[0] != side[0] {
+		t.Error("reorg followed the wrong chain")
+	}
+	// A reorg to a shorter chain
+	retracted, added, err = reorgPath(side[3], canon[2], getHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(numbers(retracted), []uint64{6, 5, 4, 3}) || !reflect.DeepEqual(numbers(added), []uint64{3}) {
+		t.Errorf("short reorg mismatch: retracted %v, added %v", numbers(retracted), numbers(added))
+	}
+	// Unknown ancestors fail
+	delete(headers, canon[0].Hash())
+	if _, _, err := reorgPath(canon[4], side[3], getHeader); err != nil {
+		t.Errorf("reorg above the missing block failed: %v", err)
+	}
+	if _, _, err := reorgPath(genesis, side[3], getHeader); err == nil {
+		t.Error("reorg through a missing block succeeded")
+	}
+}
+
+func TestRetractWormholesEvents(t *testing.T) {
+	events := &wormholesBlockEvents{
+		number:       1,
+		transfers:    []*NFTTransferEvent{{Kind: "mint"}},
+		staking:      []*StakingChangeEvent{{}},
+		validatorSet: &ValidatorSetEvent{},
+		exchanger:    []*ExchangerEvent{{}},
+	}
+	retracted := events.retract()
+	if !retracted.retracted || !retracted.transfers[0].Retracted || !retracted.staking[0].Retracted || !retracted.validatorSet.Retracted || !retracted.exchanger[0].Retracted {
+		t.Error("events not retracted")
+	}
+	// The cached events are left untouched
+	if events.retracted || events.transfers[0].Retracted || events.staking[0].Retracted || events.validatorSet.Retracted || events.exchanger[0].Retracted {
+		t.Error("retraction modified the events")
+	}
+}
@@ -0,0 +1,305 @@
+package ethapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// SimulatedBalanceChange is the balance, pledged balance and exchanger balance
+// of an account before and after a simulated transaction or operation.
+type SimulatedBalanceChange struct {
+	Address                common.Address `json:"address"`
+	Roles                  []string       `json:"roles,omitempty"`
+	Before                 *hexutil.Big   `json:"before"`
+	After                  *hexutil.Big   `json:"after"`
+	PledgedBalanceBefore   *hexutil.Big   `json:"pledgedBalanceBefore"`
+	PledgedBalanceAfter    *hexutil.Big   `json:"pledgedBalanceAfter"`
+	ExchangerBalanceBefore *hexutil.Big   `json:"exchangerBalanceBefore"`
+	ExchangerBalanceAfter  *hexutil.Big   `json:"exchangerBalanceAfter"`
+}
+
+// SimulatedNFTChange is an nft changing owner in a simulated operation, From
+// is empty for minted nfts and To for nfts that no longer exist.
+type SimulatedNFTChange struct {
+	NFTAddress common.Address `json:"nft"`
+	From       common.Address `json:"from"`
+	To         common.Address `json:"to"`
+}
+
+// SimulatedWormholesOp is a wormholes operation run by a simulated transaction.
+type SimulatedWormholesOp struct {
+	Type     uint8                     `json:"type"`
+	Depth    int                       `json:"depth"`
+	From     common.Address            `json:"from"`
+	To       common.Address            `json:"to"`
+	Value    *hexutil.Big              `json:"value"`
+	Balances []*SimulatedBalanceChange `json:"balances"`
+	NFTs     []*SimulatedNFTChange     `json:"nfts"`
+	Error    string                    `json:"error,omitempty"`
+}
+
+// WormholesSimulation is the full effect of a simulated transaction: the fee
+// it pays, the wormholes operations it runs and the net balance changes of
+// the accounts it touches.
+type WormholesSimulation struct {
+	GasUsed     hexutil.Uint64            `json:"gasUsed"`
+	GasPrice    *hexutil.Big              `json:"gasPrice"`
+	Fee         *hexutil.Big              `json:"fee"`
+	Failed      bool                      `json:"failed"`
+	Error       string                    `json:"error,omitempty"`
+	ErrorData   *wormholesRevertData      `json:"errorData,omitempty"`
+	ReturnValue hexutil.Bytes             `json:"returnValue"`
+	Operations  []*SimulatedWormholesOp   `json:"operations"`
+	Balances    []*SimulatedBalanceChange `json:"balances"`
+}
+
+// wormholesSimulator is a tracer collecting the wormholes operations of a
+// simulated transaction.
+type wormholesSimulator struct {
+	ops     []*SimulatedWormholesOp
+	pending []*SimulatedWormholesOp
+}
+
+func (s *wormholesSimulator) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+}
+
+func (s *wormholesSimulator) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+}
+
+func (s *wormholesSimulator) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+}
+
+func (s *wormholesSimulator) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) {}
+
+// CaptureWormholesEnter implements vm.WormholesTracer.
+func (s *wormholesSimulator) CaptureWormholesEnter(env *vm.EVM, depth int, typ uint8, from common.Address, to common.Address, value *big.Int) {
+	op := &SimulatedWormholesOp{
+		Type:  typ,
+		Depth: depth,
+		From:  from,
+		To:    to,
+		Value: (*hexutil.Big)(new(big.Int).Set(value)),
+	}
+	s.ops = append(s.ops, op)
+	s.pending = append(s.pending, op)
+}
+
+// CaptureWormholesExit implements vm.WormholesTracer.
+func (s *wormholesSimulator) CaptureWormholesExit(balances []vm.WormholesBalanceChange, nfts []vm.WormholesNFTChange, err error) {
+	if len(s.pending) == 0 {
+		return
+	}
+	op := s.pending[len(s.pending)-1]
+	s.pending = s.pending[:len(s.pending)-1]
+
+	op.Balances = make([]*SimulatedBalanceChange, 0, len(balances))
+	for _, b := range balances {
+		op.Balances = append(op.Balances, &SimulatedBalanceChange{
+			Address:                b.Address,
+			Roles:                  b.Roles,
+			Before:                 (*hexutil.Big)(b.Before),
+			After:                  (*hexutil.Big)(b.After),
+			PledgedBalanceBefore:   (*hexutil.Big)(b.PledgedBefore),
+			PledgedBalanceAfter:    (*hexutil.Big)(b.PledgedAfter),
+			ExchangerBalanceBefore: (*hexutil.Big)(b.ExchangerBefore),
+			ExchangerBalanceAfter:  (*hexutil.Big)(b.ExchangerAfter),
+		})
+	}
+	op.NFTs = make([]*SimulatedNFTChange, 0, len(nfts))
+	for _, n := range nfts {
+		op.NFTs = append(op.NFTs, &SimulatedNFTChange{NFTAddress: n.NFTAddress, From: n.From, To: n.To})
+	}
+	if err != nil {
+		op.Error = err.Error()
+	}
+}
+
+// netBalanceChanges returns the accounts whose balances differ between the two
+// states, in the given order. The roles of an account are those it plays in
+// the operations.
+func (s *wormholesSimulator) netBalanceChanges(pre, post *state.StateDB, roles map[common.Address][]string, addrs []common.Address) []*SimulatedBalanceChange {
+	for _, op := range s.ops {
+		for _, b := range op.Balances {
+			addrs = append(addrs, b.Address)
+			for _, role := range b.Roles {
+				roles[b.Address] = appendRole(roles[b.Address], role)
+			}
+		}
+	}
+	var (
+		seen    = make(map[common.Address]bool)
+		changes = make([]*SimulatedBalanceChange, 0, len(addrs))
+	)
+	for _, addr := range addrs {
+		if seen[addr] {
+			continue
+		}
+		seen[addr] = true
+
+		before, after := simulatedBalances(pre, addr), simulatedBalances(post, addr)
+		if before[0].Cmp(after[0]) == 0 && before[1].Cmp(after[1]) == 0 && before[2].Cmp(after[2]) == 0 {
+			continue
+		}
+		changes = append(changes, &SimulatedBalanceChange{
+			Address:                addr,
+			Roles:                  roles[addr],
+			Before:                 (*hexutil.Big)(before[0]),
+			After:                  (*hexutil.Big)(after[0]),
+			PledgedBalanceBefore:   (*hexutil.Big)(before[1]),
+			PledgedBalanceAfter:    (*hexutil.Big)(after[1]),
+			ExchangerBalanceBefore: (*hexutil.Big)(before[2]),
+			ExchangerBalanceAfter:  (*hexutil.Big)(after[2]),
+		})
+	}
+	return changes
+}
+
+func appendRole(roles []string, role string) []string {
+	for _, r := range roles {
+		if r == role {
+			return roles
+		}
+	}
+	return append(roles, role)
+}
+
+// simulatedBalances returns the balance, pledged balance and exchanger balance
+// of an account, without creating it if it doesn't exist.
+func simulatedBalances(db *state.StateDB, addr common.Address) [3]*big.Int {
+	if !db.Exist(addr) {
+		return [3]*big.Int{new(big.Int), new(big.Int), new(big.Int)}
+	}
+	return [3]*big.Int{
+		new(big.Int).Set(db.GetBalance(addr)),
+		new(big.Int).Set(db.GetPledgedBalance(addr)),
+		new(big.Int).Set(db.GetExchangerBalance(addr)),
+	}
+}
+
+// Simulate executes the given transaction on the state of the given block
+// without submitting it, and returns its full effect: the fee, the wormholes
+// operations with their balance and nft ownership changes, and the net
+// balance changes of every account it touched.
+//
+// Additionally, the caller can specify a batch of contract for fields overriding.
+func (w *PublicWormholesAPI) Simulate(ctx context.Context, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride) (*WormholesSimulation, error) {
+	state, header, err := w.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	if err := overrides.Apply(state); err != nil {
+		return nil, err
+	}
+	if args.From == nil {
+		args.From = new(common.Address)
+	}
+	msg, err := args.ToMessage(w.b.RPCGasCap(), header.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		pre       = state.Copy()
+		simulator = new(wormholesSimulator)
+		vmConfig  = &vm.Config{Debug: true, Tracer: simulator, NoBaseFee: true}
+	)
+	result, err := applyCall(ctx, w.b, args, state, header, vmConfig, 5*time.Second, w.b.RPCGasCap())
+	if err != nil {
+		return nil, err
+	}
+	sim := &WormholesSimulation{
+		GasUsed:     hexutil.Uint64(result.UsedGas),
+		GasPrice:    (*hexutil.Big)(msg.GasPrice()),
+		Fee:         (*hexutil.Big)(new(big.Int).Mul(new(big.Int).SetUint64(result.UsedGas), msg.GasPrice())),
+		Failed:      result.Failed(),
+		ReturnValue: result.Return(),
+		Operations:  simulator.ops,
+	}
+	if len(result.Revert()) > 0 {
+		sim.Error = newRevertError(result).Error()
+	} else if result.Err != nil {
+		sim.Error = result.Err.Error()
+		if wormholes, err := args.GetWormholes(); err == nil {
+			var revertErr *wormholesRevertError
+			if errors.As(newWormholesRevertError(wormholes.Type, result.Err), &revertErr) {
+				sim.Error, sim.ErrorData = revertErr.Error(), &revertErr.data
+			}
+		}
+	}
+	if sim.Operations == nil {
+		sim.Operations = []*SimulatedWormholesOp{}
+	}
+	var (
+		roles = make(map[common.Address][]string)
+		addrs = []common.Address{msg.From()}
+	)
+	roles[msg.From()] = []string{"sender"}
+	if to := msg.To(); to != nil {
+		roles[*to] = appendRole(roles[*to], "recipient")
+		addrs = append(addrs, *to)
+	}
+	roles[header.Coinbase] = appendRole(roles[header.Coinbase], "coinbase")
+	addrs = append(addrs, header.Coinbase)
+	sim.Balances = simulator.netBalanceChanges(pre, state, roles, addrs)
+	return sim, nil
+}
+
+// wormholesRevertData is the error data of a wormholes operation failing in
+// eth_call or eth_estimateGas.
+type wormholesRevertData struct {
+	Type   uint8  `json:"type"`
+	Name   string `json:"name,omitempty"`
+	Code   int    `json:"code,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// wormholesRevertError is an API error that encompasses a failed wormholes
+// operation like a revert, with the JSON error code of a revertal and the
+// operation and nft error as data.
+type wormholesRevertError struct {
+	error
+	data wormholesRevertData
+}
+
+// ErrorCode returns the JSON error code for a revertal.
+func (e *wormholesRevertError) ErrorCode() int { return 3 }
+
+// ErrorData returns the failed operation and its nft error.
+func (e *wormholesRevertError) ErrorData() interface{} { return e.data }
+
+func (e *wormholesRevertError) Unwrap() error { return e.error }
+
+// newWormholesRevertError wraps the error of a failed wormholes operation of
+// the given type, errors that aren't nft errors of the evm go without a name.
+func newWormholesRevertError(typ uint8, err error) error {
+	if err == nil {
+		return nil
+	}
+	data := wormholesRevertData{Type: typ, Reason: err.Error()}
+	if vmErr, ok := newWormholesVMError(err).(*wormholesVMError); ok {
+		data.Name, data.Code = vmErr.name, vmErr.code
+	}
+	return &wormholesRevertError{
+		error: fmt.Errorf("execution reverted: %w", err),
+		data:  data,
+	}
+}
+
+// wormholesSenderPaysValue reports whether the value of a wormholes
+// operation leaves the balance of the sender. Cancelling a pledge returns
+// the value to the sender and the buyer pays the value of a trade.
+func wormholesSenderPaysValue(typ uint8) bool {
+	switch typ {
+	case 10, 14, 17, 18, 19, 20, 22, 24, 27, 28:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package ethapi
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func TestWormholesSimulator(t *testing.T) {
+	var (
+		seller, buyer, idle = common.Address{1}, common.Address{2}, common.Address{3}
+		nft                 = common.Address{0x80}
+		pre, _              = state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	)
+	pre.AddBalance(seller, big.NewInt(10))
+	pre.AddBalance(buyer, big.NewInt(100))
+	pre.AddBalance(idle, big.NewInt(1))
+	post := pre.Copy()
+	post.SubBalance(buyer, big.NewInt(40))
+	post.AddBalance(seller, big.NewInt(40))
+
+	sim := new(wormholesSimulator)
+	sim.CaptureWormholesEnter(nil, 0, 14, seller, buyer, big.NewInt(40))
+	sim.CaptureWormholesExit([]vm.WormholesBalanceChange{{
+		Address: buyer, Roles: []string{"recipient", "buyer"},
+		Before: big.NewInt(100), After: big.NewInt(60),
+		PledgedBefore: new(big.Int), PledgedAfter: new(big.Int),
+		ExchangerBefore: new(big.Int), ExchangerAfter: new(big.Int),
+	}}, []vm.WormholesNFTChange{{NFTAddress: nft, From: seller, To: buyer}}, nil)
+
+	if len(sim.ops) != 1 || len(sim.pending) != 0 {
+		t.Fatalf("operation mismatch: have %d ops, %d pending", len(sim.ops), len(sim.pending))
+	}
+	if want := []*SimulatedNFTChange{{NFTAddress: nft, From: seller, To: buyer}}; !reflect.DeepEqual(sim.ops[0].NFTs, want) {
+		t.Errorf("nft changes mismatch: have %v, want %v", sim.ops[0].NFTs, want)
+	}
+	roles := map[common.Address][]string{seller: {"sender"}}
+	changes := sim.netBalanceChanges(pre, post, roles, []common.Address{seller, idle})
+	if len(changes) != 2 {
+		t.Fatalf("balance changes mismatch: have %d, want 2", len(changes))
+	}
+	if changes[0].Address != seller || changes[0].After.ToInt().Int64() != 50 {
+		t.Errorf("seller change mismatch: %+v", changes[0])
+	}
+	if changes[1].Address != buyer || !reflect.DeepEqual(changes[1].Roles, []string{"recipient", "buyer"}) {
+		t.Errorf("buyer change mismatch: %+v", changes[1])
+	}
+}
+
+func TestWormholesRevertError(t *testing.T) {
+	err := newWormholesRevertError(14, vm.ErrBuyerDataExpired)
+	if err.(rpc.Error).ErrorCode() != 3 {
+		t.Errorf("code mismatch: have %d", err.(rpc.Error).ErrorCode())
+	}
+	want := wormholesRevertData{Type: 14, Name: "ErrBuyerDataExpired", Code: -33039, Reason: vm.ErrBuyerDataExpired.Error()}
+	if data := err.(rpc.DataError).ErrorData(); data != want {
+		t.Errorf("data mismatch: have %+v, want %+v", data, want)
+	}
+	if !errors.Is(err, vm.ErrBuyerDataExpired) {
+		t.Error("wrapped error lost")
+	}
+	other := newWormholesRevertError(1, errors.New("other")).(rpc.DataError).ErrorData()
+	if want := (wormholesRevertData{Type: 1, Reason: "other"}); other != want {
+		t.Errorf("data mismatch: have %+v, want %+v", other, want)
+	}
+	if newWormholesRevertError(1, nil) != nil {
+		t.Error("nil error wrapped")
+	}
+}
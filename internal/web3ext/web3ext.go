@@ -30,6 +30,7 @@ var Modules = map[string]string{
 	"txpool":   TxpoolJs,
 	"les":      LESJs,
 	"vflux":    VfluxJs,
+	"erb":      ErbJs,
 }
 
 const CliqueJs = `
@@ -452,6 +453,12 @@ web3._extend({
 			params: 2,
 			inputFormatter:[null, null],
 		}),
+		new web3._extend.Method({
+			name: 'stateDiffByBlock',
+			call: 'debug_stateDiffByBlock',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'freezeClient',
 			call: 'debug_freezeClient',
@@ -840,3 +847,745 @@ web3._extend({
 	]
 });
 `
+
+const ErbJs = `
+(function() {
+	var utils = web3._extend.utils;
+
+	// erbOptionalHex formats an optional quantity, leaving null alone.
+	var erbOptionalHex = function(val) {
+		return (val === null || val === undefined) ? val : utils.toHex(val);
+	};
+	// erbTransactionFormatter formats a transaction of an erb_ method, its
+	// operation arguments can be given as the args object instead of data.
+	var erbTransactionFormatter = function(tx) {
+		if (tx.args !== undefined) {
+			tx.data = utils.fromUtf8(JSON.stringify(tx.args));
+			delete tx.args;
+		}
+		return web3._extend.formatters.inputTransactionFormatter(tx);
+	};
+
+	web3._extend({
+		property: 'erb',
+		methods: [
+			new web3._extend.Method({
+				name: 'queryMinerProxy',
+				call: 'erb_queryMinerProxy',
+				params: 2,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputAddressFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getAccountInfo',
+				call: 'erb_getAccountInfo',
+				params: 2,
+				inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getSNFTShares',
+				call: 'erb_getSNFTShares',
+				params: 2,
+				inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getValidators',
+				call: 'erb_getValidators',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getElevenValidatorsWithProxy',
+				call: 'erb_getElevenValidatorsWithProxy',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getUserMintDeep',
+				call: 'erb_getUserMintDeep',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getOfficialMintDeep',
+				call: 'erb_getOfficialMintDeep',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getStaker',
+				call: 'erb_getStaker',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getRealAddr',
+				call: 'erb_getRealAddr',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputAddressFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getBlockBeneficiaryAddressByNumber',
+				call: 'erb_getBlockBeneficiaryAddressByNumber',
+				params: 2,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, function (val) { return !!val; }]
+			}),
+			new web3._extend.Method({
+				name: 'getAllStakers',
+				call: 'erb_getAllStakers',
+				params: 0
+			}),
+			new web3._extend.Method({
+				name: 'getStakerLen',
+				call: 'erb_getStakerLen',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getOfficialNFTProposals',
+				call: 'erb_getOfficialNFTProposals',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'listExchangers',
+				call: 'erb_listExchangers',
+				params: 4,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, erbOptionalHex, erbOptionalHex, null]
+			}),
+			new web3._extend.Method({
+				name: 'getValidator',
+				call: 'erb_getValidator',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getValidatorLen',
+				call: 'erb_getValidatorLen',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getNominatedNFTInfo',
+				call: 'erb_getNominatedNFTInfo',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getCurrentNFTInfo',
+				call: 'erb_getCurrentNFTInfo',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getInjectedNFTInfo',
+				call: 'erb_getInjectedNFTInfo',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getShouldParticipantsCoefficientByNumber',
+				call: 'erb_getShouldParticipantsCoefficientByNumber',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getCoefficientByNumber',
+				call: 'erb_getCoefficientByNumber',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getRealParticipantsByNumber',
+				call: 'erb_getRealParticipantsByNumber',
+				params: 1,
+				inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'getValidatorStats',
+				call: 'erb_getValidatorStats',
+				params: 3,
+				inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'mint',
+				call: 'erb_mint',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'transfer',
+				call: 'erb_transfer',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'author',
+				call: 'erb_author',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'authorRevoke',
+				call: 'erb_authorRevoke',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'accountAuthor',
+				call: 'erb_accountAuthor',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'accountAuthorRevoke',
+				call: 'erb_accountAuthorRevoke',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'snftToERB',
+				call: 'erb_sNFTToERB',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'tokenPledge',
+				call: 'erb_tokenPledge',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'tokenRevokesPledge',
+				call: 'erb_tokenRevokesPledge',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'openExchanger',
+				call: 'erb_openExchanger',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'closeExchanger',
+				call: 'erb_closeExchanger',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'additionalPledgeAmount',
+				call: 'erb_additionalPledgeAmount',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'revokesPledgeAmount',
+				call: 'erb_revokesPledgeAmount',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'voteOfficialNFT',
+				call: 'erb_voteOfficialNFT',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'voteOfficialNFTProposal',
+				call: 'erb_voteOfficialNFTProposal',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'unfrozen',
+				call: 'erb_unfrozen',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'updateMetaURL',
+				call: 'erb_updateMetaURL',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'freezeMetaURL',
+				call: 'erb_freezeMetaURL',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'updateExchanger',
+				call: 'erb_updateExchanger',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'setCollectionFeeRate',
+				call: 'erb_setCollectionFeeRate',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'addAllowedCreator',
+				call: 'erb_addAllowedCreator',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'removeAllowedCreator',
+				call: 'erb_removeAllowedCreator',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'fractionalizeSNFT',
+				call: 'erb_fractionalizeSNFT',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'redeemSNFT',
+				call: 'erb_redeemSNFT',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'transferSNFTShares',
+				call: 'erb_transferSNFTShares',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'registerBLSPubKey',
+				call: 'erb_registerBLSPubKey',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'rawMint',
+				call: 'erb_rawMint',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawTransfer',
+				call: 'erb_rawTransfer',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawAuthor',
+				call: 'erb_rawAuthor',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawAuthorRevoke',
+				call: 'erb_rawAuthorRevoke',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawAccountAuthor',
+				call: 'erb_rawAccountAuthor',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawAccountAuthorRevoke',
+				call: 'erb_rawAccountAuthorRevoke',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawSNFTToERB',
+				call: 'erb_rawSNFTToERB',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawTokenPledge',
+				call: 'erb_rawTokenPledge',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawTokenRevokesPledge',
+				call: 'erb_rawTokenRevokesPledge',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawOpenExchanger',
+				call: 'erb_rawOpenExchanger',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawCloseExchanger',
+				call: 'erb_rawCloseExchanger',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawTransactionNFT',
+				call: 'erb_rawTransactionNFT',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawBuyerInitiatingTransaction',
+				call: 'erb_rawBuyerInitiatingTransaction',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawFoundryTradeBuyer',
+				call: 'erb_rawFoundryTradeBuyer',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawNftExchangeMatch',
+				call: 'erb_rawNftExchangeMatch',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawFoundryExchangeInitiated',
+				call: 'erb_rawFoundryExchangeInitiated',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawNFTDoesNotAuthorizeExchanges',
+				call: 'erb_rawNFTDoesNotAuthorizeExchanges',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawAdditionalPledgeAmount',
+				call: 'erb_rawAdditionalPledgeAmount',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawRevokesPledgeAmount',
+				call: 'erb_rawRevokesPledgeAmount',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawVoteOfficialNFT',
+				call: 'erb_rawVoteOfficialNFT',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawVoteOfficialNFTByApprovedExchanger',
+				call: 'erb_rawVoteOfficialNFTByApprovedExchanger',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawUnfrozen',
+				call: 'erb_rawUnfrozen',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawAccountDelegate',
+				call: 'erb_rawAccountDelegate',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawUpdateMetaURL',
+				call: 'erb_rawUpdateMetaURL',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawFreezeMetaURL',
+				call: 'erb_rawFreezeMetaURL',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawUpdateExchanger',
+				call: 'erb_rawUpdateExchanger',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawSetCollectionFeeRate',
+				call: 'erb_rawSetCollectionFeeRate',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawAddAllowedCreator',
+				call: 'erb_rawAddAllowedCreator',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawRemoveAllowedCreator',
+				call: 'erb_rawRemoveAllowedCreator',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawVoteOfficialNFTProposal',
+				call: 'erb_rawVoteOfficialNFTProposal',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawFractionalizeSNFT',
+				call: 'erb_rawFractionalizeSNFT',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawRedeemSNFT',
+				call: 'erb_rawRedeemSNFT',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawTransferSNFTShares',
+				call: 'erb_rawTransferSNFTShares',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'rawRegisterBLSPubKey',
+				call: 'erb_rawRegisterBLSPubKey',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'buildMint',
+				call: 'erb_buildMint',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildTransfer',
+				call: 'erb_buildTransfer',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildAuthor',
+				call: 'erb_buildAuthor',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildAuthorRevoke',
+				call: 'erb_buildAuthorRevoke',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildAccountAuthor',
+				call: 'erb_buildAccountAuthor',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildAccountAuthorRevoke',
+				call: 'erb_buildAccountAuthorRevoke',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildSNFTToERB',
+				call: 'erb_buildSNFTToERB',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildTokenPledge',
+				call: 'erb_buildTokenPledge',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildTokenRevokesPledge',
+				call: 'erb_buildTokenRevokesPledge',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildOpenExchanger',
+				call: 'erb_buildOpenExchanger',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildCloseExchanger',
+				call: 'erb_buildCloseExchanger',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildAdditionalPledgeAmount',
+				call: 'erb_buildAdditionalPledgeAmount',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildRevokesPledgeAmount',
+				call: 'erb_buildRevokesPledgeAmount',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildVoteOfficialNFT',
+				call: 'erb_buildVoteOfficialNFT',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildVoteOfficialNFTProposal',
+				call: 'erb_buildVoteOfficialNFTProposal',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildUnfrozen',
+				call: 'erb_buildUnfrozen',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildUpdateMetaURL',
+				call: 'erb_buildUpdateMetaURL',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildFreezeMetaURL',
+				call: 'erb_buildFreezeMetaURL',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildUpdateExchanger',
+				call: 'erb_buildUpdateExchanger',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildSetCollectionFeeRate',
+				call: 'erb_buildSetCollectionFeeRate',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildAddAllowedCreator',
+				call: 'erb_buildAddAllowedCreator',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildRemoveAllowedCreator',
+				call: 'erb_buildRemoveAllowedCreator',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildFractionalizeSNFT',
+				call: 'erb_buildFractionalizeSNFT',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildRedeemSNFT',
+				call: 'erb_buildRedeemSNFT',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildTransferSNFTShares',
+				call: 'erb_buildTransferSNFTShares',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'buildRegisterBLSPubKey',
+				call: 'erb_buildRegisterBLSPubKey',
+				params: 1,
+				inputFormatter: [erbTransactionFormatter]
+			}),
+			new web3._extend.Method({
+				name: 'decodeTransaction',
+				call: 'erb_decodeTransaction',
+				params: 1
+			}),
+			new web3._extend.Method({
+				name: 'simulate',
+				call: 'erb_simulate',
+				params: 3,
+				inputFormatter: [web3._extend.formatters.inputCallFormatter, web3._extend.formatters.inputBlockNumberFormatter, null]
+			}),
+		],
+		properties: [
+			new web3._extend.Property({
+				name: 'version',
+				getter: 'erb_version'
+			}),
+		]
+	});
+
+	// Marketplace orders are signed as personal messages over the
+	// concatenation of their fields, quantities are hex strings.
+	var order = function(o, quantities) {
+		var res = {};
+		for (var key in o) {
+			res[key] = o[key];
+		}
+		for (var i = 0; i < quantities.length; i++) {
+			if (res[quantities[i]] !== undefined) {
+				res[quantities[i]] = utils.toHex(res[quantities[i]]);
+			}
+		}
+		return res;
+	};
+	var sign = function(account, text) {
+		return web3.eth.sign(account, utils.fromUtf8(text));
+	};
+	var wormholesData = function(type, fields) {
+		var w = {type: type, version: 'v0.0.1'};
+		for (var key in fields) {
+			w[key] = fields[key];
+		}
+		return utils.fromUtf8('wormholes:' + JSON.stringify(w));
+	};
+
+	// signBuyerOrder signs the order of a buyer for an nft.
+	web3.erb.signBuyerOrder = function(account, buyer) {
+		var o = order(buyer, ['price', 'block_number']);
+		o.sig = sign(account, o.price + o.nft_address + o.exchanger + o.block_number + o.seller);
+		return o;
+	};
+	// signSellerOrder signs the order of a seller for an nft.
+	web3.erb.signSellerOrder = function(account, seller) {
+		var o = order(seller, ['price', 'block_number']);
+		o.sig = sign(account, o.price + o.nft_address + o.exchanger + o.block_number);
+		return o;
+	};
+	// signMintSellerOrder signs the lazy mint order of a seller.
+	web3.erb.signMintSellerOrder = function(account, seller) {
+		var o = order(seller, ['price', 'royalty', 'block_number']);
+		if (typeof o.exclusive_flag === 'boolean') {
+			o.exclusive_flag = o.exclusive_flag ? '1' : '0';
+		}
+		o.exclusive_flag = String(o.exclusive_flag);
+		o.sig = sign(account, o.price + o.royalty + o.meta_url + o.exclusive_flag + o.exchanger + o.block_number);
+		return o;
+	};
+	// signExchangerAuth signs the approval of an exchanger to trade for the
+	// exchanger owned by account.
+	web3.erb.signExchangerAuth = function(account, auth) {
+		var o = order(auth, ['block_number']);
+		o.exchanger_owner = web3.toChecksumAddress(account);
+		o.sig = sign(account, o.exchanger_owner + o.to + o.block_number);
+		return o;
+	};
+
+	// The trade helpers return the data of the transaction filling signed
+	// orders, to be sent with eth.sendTransaction.
+	web3.erb.buyNFTBySellerOrExchangerData = function(buyer) {
+		return wormholesData(14, {buyer: buyer});
+	};
+	web3.erb.buyNFTByBuyerData = function(seller) {
+		return wormholesData(15, {seller1: seller});
+	};
+	web3.erb.buyAndMintNFTByBuyerData = function(seller) {
+		return wormholesData(16, {seller2: seller});
+	};
+	web3.erb.buyAndMintNFTByExchangerData = function(buyer, seller) {
+		return wormholesData(17, {buyer: buyer, seller2: seller});
+	};
+	web3.erb.buyNFTByApproveExchangerData = function(buyer, exchangerAuth) {
+		return wormholesData(18, {buyer: buyer, exchanger_auth: exchangerAuth});
+	};
+	web3.erb.buyAndMintNFTByApprovedExchangerData = function(buyer, seller, exchangerAuth) {
+		return wormholesData(19, {buyer: buyer, seller2: seller, exchanger_auth: exchangerAuth});
+	};
+	web3.erb.buyNFTByExchangerData = function(buyer, seller) {
+		return wormholesData(20, {buyer: buyer, seller1: seller});
+	};
+})();
+`
@@ -128,6 +128,10 @@ func (b *LesApiBackend) GetAllValidators(ctx context.Context, header *types.Head
 	return nil, nil
 }
 
+func (b *LesApiBackend) GetValidatorStats(ctx context.Context, addr common.Address, from, to uint64) (*types.ValidatorStats, error) {
+	return nil, errors.New("validator stats are not available in light mode")
+}
+
 func (b *LesApiBackend) BlockByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*types.Block, error) {
 	if blockNr, ok := blockNrOrHash.Number(); ok {
 		return b.BlockByNumber(ctx, blockNr)
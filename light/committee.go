@@ -0,0 +1,175 @@
+package light
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	// errUnknownParent is returned if the header does not extend the verified head.
+	errUnknownParent = errors.New("header does not extend the verified head")
+
+	// errNoCommitment is returned if the verified head does not commit to the
+	// validator pool of the next committee.
+	errNoCommitment = errors.New("head does not commit to the validator pool")
+
+	// errMissingParent is returned if the parent of the verified head is needed
+	// but unknown.
+	errMissingParent = errors.New("parent of the verified head is unknown")
+
+	// errInvalidCheckpoint is returned if the validator checkpoint does not
+	// match the commitment of the verified head.
+	errInvalidCheckpoint = errors.New("invalid validator checkpoint")
+
+	// errInvalidCommittee is returned if the validators of the header are not
+	// the committee drawn from the validator checkpoint.
+	errInvalidCommittee = errors.New("invalid committee")
+
+	// errInsufficientSeals is returned if the header is not committed by a
+	// quorum of the committee.
+	errInsufficientSeals = errors.New("insufficient committed seals")
+)
+
+// CommitteeEngine is the part of the istanbul engine the committee verifier
+// needs to recover the committers of a header.
+type CommitteeEngine interface {
+	// Signers returns the committers of the header.
+	Signers(header *types.Header) ([]common.Address, error)
+
+	// VerifyAggregatedSeals checks the aggregated committed seal of the header,
+	// if any, against the bls keys of the validator pool.
+	VerifyAggregatedSeals(header *types.Header, validatorList *types.ValidatorList) error
+
+	core.RewardSigner
+}
+
+// CommitteeVerifier follows the chain from a trusted header using the headers
+// only. Every header after the committee fork commits to the validator pool
+// its block leaves, which the committee of the next block is drawn from, and
+// carries the full pool every params.ValidatorCheckpointInterval blocks. The
+// verifier derives the committee of each header from the checkpoint of its
+// parent and checks it was committed by a quorum of that committee.
+type CommitteeVerifier struct {
+	config *params.ChainConfig
+	engine CommitteeEngine
+	head   *types.Header
+	parent *types.Header // Parent of the head, the lagging committee members are derived from it
+}
+
+// NewCommitteeVerifier creates a verifier following the chain from the trusted
+// header. The parent of the trusted header is only needed after the lagging
+// fork and may be nil before it.
+func NewCommitteeVerifier(config *params.ChainConfig, engine CommitteeEngine, trusted *types.Header, parent *types.Header) *CommitteeVerifier {
+	return &CommitteeVerifier{
+		config: config,
+		engine: engine,
+		head:   trusted,
+		parent: parent,
+	}
+}
+
+// Head returns the last verified header.
+func (v *CommitteeVerifier) Head() *types.Header {
+	return v.head
+}
+
+// Verify checks that header extends the verified head and was committed by the
+// committee drawn from the validator pool the head commits to, and makes it
+// the verified head. The checkpoint of the head is read from its extra-data if
+// nil, otherwise it has to be fetched from a full node.
+func (v *CommitteeVerifier) Verify(header *types.Header, checkpoint *types.ValidatorCheckpoint) error {
+	if header.ParentHash != v.head.Hash() || header.Number.Uint64() != v.head.Number.Uint64()+1 {
+		return errUnknownParent
+	}
+	if !v.config.IsCommittee(v.head.Number) {
+		return errNoCommitment
+	}
+	headExtra, err := types.ExtractWormholesExtra(v.head)
+	if err != nil {
+		return err
+	}
+	if checkpoint == nil {
+		checkpoint = headExtra.Checkpoint
+	}
+	if checkpoint == nil || checkpoint.Hash() != headExtra.ValidatorsHash {
+		return errInvalidCheckpoint
+	}
+	pool := checkpoint.ValidatorList()
+	coefficients := checkpoint.Coefficients()
+
+	if err := core.VerifyExtraLimits(v.config, header, pool); err != nil {
+		return err
+	}
+	if header.Coinbase == (common.Address{}) {
+		// Empty blocks are voted by the whole validator pool
+		if err := core.VerifyEmptyBlockVotes(v.config, header, pool, checkpoint.Coefficient); err != nil {
+			return err
+		}
+	} else if err := v.verifyCommitted(header, pool, coefficients); err != nil {
+		return err
+	}
+	v.parent, v.head = v.head, header
+	return nil
+}
+
+// verifyCommitted checks that the header was committed by a quorum of the
+// committee drawn from the validator pool left by its parent.
+func (v *CommitteeVerifier) verifyCommitted(header *types.Header, pool *types.ValidatorList, coefficients []uint8) error {
+	if v.config.IsLagging(v.head.Number) && v.head.Number.Sign() > 0 {
+		if v.parent == nil || v.parent.Hash() != v.head.ParentHash {
+			return errMissingParent
+		}
+		lagging, err := core.LaggingValidators(pool, v.parent, v.head, v.engine)
+		if err != nil {
+			return err
+		}
+		core.DeprioritizeLagging(pool, coefficients, lagging)
+	}
+	committee, err := core.SelectCommittee(pool, coefficients, v.head)
+	if err != nil {
+		return err
+	}
+	members := make(map[common.Address]bool, len(committee.Validators))
+	for _, validator := range committee.Validators {
+		members[validator.Addr] = true
+	}
+
+	extra, err := types.ExtractWormholesExtra(header)
+	if err != nil {
+		return err
+	}
+	if len(extra.Validators) != len(members) {
+		return errInvalidCommittee
+	}
+	for _, addr := range extra.Validators {
+		if !members[addr] {
+			return errInvalidCommittee
+		}
+	}
+
+	if err := v.engine.VerifyAggregatedSeals(header, pool); err != nil {
+		return err
+	}
+	committers, err := v.engine.Signers(header)
+	if err != nil {
+		return err
+	}
+	committed := make(map[common.Address]bool, len(committers))
+	for _, addr := range committers {
+		if !members[addr] || committed[addr] {
+			return fmt.Errorf("%w: unexpected committer %x", errInsufficientSeals, addr)
+		}
+		committed[addr] = true
+	}
+	// The committers have to outnumber the faulty members of the committee
+	faulty := (len(members)+2)/3 - 1
+	if len(committed) <= faulty {
+		return errInsufficientSeals
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package light
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	ibftengine "github.com/ethereum/go-ethereum/consensus/istanbul/ibft/engine"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func newCommitteeHeader(t *testing.T, parent *types.Header, coinbase common.Address, extra *types.IstanbulExtra, signers []*ecdsa.PrivateKey) *types.Header {
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		Coinbase:   coinbase,
+		Difficulty: common.Big1,
+		MixDigest:  types.IstanbulDigest,
+	}
+	extra.Seal, extra.CommittedSeal = []byte{}, [][]byte{}
+	payload, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header.Extra = append(bytes.Repeat([]byte{0x00}, types.IstanbulExtraVanity), payload...)
+
+	proposalSeal := crypto.Keccak256(ibftengine.PrepareCommittedSeal(header.Hash()))
+	for _, key := range signers {
+		seal, err := crypto.Sign(proposalSeal, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		extra.CommittedSeal = append(extra.CommittedSeal, seal)
+	}
+	payload, _ = rlp.EncodeToBytes(extra)
+	header.Extra = append(bytes.Repeat([]byte{0x00}, types.IstanbulExtraVanity), payload...)
+	return header
+}
+
+func TestCommitteeVerifier(t *testing.T) {
+	var (
+		keys         = make(map[common.Address]*ecdsa.PrivateKey)
+		pool         = new(types.ValidatorList)
+		coefficients = make(map[common.Address]uint8)
+		validators   []common.Address
+	)
+	for i := 0; i < 13; i++ {
+		key, _ := crypto.GenerateKey()
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		keys[addr] = key
+		validators = append(validators, addr)
+		pool.AddValidator(addr, new(big.Int).Mul(big.NewInt(int64(i+1)), big.NewInt(1e18)), common.Address{})
+		coefficients[addr] = types.DEFAULT_VALIDATOR_COEFFICIENT
+	}
+	checkpoint := types.NewValidatorCheckpoint(pool, coefficients)
+
+	config := &params.ChainConfig{Istanbul: &params.IstanbulConfig{CommitteeBlock: common.Big0}}
+	engine := ibftengine.NewEngine(istanbul.DefaultConfig, common.Address{}, nil, nil)
+
+	genesis := &types.Header{Number: common.Big0, MixDigest: types.IstanbulDigest}
+	trusted := newCommitteeHeader(t, genesis, validators[0], &types.IstanbulExtra{
+		Validators:     validators,
+		ValidatorsHash: checkpoint.Hash(),
+		Checkpoint:     checkpoint,
+	}, nil)
+
+	committee, err := core.SelectCommittee(checkpoint.ValidatorList(), checkpoint.Coefficients(), trusted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	members := committee.ConvertToAddress()
+	var signers []*ecdsa.PrivateKey
+	for _, addr := range members {
+		signers = append(signers, keys[addr])
+	}
+	outsider, _ := crypto.GenerateKey()
+
+	tests := []struct {
+		name       string
+		validators []common.Address
+		signers    []*ecdsa.PrivateKey
+		checkpoint *types.ValidatorCheckpoint
+		err        error
+	}{
+		{"committed", members, signers, nil, nil},
+		{"no quorum", members, nil, nil, errInsufficientSeals},
+		{"outsider", members, append([]*ecdsa.PrivateKey{outsider}, signers...), nil, errInsufficientSeals},
+		{"wrong committee", validators, signers, nil, errInvalidCommittee},
+		{"wrong checkpoint", members, signers, types.NewValidatorCheckpoint(committee, coefficients), errInvalidCheckpoint},
+	}
+	for _, tt := range tests {
+		verifier := NewCommitteeVerifier(config, engine, trusted, genesis)
+		header := newCommitteeHeader(t, trusted, members[0], &types.IstanbulExtra{Validators: tt.validators}, tt.signers)
+		err := verifier.Verify(header, tt.checkpoint)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s: error mismatch: have %v, want %v", tt.name, err, tt.err)
+		}
+		want := trusted
+		if tt.err == nil {
+			want = header
+		}
+		if verifier.Head() != want {
+			t.Errorf("%s: head mismatch: have %d, want %d", tt.name, verifier.Head().Number, want.Number)
+		}
+	}
+}
+
+func TestValidatorCheckpointExtra(t *testing.T) {
+	pool := new(types.ValidatorList)
+	pool.AddValidator(common.HexToAddress("0x01"), big.NewInt(1e18), common.HexToAddress("0x02"))
+	checkpoint := types.NewValidatorCheckpoint(pool, map[common.Address]uint8{common.HexToAddress("0x01"): 50})
+
+	extra := &types.IstanbulExtra{
+		Validators:     []common.Address{common.HexToAddress("0x01")},
+		Seal:           []byte{},
+		CommittedSeal:  [][]byte{},
+		ValidatorsHash: checkpoint.Hash(),
+		Checkpoint:     checkpoint,
+	}
+	payload, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := &types.Header{Number: common.Big1, Extra: append(make([]byte, types.IstanbulExtraVanity), payload...)}
+	decoded, err := types.ExtractWormholesExtra(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.ValidatorsHash != checkpoint.Hash() || decoded.Checkpoint == nil || decoded.Checkpoint.Hash() != checkpoint.Hash() {
+		t.Fatalf("checkpoint mismatch: have %x, want %x", decoded.ValidatorsHash, checkpoint.Hash())
+	}
+	if c := decoded.Checkpoint.Coefficient(common.HexToAddress("0x01")); c != 50 {
+		t.Fatalf("coefficient mismatch: have %d, want 50", c)
+	}
+}
+
+func TestCommitteeVerifierLagging(t *testing.T) {
+	var (
+		keys         = make(map[common.Address]*ecdsa.PrivateKey)
+		pool         = new(types.ValidatorList)
+		coefficients = make(map[common.Address]uint8)
+		validators   []common.Address
+	)
+	for i := 0; i < 13; i++ {
+		key, _ := crypto.GenerateKey()
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		keys[addr] = key
+		validators = append(validators, addr)
+		pool.AddValidator(addr, big.NewInt(1e18), common.Address{})
+		coefficients[addr] = types.DEFAULT_VALIDATOR_COEFFICIENT
+	}
+	checkpoint := types.NewValidatorCheckpoint(pool, coefficients)
+
+	config := &params.ChainConfig{Istanbul: &params.IstanbulConfig{CommitteeBlock: common.Big0, LaggingBlock: common.Big0}}
+	engine := ibftengine.NewEngine(istanbul.DefaultConfig, common.Address{}, nil, nil)
+
+	// The last three members of the committee of block 1 didn't commit it, the
+	// seals copied into block 2 count beyond the rewarded quorum
+	genesis := &types.Header{Number: common.Big0, MixDigest: types.IstanbulDigest}
+	var committers []*ecdsa.PrivateKey
+	for _, addr := range validators[:8] {
+		committers = append(committers, keys[addr])
+	}
+	parent := newCommitteeHeader(t, genesis, validators[0], &types.IstanbulExtra{Validators: validators[:11]}, committers)
+	parentExtra, err := types.ExtractIstanbulExtra(parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trusted := newCommitteeHeader(t, parent, validators[1], &types.IstanbulExtra{
+		Validators:     validators[:11],
+		ValidatorAddr:  validators[:7],
+		RewardSeal:     parentExtra.CommittedSeal,
+		ValidatorsHash: checkpoint.Hash(),
+		Checkpoint:     checkpoint,
+	}, nil)
+
+	list, weights := checkpoint.ValidatorList(), checkpoint.Coefficients()
+	lagging, err := core.LaggingValidators(list, parent, trusted, engine)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lagging) != 3 || !lagging[validators[8]] || !lagging[validators[9]] || !lagging[validators[10]] {
+		t.Fatalf("lagging validators mismatch: have %v", lagging)
+	}
+	core.DeprioritizeLagging(list, weights, lagging)
+	for i, v := range list.Validators {
+		want := uint8(types.DEFAULT_VALIDATOR_COEFFICIENT)
+		if lagging[v.Addr] {
+			want /= 2
+		}
+		if weights[i] != want {
+			t.Fatalf("weight mismatch of %x: have %d, want %d", v.Addr, weights[i], want)
+		}
+	}
+	committee, err := core.SelectCommittee(list, weights, trusted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	members := committee.ConvertToAddress()
+	var signers []*ecdsa.PrivateKey
+	for _, addr := range members {
+		signers = append(signers, keys[addr])
+	}
+	header := newCommitteeHeader(t, trusted, members[0], &types.IstanbulExtra{Validators: members}, signers)
+
+	if err := NewCommitteeVerifier(config, engine, trusted, nil).Verify(header, nil); err != errMissingParent {
+		t.Fatalf("error mismatch: have %v, want %v", err, errMissingParent)
+	}
+	if err := NewCommitteeVerifier(config, engine, trusted, parent).Verify(header, nil); err != nil {
+		t.Fatalf("failed to verify header: %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p"
@@ -34,6 +35,7 @@ type Certify struct {
 	voteIndex         int
 	validatorsHeight  []string
 	proofStatePool    *ProofStatePool // Currently highly collected validators that have sent online proofs
+	voteTracker       *VoteTracker    // Votes of the peers per slot to detect equivocations
 }
 
 func (c *Certify) Start() {
@@ -59,6 +61,7 @@ func NewCertify(self common.Address, eth Backend, handler Handler) *Certify {
 		voteIndex:         0,
 		validatorsHeight:  make([]string, 0),
 		proofStatePool:    NewProofStatePool(),
+		voteTracker:       NewVoteTracker(),
 	}
 	return certify
 }
@@ -111,12 +114,16 @@ func (c *Certify) sign(data []byte) ([]byte, error) {
 	return crypto.Sign(hashData, c.eth.GetNodeKey())
 }
 
-func (c *Certify) assembleMessage(height *big.Int, vote common.Address) (error, []byte) {
+func (c *Certify) assembleMessage(height *big.Int, vote common.Address, index int) (error, []byte) {
 	ques := &types.SignatureData{
 		Vote:   vote,
 		Height: height,
 		Round:  c.round,
 	}
+	// After the evidence fork the votes carry their position in the rotation
+	if c.eth.BlockChain().Config().IsEvidence(height) {
+		ques.Index = uint64(index)
+	}
 	encQues, err := Encode(ques)
 	if err != nil {
 		//log.Error("Failed to encode", "subject", err)
@@ -127,6 +134,10 @@ func (c *Certify) assembleMessage(height *big.Int, vote common.Address) (error,
 		Code: SendSignMsg,
 		Msg:  encQues,
 	}
+	// After the bls fork the votes are aggregated into the empty block
+	if c.eth.BlockChain().Config().IsBLS(height) {
+		msg.BLSSignature = bls.SecretKeyFromECDSA(c.eth.GetNodeKey()).Sign(types.EmptyBlockVoteHash(height))
+	}
 
 	payload, err := c.signMessage(msg)
 	if err != nil {
@@ -176,6 +187,16 @@ func (c *Certify) HandleMsg(addr common.Address, msg p2p.Msg) (bool, error) {
 
 		log.Info("azh|emptyMessage", "height", signature.Height, "from", sender, "vote", signature.Vote, "round", signature.Round)
 
+		if c.eth.BlockChain().Config().IsEvidence(signature.Height) {
+			// The index of a vote is the position of its proposer in the
+			// rotation, a sender can't vote for two proposers in the same
+			// slot by picking the index itself
+			if c.stakers != nil && signature.Index != uint64(c.rotationIndex(signature.Vote)) {
+				return true, errInvalidVoteIndex
+			}
+			c.checkEquivocation(sender, signature, data)
+		}
+
 		c.rebroadcast(addr, data)
 
 		if c.stakers == nil {
@@ -212,6 +233,21 @@ func (c *Certify) HandleMsg(addr common.Address, msg p2p.Msg) (bool, error) {
 	return false, nil
 }
 
+// checkEquivocation tracks the vote of sender and reports the evidence to the
+// consensus engine if sender voted for another proposer in the same slot
+func (c *Certify) checkEquivocation(sender common.Address, signature *types.SignatureData, payload []byte) {
+	evidence := c.voteTracker.Track(sender, signature, payload)
+	if evidence == nil {
+		return
+	}
+	log.Warn("Certify.checkEquivocation: conflicting empty block votes", "offender", sender, "height", evidence.Height, "round", evidence.Round, "index", evidence.Index)
+	if reporter, ok := c.miner.GetWorker().engine.(EvidenceReporter); ok {
+		if err := reporter.ReportEvidence(evidence); err != nil {
+			log.Error("Certify.checkEquivocation: report evidence", "err", err)
+		}
+	}
+}
+
 func (c *Certify) decode(msg p2p.Msg) ([]byte, common.Hash, error) {
 	var data []byte
 	if err := msg.Decode(&data); err != nil {
@@ -72,8 +72,8 @@ func TestRlpDecode2(t *testing.T) {
 		Height  *big.Int
 	}
 	ques := &types.SignatureData{
-		Address: common.HexToAddress("0x2000000000000000000000000000000000000002"),
-		Height:  big.NewInt(2),
+		Vote:   common.HexToAddress("0x2000000000000000000000000000000000000002"),
+		Height: big.NewInt(2),
 		//Timestamp: uint64(time.Now().Unix()),
 	}
 	encQues, err := Encode(ques)
@@ -100,8 +100,8 @@ func TestRlpDecode2(t *testing.T) {
 
 func TestMsgSignature(t *testing.T) {
 	ques := &types.SignatureData{
-		Address: common.HexToAddress("0x2000000000000000000000000000000000000002"),
-		Height:  big.NewInt(2),
+		Vote:   common.HexToAddress("0x2000000000000000000000000000000000000002"),
+		Height: big.NewInt(2),
 		//Timestamp: uint64(time.Now().Unix()),
 	}
 	encQues, err := Encode(ques)
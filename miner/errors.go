@@ -4,6 +4,7 @@ import "errors"
 
 var (
 	errDecodeFailed               = errors.New("fail to decode worker message")
+	errInvalidVoteIndex           = errors.New("vote index is not the position of the proposer")
 	ErrInvalidSigner              = errors.New("message not signed by the sender")
 	ErrUnauthorizedAddress        = errors.New("unauthorized address")
 	ErrFailedDecodeOnlineQuestion = errors.New("failed to decode online-question message")
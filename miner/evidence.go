@@ -0,0 +1,73 @@
+package miner
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EvidenceReporter is implemented by the consensus engines that collect the
+// evidence of equivocating empty block voters
+type EvidenceReporter interface {
+	ReportEvidence(evidence *types.EmptyBlockEvidence) error
+}
+
+type voteSlot struct {
+	sender common.Address
+	height uint64
+	round  uint64
+	index  uint64
+}
+
+type trackedVote struct {
+	vote    common.Address
+	payload []byte
+}
+
+// VoteTracker remembers the empty block vote of every sender per slot, a
+// second vote of the sender for another proposer in the same slot is an
+// equivocation.
+type VoteTracker struct {
+	mu    sync.Mutex
+	votes map[voteSlot]trackedVote
+}
+
+func NewVoteTracker() *VoteTracker {
+	return &VoteTracker{votes: make(map[voteSlot]trackedVote)}
+}
+
+// Track records the vote of sender, it returns the evidence if sender voted
+// for another proposer in the same slot before.
+func (t *VoteTracker) Track(sender common.Address, vote *types.SignatureData, payload []byte) *types.EmptyBlockEvidence {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	slot := voteSlot{sender: sender, height: vote.Height.Uint64(), round: vote.Round, index: vote.Index}
+	prev, ok := t.votes[slot]
+	if !ok {
+		t.votes[slot] = trackedVote{vote: vote.Vote, payload: payload}
+		return nil
+	}
+	if prev.vote == vote.Vote {
+		return nil
+	}
+	evidence, err := types.NewEmptyBlockEvidence(prev.payload, payload)
+	if err != nil {
+		return nil
+	}
+	return evidence
+}
+
+// ClearPrev Clear all votes before this altitude
+func (t *VoteTracker) ClearPrev(height *big.Int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for slot := range t.votes {
+		if slot.height <= height.Uint64() {
+			delete(t.votes, slot)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package miner
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func signVote(t *testing.T, key *ecdsa.PrivateKey, vote *types.SignatureData) []byte {
+	enc, err := Encode(vote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &types.EmptyMsg{Code: SendSignMsg, Msg: enc, Address: crypto.PubkeyToAddress(key.PublicKey)}
+	unsigned, err := msg.PayloadNoSig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Signature, err = crypto.Sign(crypto.Keccak256(unsigned), key); err != nil {
+		t.Fatal(err)
+	}
+	payload, err := msg.Payload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
+
+func TestVoteTracker(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	tracker := NewVoteTracker()
+
+	track := func(vote *types.SignatureData) *types.EmptyBlockEvidence {
+		return tracker.Track(sender, vote, signVote(t, key, vote))
+	}
+	first := &types.SignatureData{Vote: common.HexToAddress("0x01"), Height: big.NewInt(10), Round: 1, Index: 2}
+	if evidence := track(first); evidence != nil {
+		t.Fatalf("evidence for the first vote: %+v", evidence)
+	}
+	if evidence := track(first); evidence != nil {
+		t.Fatalf("evidence for a repeated vote: %+v", evidence)
+	}
+	// Votes for another proposer in another slot are no equivocation
+	for _, vote := range []*types.SignatureData{
+		{Vote: common.HexToAddress("0x02"), Height: big.NewInt(10), Round: 1, Index: 3},
+		{Vote: common.HexToAddress("0x02"), Height: big.NewInt(10), Round: 2, Index: 2},
+		{Vote: common.HexToAddress("0x02"), Height: big.NewInt(11), Round: 1, Index: 2},
+	} {
+		if evidence := track(vote); evidence != nil {
+			t.Fatalf("evidence for vote %+v in another slot: %+v", vote, evidence)
+		}
+	}
+
+	evidence := track(&types.SignatureData{Vote: common.HexToAddress("0x02"), Height: big.NewInt(10), Round: 1, Index: 2})
+	if evidence == nil {
+		t.Fatal("no evidence for conflicting votes")
+	}
+	if err := evidence.Verify(); err != nil {
+		t.Fatalf("invalid evidence: %v", err)
+	}
+	if evidence.Offender != sender || evidence.Height.Uint64() != 10 || evidence.Round != 1 || evidence.Index != 2 {
+		t.Fatalf("evidence mismatch: %+v", evidence)
+	}
+
+	// The votes up to the cleared height are forgotten
+	tracker.ClearPrev(big.NewInt(10))
+	if evidence := track(&types.SignatureData{Vote: common.HexToAddress("0x02"), Height: big.NewInt(10), Round: 1, Index: 2}); evidence != nil {
+		t.Fatalf("evidence after clearing the height: %+v", evidence)
+	}
+	if evidence := track(&types.SignatureData{Vote: common.HexToAddress("0x03"), Height: big.NewInt(11), Round: 1, Index: 2}); evidence == nil {
+		t.Fatal("no evidence for conflicting votes above the cleared height")
+	}
+}
+
+func TestRotationIndex(t *testing.T) {
+	var (
+		a, b, proxy = common.HexToAddress("0x01"), common.HexToAddress("0x02"), common.HexToAddress("0x03")
+		c           = &Certify{stakers: types.NewValidatorList([]*types.Validator{
+			types.NewValidator(a, big.NewInt(1), common.Address{}),
+			types.NewValidator(b, big.NewInt(1), proxy),
+		})}
+	)
+	for i, v := range c.stakers.Validators {
+		want := v.Addr
+		if v.Proxy != (common.Address{}) {
+			want = v.Proxy
+		}
+		if have := c.rotationIndex(want); have != i {
+			t.Errorf("validator %x: index mismatch: have %d, want %d", want, have, i)
+		}
+	}
+	// A validator with a proxy votes with the proxy only
+	if have := c.rotationIndex(b); have != len(c.stakers.Validators) {
+		t.Errorf("index mismatch for the proxied validator: have %d, want %d", have, len(c.stakers.Validators))
+	}
+}
@@ -274,6 +274,10 @@ func (miner *Miner) SetExtra(extra []byte) error {
 	if uint64(len(extra)) > params.MaximumExtraDataSize {
 		return fmt.Errorf("extra exceeds max length. %d > %v", len(extra), params.MaximumExtraDataSize)
 	}
+	// The last byte of the istanbul vanity carries the version of the extra-data
+	if istanbul := miner.worker.chainConfig.Istanbul; istanbul != nil && istanbul.ExtraVersionBlock != nil && len(extra) >= types.IstanbulExtraVanity {
+		return fmt.Errorf("extra exceeds max length. %d > %v", len(extra), types.IstanbulExtraVanity-1)
+	}
 	miner.worker.setExtra(extra)
 	return nil
 }
@@ -19,17 +19,16 @@ func TestProofStatePoolPut(t *testing.T) {
 	//}
 }
 
-func TestOnlineValidator_GetAllAddress(t *testing.T) {
-	var vals OnlineValidator
-	vals = make(OnlineValidator)
-	vals[common.HexToAddress("0x1000000000000000000000000000000000000000")] = struct{}{}
-	vals[common.HexToAddress("0x1000000000000000000000000000000000000001")] = struct{}{}
-	vals[common.HexToAddress("0x1000000000000000000000000000000000000002")] = struct{}{}
-	vals[common.HexToAddress("0x1000000000000000000000000000000000000003")] = struct{}{}
-	vals[common.HexToAddress("0x1000000000000000000000000000000000000004")] = struct{}{}
-	addrs := vals.GetAllAddress()
-	for _, addr := range addrs {
-		t.Log(addr.Hex())
+func TestOnlineValidator_Has(t *testing.T) {
+	vals := OnlineValidator{
+		common.HexToAddress("0x1000000000000000000000000000000000000000"),
+		common.HexToAddress("0x1000000000000000000000000000000000000001"),
+	}
+	if !vals.Has(common.HexToAddress("0x1000000000000000000000000000000000000001")) {
+		t.Error("online validator not found")
+	}
+	if vals.Has(common.HexToAddress("0x1000000000000000000000000000000000000002")) {
+		t.Error("offline validator found")
 	}
 }
 
@@ -37,11 +36,11 @@ func TestProofStatePool(t *testing.T) {
 	proofStatePool := NewProofStatePool()
 
 	height := big.NewInt(1)
-	ps := newProofState(common.HexToAddress("0x1000000000000000000000000000000000000000"), common.HexToAddress("0x1000000000000000000000000000000000000000"))
+	ps := newProofState(common.HexToAddress("0x1000000000000000000000000000000000000000"), nil, height)
 	proofStatePool.proofs[height.Uint64()] = ps
 	t.Log(proofStatePool.proofs[height.Uint64()] == nil)
 	height2 := big.NewInt(1)
-	ps2 := newProofState(common.HexToAddress("0x1000000000000000000000000000000000000001"), common.HexToAddress("0x1000000000000000000000000000000000000001"))
+	ps2 := newProofState(common.HexToAddress("0x1000000000000000000000000000000000000001"), nil, height2)
 	proofStatePool.proofs[height2.Uint64()] = ps2
 	t.Log(proofStatePool.proofs[height2.Uint64()] == nil)
 
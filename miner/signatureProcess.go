@@ -11,7 +11,8 @@ import (
 
 func (c *Certify) AssembleAndBroadcastMessage(height *big.Int) {
 	//log.Info("AssembleAndBroadcastMessage", "validators len", len(c.stakers.Validators), "sender", c.addr, "vote index", c.voteIndex, "round", c.round)
-	vote := c.stakers.Validators[c.voteIndex]
+	index := c.voteIndex
+	vote := c.stakers.Validators[index]
 	var voteAddress common.Address
 	if vote.Proxy == (common.Address{}) {
 		voteAddress = vote.Addr
@@ -25,7 +26,7 @@ func (c *Certify) AssembleAndBroadcastMessage(height *big.Int) {
 	}
 
 	log.Info("azh|start to vote", "validators", len(c.stakers.Validators), "index", c.voteIndex, "vote", vote, "height:", height)
-	err, payload := c.assembleMessage(height, voteAddress)
+	err, payload := c.assembleMessage(height, voteAddress, index)
 	if err != nil {
 		return
 	}
@@ -52,7 +53,7 @@ func (c *Certify) GatherOtherPeerSignature(validator common.Address, height *big
 	var weightBalance *big.Int
 	log.Info("GatherOtherPeerSignature", "c.proofStatePool", c.proofStatePool)
 	if _, ok := c.proofStatePool.proofs[height.Uint64()]; !ok {
-		_, proposerMessage := c.assembleMessage(height, c.self)
+		_, proposerMessage := c.assembleMessage(height, c.self, c.selfVoteIndex())
 		ps := newProofState(c.self, proposerMessage, height)
 		ps.receiveValidatorsSum = big.NewInt(0)
 		//coe, err = c.miner.GetWorker().getValidatorCoefficient(validator)
@@ -112,3 +113,21 @@ func (c *Certify) GatherOtherPeerSignature(validator common.Address, height *big
 	//log.Info("Certify.GatherOtherPeerSignature <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<< 2")
 	return nil
 }
+
+// selfVoteIndex returns the position of the own vote in the rotation, the
+// message of the proposer is signed with it so it never conflicts with the
+// votes it broadcasts.
+func (c *Certify) selfVoteIndex() int {
+	return c.rotationIndex(c.self)
+}
+
+// rotationIndex returns the position of the validator voting with addr in the
+// rotation, the length of the validator list if addr is no validator.
+func (c *Certify) rotationIndex(addr common.Address) int {
+	for i, v := range c.stakers.Validators {
+		if (v.Proxy == (common.Address{}) && v.Addr == addr) || v.Proxy == addr {
+			return i
+		}
+	}
+	return len(c.stakers.Validators)
+}
@@ -36,6 +36,7 @@ import (
 	mapset "github.com/deckarep/golang-set"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/backend"
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
@@ -211,7 +212,7 @@ type worker struct {
 	onlineValidators *types.OnlineValidatorList
 
 	//empty block
-	totalCondition      int
+	totalCondition      uint64
 	emptyTimestamp      int64
 	emptyHandleFlag     bool
 	cacheHeight         *big.Int
@@ -394,6 +395,7 @@ func (w *worker) resetEmptyCondition() {
 	w.cerytify.voteIndex = 0
 	w.cerytify.round = 0
 	w.cerytify.selfMessages.Purge()
+	w.cerytify.voteTracker.ClearPrev(w.chain.CurrentHeader().Number)
 }
 
 // recalcRecommit recalculates the resubmitting interval upon feedback.
@@ -428,19 +430,21 @@ func (w *worker) emptyLoop() {
 	w.emptyTimer = time.NewTimer(0)
 	defer w.emptyTimer.Stop()
 	<-w.emptyTimer.C // discard the initial tick
-	w.emptyTimer.Reset(120 * time.Second)
+	emptyBlockConfig := w.chainConfig.Istanbul.GetEmptyBlock()
+	gossipInterval := time.Duration(emptyBlockConfig.GetGossipInterval()) * time.Second
+	w.emptyTimer.Reset(time.Duration(emptyBlockConfig.GetTimeout()) * time.Second)
 
 	gossipTimer := time.NewTimer(0)
 	defer gossipTimer.Stop()
 	<-gossipTimer.C // discard the initial tick
-	gossipTimer.Reset(5 * time.Second)
+	gossipTimer.Reset(gossipInterval)
 
 	checkTimer := time.NewTimer(0)
 	defer checkTimer.Stop()
 	<-checkTimer.C // discard the initial tick
 	checkTimer.Reset(1 * time.Second)
 
-	var valiTotal int
+	var valiTotal uint64
 	var currentHash common.Hash
 
 	for {
@@ -489,19 +493,19 @@ func (w *worker) emptyLoop() {
 					currentHash = curBlock.Hash()
 					rs, err := w.chain.IsValidatorByHight(w.chain.CurrentHeader(), w.cerytify.self)
 					if err == nil && rs {
-						valiTotal = 15
+						valiTotal = emptyBlockConfig.GetEarlyTimeout()
 					} else {
-						valiTotal = 16
+						valiTotal = emptyBlockConfig.GetEarlyTimeout() + 1
 					}
 				}
 
 				//if curTime-int64(curBlock.Time()) < 120 && curBlock.Number().Uint64() > 0 {
-				if w.totalCondition < 120 && curBlock.Number().Uint64() > 0 {
+				if w.totalCondition < emptyBlockConfig.GetTimeout() && curBlock.Number().Uint64() > 0 {
 					//log.Info("wait empty condition", "totalCondition", totalCondition, "time", curTime, "blocktime", int64(w.chain.CurrentBlock().Time()))
 					if w.totalCondition != valiTotal {
 						continue
 					}
-					if len(w.engine.OnlineValidators(curBlock.Number().Uint64()+1)) >= 7 {
+					if uint64(len(w.engine.OnlineValidators(curBlock.Number().Uint64()+1))) >= emptyBlockConfig.GetOnlineThreshold() {
 						continue
 					}
 					//log.Info("ok empty condition 15", "totalCondition", totalCondition, "time", curTime, "blocktime", int64(w.chain.CurrentBlock().Time()), "online len",len(w.engine.OnlineValidators(curBlock.Number().Uint64()+1)) )
@@ -565,9 +569,9 @@ func (w *worker) emptyLoop() {
 				//w.onlineCh <- struct{}{}
 				w.emptyTimer.Stop()
 
-				if valiTotal == 15 {
+				if valiTotal == emptyBlockConfig.GetEarlyTimeout() {
 					w.cerytify.AssembleAndBroadcastMessage(new(big.Int).Add(w.chain.CurrentHeader().Number, big.NewInt(1)))
-					gossipTimer.Reset(time.Second * 5)
+					gossipTimer.Reset(gossipInterval)
 				}
 				//log.Info("emptyLoop start empty")
 			}
@@ -575,7 +579,7 @@ func (w *worker) emptyLoop() {
 		case <-gossipTimer.C:
 			{
 				//log.Info("emptyLoop gossipTimer", "w.isEmpty", w.isEmpty)
-				gossipTimer.Reset(time.Second * 5)
+				gossipTimer.Reset(gossipInterval)
 				if !w.isEmpty {
 					continue
 				}
@@ -603,6 +607,9 @@ func (w *worker) emptyLoop() {
 					}
 					//sgiccommon.Sigc <- syscall.SIGTERM
 				}
+				if recorder, ok := w.engine.(backend.AttestationRecorder); ok {
+					recorder.RecordEmptyBlockVotes(rs.Height, w.cerytify.self, rs.OnlineValidators, rs.ReceiveSum, rs.EmptyMessages)
+				}
 				w.cerytify.proofStatePool.ClearPrev(w.chain.CurrentHeader().Number)
 			}
 		}
@@ -1021,6 +1028,8 @@ func (w *worker) makeEmptyCurrent(parent *types.Block, header *types.Header) err
 		log.Info("makeCurrent()", "state.OfficialNFTPool.InjectedOfficialNFTs", v)
 	}
 
+	state.OfficialNFTProposals = w.chain.ReadOfficialNFTProposals(parent.Header())
+
 	var nominatedOfficialNFT *types.NominatedOfficialNFT
 	if parent.NumberU64() > 0 {
 		nominatedOfficialNFT, err = w.chain.ReadNominatedOfficialNFT(parent.Header())
@@ -1127,6 +1136,8 @@ func (w *worker) makeCurrent(parent *types.Block, header *types.Header) error {
 		log.Info("makeCurrent()", "state.OfficialNFTPool.InjectedOfficialNFTs", v)
 	}
 
+	state.OfficialNFTProposals = w.chain.ReadOfficialNFTProposals(parent.Header())
+
 	var nominatedOfficialNFT *types.NominatedOfficialNFT
 	if parent.NumberU64() > 0 {
 		nominatedOfficialNFT, err = w.chain.ReadNominatedOfficialNFT(parent.Header())
@@ -1891,7 +1902,8 @@ func (w *worker) targetSizeWithWeight() (*big.Int, error) {
 		total.Add(total, voteBalance)
 		//log.Info("targetSizeWithWeight:info", "height", w.chain.CurrentBlock().NumberU64()+1, "coe", coe, "voter.Balance", voter.Balance, "voteBalance", voteBalance, "total", total)
 	}
-	a := new(big.Int).Mul(big.NewInt(50), total)
+	emptyBlockConfig := w.chainConfig.Istanbul.EmptyBlockAt(new(big.Int).Add(w.chain.CurrentBlock().Number(), big.NewInt(1)))
+	a := new(big.Int).Mul(new(big.Int).SetUint64(emptyBlockConfig.GetVotePercent()), total)
 	b := new(big.Int).Div(a, big.NewInt(100))
 	return b, nil
 }
@@ -1997,6 +2009,8 @@ func (w *worker) makeProofCurrent(parent *types.Block, header *types.Header) err
 		log.Info("makeCurrent()", "state.OfficialNFTPool.InjectedOfficialNFTs", v)
 	}
 
+	state.OfficialNFTProposals = w.chain.ReadOfficialNFTProposals(parent.Header())
+
 	var nominatedOfficialNFT *types.NominatedOfficialNFT
 	if parent.NumberU64() > 0 {
 		nominatedOfficialNFT, err = w.chain.ReadNominatedOfficialNFT(parent.Header())
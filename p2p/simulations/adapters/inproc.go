@@ -38,6 +38,7 @@ import (
 // connects them using net.Pipe
 type SimAdapter struct {
 	pipe       func() (net.Conn, net.Conn, error)
+	link       LinkFunc
 	mtx        sync.RWMutex
 	nodes      map[enode.ID]*SimNode
 	lifecycles LifecycleConstructors
@@ -55,6 +56,18 @@ func NewSimAdapter(services LifecycleConstructors) *SimAdapter {
 	}
 }
 
+// LinkFunc wraps the ends of a connection between two simulation nodes, the
+// dialer end is used by src and the listener end by dst
+type LinkFunc func(src, dst enode.ID, dialer, listener net.Conn) (net.Conn, net.Conn)
+
+// SetLink sets the function wrapping the connections dialed after the call,
+// it allows to simulate the latency or the loss of the links
+func (s *SimAdapter) SetLink(link LinkFunc) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.link = link
+}
+
 // Name returns the name of the adapter for logging purposes
 func (s *SimAdapter) Name() string {
 	return "sim-adapter"
@@ -96,7 +109,7 @@ func (s *SimAdapter) NewNode(config *NodeConfig) (Node, error) {
 			PrivateKey:      config.PrivateKey,
 			MaxPeers:        math.MaxInt32,
 			NoDiscovery:     true,
-			Dialer:          s,
+			Dialer:          &simDialer{adapter: s, id: id},
 			EnableMsgEvents: config.EnableMsgEvents,
 		},
 		ExternalSigner: config.ExternalSigner,
@@ -120,6 +133,10 @@ func (s *SimAdapter) NewNode(config *NodeConfig) (Node, error) {
 // Dial implements the p2p.NodeDialer interface by connecting to the node using
 // an in-memory net.Pipe
 func (s *SimAdapter) Dial(ctx context.Context, dest *enode.Node) (conn net.Conn, err error) {
+	return s.dial(ctx, enode.ID{}, dest)
+}
+
+func (s *SimAdapter) dial(ctx context.Context, src enode.ID, dest *enode.Node) (conn net.Conn, err error) {
 	node, ok := s.GetNode(dest.ID())
 	if !ok {
 		return nil, fmt.Errorf("unknown node: %s", dest.ID())
@@ -133,6 +150,12 @@ func (s *SimAdapter) Dial(ctx context.Context, dest *enode.Node) (conn net.Conn,
 	if err != nil {
 		return nil, err
 	}
+	s.mtx.RLock()
+	link := s.link
+	s.mtx.RUnlock()
+	if link != nil {
+		pipe2, pipe1 = link(src, dest.ID(), pipe2, pipe1)
+	}
 	// this is simulated 'listening'
 	// asynchronously call the dialed destination node's p2p server
 	// to set up connection on the 'listening' side
@@ -140,6 +163,17 @@ func (s *SimAdapter) Dial(ctx context.Context, dest *enode.Node) (conn net.Conn,
 	return pipe2, nil
 }
 
+// simDialer dials the other simulation nodes on behalf of a node
+type simDialer struct {
+	adapter *SimAdapter
+	id      enode.ID
+}
+
+// Dial implements the p2p.NodeDialer interface
+func (d *simDialer) Dial(ctx context.Context, dest *enode.Node) (net.Conn, error) {
+	return d.adapter.dial(ctx, d.id, dest)
+}
+
 // DialRPC implements the RPCDialer interface by creating an in-memory RPC
 // client of the given node
 func (s *SimAdapter) DialRPC(id enode.ID) (*rpc.Client, error) {
@@ -244,6 +244,7 @@ var (
 			Epoch:          30000,
 			ProposerPolicy: 0,
 			Ceil2Nby3Block: big.NewInt(0),
+			EmptyBlock:     DefaultEmptyBlockConfig,
 		},
 		IsQuorum: true,
 	}
@@ -270,6 +271,7 @@ var (
 			Epoch:          30000,
 			ProposerPolicy: 0,
 			Ceil2Nby3Block: big.NewInt(0),
+			EmptyBlock:     DefaultEmptyBlockConfig,
 		},
 		IsQuorum: true,
 	}
@@ -399,10 +401,160 @@ func (c *CliqueConfig) String() string {
 
 // IstanbulConfig is the consensus engine configs for Istanbul based sealing.
 type IstanbulConfig struct {
-	Epoch          uint64   `json:"epoch"`                    // Epoch length to reset votes and checkpoint
-	ProposerPolicy uint64   `json:"policy"`                   // The policy for proposer selection
-	Ceil2Nby3Block *big.Int `json:"ceil2Nby3Block,omitempty"` // Number of confirmations required to move from one state to next [2F + 1 to Ceil(2N/3)]
-	TestQBFTBlock  *big.Int `json:"testQBFTBlock,omitempty"`  // Fork block at which block confirmations are done using qbft consensus instead of ibft
+	Epoch             uint64   `json:"epoch"`                       // Epoch length to reset votes and checkpoint
+	ProposerPolicy    uint64   `json:"policy"`                      // The policy for proposer selection
+	Ceil2Nby3Block    *big.Int `json:"ceil2Nby3Block,omitempty"`    // Number of confirmations required to move from one state to next [2F + 1 to Ceil(2N/3)]
+	TestQBFTBlock     *big.Int `json:"testQBFTBlock,omitempty"`     // Fork block at which block confirmations are done using qbft consensus instead of ibft
+	BLSBlock          *big.Int `json:"blsBlock,omitempty"`          // Fork block at which seals and empty block votes are aggregated bls signatures
+	EvidenceBlock     *big.Int `json:"evidenceBlock,omitempty"`     // Fork block at which equivocating empty block voters are penalized
+	CommitteeBlock    *big.Int `json:"committeeBlock,omitempty"`    // Fork block at which headers commit to the validators the next committee is drawn from
+	LaggingBlock      *big.Int `json:"laggingBlock,omitempty"`      // Fork block at which committee members missing the commit quorum are drawn with a lower weight
+	ExtraVersionBlock *big.Int `json:"extraVersionBlock,omitempty"` // Fork block at which the header extra-data carries a version and its lists are size limited
+
+	OfficialNFTProposalBlock *big.Int `json:"officialNFTProposalBlock,omitempty"` // Fork block at which official nfts are elected by stake weighted proposal voting instead of nomination
+	EmptyBlockParamsBlock    *big.Int `json:"emptyBlockParamsBlock,omitempty"`    // Fork block at which the configured consensus parameters of the empty blocks replace the defaults
+	NFTMetaURLBlock          *big.Int `json:"nftMetaURLBlock,omitempty"`          // Fork block at which the owners of nfts can update and freeze their meta urls
+	ExchangerSettingsBlock   *big.Int `json:"exchangerSettingsBlock,omitempty"`   // Fork block at which exchangers can update their registration, set collection fee rates and restrict the creators they broker
+	SNFTSharesBlock          *big.Int `json:"snftSharesBlock,omitempty"`          // Fork block at which merged snfts can be fractionalized into transferable shares
+
+	EmptyBlock *EmptyBlockConfig `json:"emptyBlock,omitempty"` // Liveness parameters of the empty blocks, defaults are used if nil
+}
+
+// EmptyBlockConfig is the liveness configuration of the empty blocks, which are
+// produced by the online validators when no block is sealed for too long. Unset
+// fields fall back to the values of DefaultEmptyBlockConfig. The difficulty,
+// the vote percent and the coefficient changes are consensus parameters, they
+// only apply from the EmptyBlockParamsBlock fork on.
+type EmptyBlockConfig struct {
+	Timeout         *uint64 `json:"timeout,omitempty"`         // Seconds without a block after which an empty block is produced
+	EarlyTimeout    *uint64 `json:"earlyTimeout,omitempty"`    // Seconds without a block after which an empty block is produced if too few validators are online, non-validators wait one second more
+	OnlineThreshold *uint64 `json:"onlineThreshold,omitempty"` // Number of online validators below which the early timeout applies
+	GossipInterval  *uint64 `json:"gossipInterval,omitempty"`  // Seconds between two broadcasts of the empty block votes
+	Difficulty      *uint64 `json:"difficulty,omitempty"`      // Difficulty of an empty block
+	VotePercent     *uint64 `json:"votePercent,omitempty"`     // Percentage of the coefficient weighted stake the votes of an empty block must exceed
+	Penalty         *uint64 `json:"penalty,omitempty"`         // Coefficient subtracted from the selected validators that missed the block
+	VoteReward      *uint64 `json:"voteReward,omitempty"`      // Coefficient added to the voters of an empty block
+
+	EquivocationPenalty *uint64 `json:"equivocationPenalty,omitempty"` // Coefficient subtracted from a validator that voted twice in the same slot
+}
+
+// DefaultEmptyBlockConfig is the empty block configuration of the networks
+// that don't configure it in the genesis, all its fields are unset.
+var DefaultEmptyBlockConfig = &EmptyBlockConfig{}
+
+// Default values of the empty block parameters.
+const (
+	defaultEmptyBlockTimeout             = 120
+	defaultEmptyBlockEarlyTimeout        = 15
+	defaultEmptyBlockOnlineThreshold     = 7
+	defaultEmptyBlockGossipInterval      = 5
+	defaultEmptyBlockDifficulty          = 24
+	defaultEmptyBlockVotePercent         = 50
+	defaultEmptyBlockPenalty             = 20
+	defaultEmptyBlockVoteReward          = 70
+	defaultEmptyBlockEquivocationPenalty = 20
+)
+
+func orDefault(v *uint64, def uint64) uint64 {
+	if v == nil {
+		return def
+	}
+	return *v
+}
+
+// GetEmptyBlock returns the configured empty block parameters regardless of
+// the EmptyBlockParamsBlock fork, it is meant for the local liveness timers.
+// It is safe to call on a nil config.
+func (c *IstanbulConfig) GetEmptyBlock() *EmptyBlockConfig {
+	if c == nil || c.EmptyBlock == nil {
+		return DefaultEmptyBlockConfig
+	}
+	return c.EmptyBlock
+}
+
+// EmptyBlockAt returns the empty block parameters the block identified by the
+// given number is verified with, the defaults apply before the
+// EmptyBlockParamsBlock fork. It is safe to call on a nil config.
+func (c *IstanbulConfig) EmptyBlockAt(num *big.Int) *EmptyBlockConfig {
+	if c == nil || !isForked(c.EmptyBlockParamsBlock, num) {
+		return DefaultEmptyBlockConfig
+	}
+	return c.GetEmptyBlock()
+}
+
+// CheckConfig checks the consistency of the istanbul parameters that the fork
+// ordering doesn't cover. It is safe to call on a nil config.
+func (c *IstanbulConfig) CheckConfig() error {
+	if c == nil {
+		return nil
+	}
+	return c.GetEmptyBlock().CheckConfig()
+}
+
+// GetTimeout returns the empty block timeout in seconds.
+func (c *EmptyBlockConfig) GetTimeout() uint64 {
+	return orDefault(c.Timeout, defaultEmptyBlockTimeout)
+}
+
+// GetEarlyTimeout returns the early empty block timeout of a validator in seconds.
+func (c *EmptyBlockConfig) GetEarlyTimeout() uint64 {
+	return orDefault(c.EarlyTimeout, defaultEmptyBlockEarlyTimeout)
+}
+
+// GetOnlineThreshold returns the number of online validators below which the
+// early timeout applies.
+func (c *EmptyBlockConfig) GetOnlineThreshold() uint64 {
+	return orDefault(c.OnlineThreshold, defaultEmptyBlockOnlineThreshold)
+}
+
+// GetGossipInterval returns the interval between two vote broadcasts in seconds.
+func (c *EmptyBlockConfig) GetGossipInterval() uint64 {
+	return orDefault(c.GossipInterval, defaultEmptyBlockGossipInterval)
+}
+
+// GetDifficulty returns the difficulty of an empty block.
+func (c *EmptyBlockConfig) GetDifficulty() uint64 {
+	return orDefault(c.Difficulty, defaultEmptyBlockDifficulty)
+}
+
+// GetVotePercent returns the percentage of the weighted stake the votes must exceed.
+func (c *EmptyBlockConfig) GetVotePercent() uint64 {
+	return orDefault(c.VotePercent, defaultEmptyBlockVotePercent)
+}
+
+// GetPenalty returns the coefficient penalty of the validators missing a block.
+func (c *EmptyBlockConfig) GetPenalty() uint64 {
+	return orDefault(c.Penalty, defaultEmptyBlockPenalty)
+}
+
+// GetVoteReward returns the coefficient reward of the voters of an empty block.
+func (c *EmptyBlockConfig) GetVoteReward() uint64 {
+	return orDefault(c.VoteReward, defaultEmptyBlockVoteReward)
+}
+
+// GetEquivocationPenalty returns the coefficient penalty of an equivocating voter.
+func (c *EmptyBlockConfig) GetEquivocationPenalty() uint64 {
+	return orDefault(c.EquivocationPenalty, defaultEmptyBlockEquivocationPenalty)
+}
+
+// CheckConfig checks the consistency of the empty block parameters.
+func (c *EmptyBlockConfig) CheckConfig() error {
+	if c.GetTimeout() == 0 || c.GetGossipInterval() == 0 {
+		return fmt.Errorf("empty block timeout and gossip interval must be positive")
+	}
+	if c.GetEarlyTimeout() >= c.GetTimeout() {
+		return fmt.Errorf("empty block early timeout %d not below timeout %d", c.GetEarlyTimeout(), c.GetTimeout())
+	}
+	if c.GetDifficulty() == 0 {
+		return fmt.Errorf("empty block difficulty must be positive")
+	}
+	if c.GetVotePercent() >= 100 {
+		return fmt.Errorf("empty block vote percent %d not below 100", c.GetVotePercent())
+	}
+	if c.GetVoteReward() > 255 || c.GetPenalty() > 255 || c.GetEquivocationPenalty() > 255 {
+		return fmt.Errorf("empty block coefficient change out of range")
+	}
+	return nil
 }
 
 // String implements the stringer interface, returning the consensus engine details.
@@ -510,6 +662,51 @@ func (c *ChainConfig) IsCatalyst(num *big.Int) bool {
 	return isForked(c.CatalystBlock, num)
 }
 
+// IsBLS returns whether num is either equal to the istanbul bls fork block or greater.
+func (c *ChainConfig) IsBLS(num *big.Int) bool {
+	return c.Istanbul != nil && isForked(c.Istanbul.BLSBlock, num)
+}
+
+// IsEvidence returns whether num is either equal to the istanbul evidence fork block or greater.
+func (c *ChainConfig) IsEvidence(num *big.Int) bool {
+	return c.Istanbul != nil && isForked(c.Istanbul.EvidenceBlock, num)
+}
+
+// IsCommittee returns whether num is either equal to the istanbul committee fork block or greater.
+func (c *ChainConfig) IsCommittee(num *big.Int) bool {
+	return c.Istanbul != nil && isForked(c.Istanbul.CommitteeBlock, num)
+}
+
+// IsLagging returns whether num is either equal to the istanbul lagging fork block or greater.
+func (c *ChainConfig) IsLagging(num *big.Int) bool {
+	return c.Istanbul != nil && isForked(c.Istanbul.LaggingBlock, num)
+}
+
+// IsExtraVersion returns whether num is either equal to the istanbul extra version fork block or greater.
+func (c *ChainConfig) IsExtraVersion(num *big.Int) bool {
+	return c.Istanbul != nil && isForked(c.Istanbul.ExtraVersionBlock, num)
+}
+
+// IsOfficialNFTProposal returns whether num is either equal to the official nft proposal fork block or greater.
+func (c *ChainConfig) IsOfficialNFTProposal(num *big.Int) bool {
+	return c.Istanbul != nil && isForked(c.Istanbul.OfficialNFTProposalBlock, num)
+}
+
+// IsNFTMetaURL returns whether num is either equal to the nft meta url fork block or greater.
+func (c *ChainConfig) IsNFTMetaURL(num *big.Int) bool {
+	return c.Istanbul != nil && isForked(c.Istanbul.NFTMetaURLBlock, num)
+}
+
+// IsExchangerSettings returns whether num is either equal to the exchanger settings fork block or greater.
+func (c *ChainConfig) IsExchangerSettings(num *big.Int) bool {
+	return c.Istanbul != nil && isForked(c.Istanbul.ExchangerSettingsBlock, num)
+}
+
+// IsSNFTShares returns whether num is either equal to the snft shares fork block or greater.
+func (c *ChainConfig) IsSNFTShares(num *big.Int) bool {
+	return c.Istanbul != nil && isForked(c.Istanbul.SNFTSharesBlock, num)
+}
+
 // CheckCompatible checks whether scheduled fork transitions have been imported
 // with a mismatching chain configuration.
 func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64) *ConfigCompatError {
@@ -619,6 +816,43 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.LondonBlock, newcfg.LondonBlock, head) {
 		return newCompatError("London fork block", c.LondonBlock, newcfg.LondonBlock)
 	}
+	var istanbul, newIstanbul IstanbulConfig
+	if c.Istanbul != nil {
+		istanbul = *c.Istanbul
+	}
+	if newcfg.Istanbul != nil {
+		newIstanbul = *newcfg.Istanbul
+	}
+	if isForkIncompatible(istanbul.BLSBlock, newIstanbul.BLSBlock, head) {
+		return newCompatError("BLS fork block", istanbul.BLSBlock, newIstanbul.BLSBlock)
+	}
+	if isForkIncompatible(istanbul.EvidenceBlock, newIstanbul.EvidenceBlock, head) {
+		return newCompatError("Evidence fork block", istanbul.EvidenceBlock, newIstanbul.EvidenceBlock)
+	}
+	if isForkIncompatible(istanbul.OfficialNFTProposalBlock, newIstanbul.OfficialNFTProposalBlock, head) {
+		return newCompatError("Official NFT proposal fork block", istanbul.OfficialNFTProposalBlock, newIstanbul.OfficialNFTProposalBlock)
+	}
+	if isForkIncompatible(istanbul.EmptyBlockParamsBlock, newIstanbul.EmptyBlockParamsBlock, head) {
+		return newCompatError("Empty block params fork block", istanbul.EmptyBlockParamsBlock, newIstanbul.EmptyBlockParamsBlock)
+	}
+	if isForkIncompatible(istanbul.CommitteeBlock, newIstanbul.CommitteeBlock, head) {
+		return newCompatError("Committee fork block", istanbul.CommitteeBlock, newIstanbul.CommitteeBlock)
+	}
+	if isForkIncompatible(istanbul.LaggingBlock, newIstanbul.LaggingBlock, head) {
+		return newCompatError("Lagging fork block", istanbul.LaggingBlock, newIstanbul.LaggingBlock)
+	}
+	if isForkIncompatible(istanbul.ExtraVersionBlock, newIstanbul.ExtraVersionBlock, head) {
+		return newCompatError("Extra version fork block", istanbul.ExtraVersionBlock, newIstanbul.ExtraVersionBlock)
+	}
+	if isForkIncompatible(istanbul.NFTMetaURLBlock, newIstanbul.NFTMetaURLBlock, head) {
+		return newCompatError("NFT meta url fork block", istanbul.NFTMetaURLBlock, newIstanbul.NFTMetaURLBlock)
+	}
+	if isForkIncompatible(istanbul.ExchangerSettingsBlock, newIstanbul.ExchangerSettingsBlock, head) {
+		return newCompatError("Exchanger settings fork block", istanbul.ExchangerSettingsBlock, newIstanbul.ExchangerSettingsBlock)
+	}
+	if isForkIncompatible(istanbul.SNFTSharesBlock, newIstanbul.SNFTSharesBlock, head) {
+		return newCompatError("SNFT shares fork block", istanbul.SNFTSharesBlock, newIstanbul.SNFTSharesBlock)
+	}
 	return nil
 }
 
@@ -87,6 +87,94 @@ func TestCheckCompatible(t *testing.T) {
 				RewindTo:     30,
 			},
 		},
+		{
+			stored: &ChainConfig{Istanbul: &IstanbulConfig{OfficialNFTProposalBlock: big.NewInt(10)}},
+			new:    &ChainConfig{Istanbul: &IstanbulConfig{}},
+			head:   20,
+			wantErr: &ConfigCompatError{
+				What:         "Official NFT proposal fork block",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    nil,
+				RewindTo:     9,
+			},
+		},
+		{
+			stored: &ChainConfig{Istanbul: &IstanbulConfig{}},
+			new:    &ChainConfig{Istanbul: &IstanbulConfig{EmptyBlockParamsBlock: big.NewInt(10)}},
+			head:   20,
+			wantErr: &ConfigCompatError{
+				What:         "Empty block params fork block",
+				StoredConfig: nil,
+				NewConfig:    big.NewInt(10),
+				RewindTo:     9,
+			},
+		},
+		{
+			stored: &ChainConfig{Istanbul: &IstanbulConfig{CommitteeBlock: big.NewInt(10)}},
+			new:    &ChainConfig{Istanbul: &IstanbulConfig{CommitteeBlock: big.NewInt(30)}},
+			head:   20,
+			wantErr: &ConfigCompatError{
+				What:         "Committee fork block",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    big.NewInt(30),
+				RewindTo:     9,
+			},
+		},
+		{
+			stored: &ChainConfig{Istanbul: &IstanbulConfig{LaggingBlock: big.NewInt(10)}},
+			new:    &ChainConfig{Istanbul: &IstanbulConfig{LaggingBlock: big.NewInt(5)}},
+			head:   20,
+			wantErr: &ConfigCompatError{
+				What:         "Lagging fork block",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    big.NewInt(5),
+				RewindTo:     4,
+			},
+		},
+		{
+			stored: &ChainConfig{Istanbul: &IstanbulConfig{ExtraVersionBlock: big.NewInt(10)}},
+			new:    &ChainConfig{Istanbul: &IstanbulConfig{}},
+			head:   20,
+			wantErr: &ConfigCompatError{
+				What:         "Extra version fork block",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    nil,
+				RewindTo:     9,
+			},
+		},
+		{
+			stored: &ChainConfig{Istanbul: &IstanbulConfig{}},
+			new:    &ChainConfig{Istanbul: &IstanbulConfig{NFTMetaURLBlock: big.NewInt(10)}},
+			head:   20,
+			wantErr: &ConfigCompatError{
+				What:         "NFT meta url fork block",
+				StoredConfig: nil,
+				NewConfig:    big.NewInt(10),
+				RewindTo:     9,
+			},
+		},
+		{
+			stored: &ChainConfig{Istanbul: &IstanbulConfig{ExchangerSettingsBlock: big.NewInt(10)}},
+			new:    &ChainConfig{Istanbul: &IstanbulConfig{ExchangerSettingsBlock: big.NewInt(20)}},
+			head:   15,
+			wantErr: &ConfigCompatError{
+				What:         "Exchanger settings fork block",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    big.NewInt(20),
+				RewindTo:     9,
+			},
+		},
+		{
+			stored: &ChainConfig{Istanbul: &IstanbulConfig{SNFTSharesBlock: big.NewInt(10)}},
+			new:    &ChainConfig{Istanbul: &IstanbulConfig{}},
+			head:   20,
+			wantErr: &ConfigCompatError{
+				What:         "SNFT shares fork block",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    nil,
+				RewindTo:     9,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -96,3 +184,59 @@ func TestCheckCompatible(t *testing.T) {
 		}
 	}
 }
+
+func newUint64(v uint64) *uint64 { return &v }
+
+func TestEmptyBlockConfig(t *testing.T) {
+	var istanbul *IstanbulConfig
+	if have := istanbul.GetEmptyBlock(); have != DefaultEmptyBlockConfig {
+		t.Fatalf("nil istanbul config: have %v, want defaults", have)
+	}
+	cfg := &EmptyBlockConfig{Timeout: newUint64(60), Difficulty: newUint64(12), Penalty: newUint64(0)}
+	if have := cfg.GetTimeout(); have != 60 {
+		t.Errorf("timeout mismatch: have %d, want 60", have)
+	}
+	if have := cfg.GetDifficulty(); have != 12 {
+		t.Errorf("difficulty mismatch: have %d, want 12", have)
+	}
+	if have := cfg.GetPenalty(); have != 0 {
+		t.Errorf("penalty mismatch: have %d, want 0", have)
+	}
+	if have, want := cfg.GetVotePercent(), DefaultEmptyBlockConfig.GetVotePercent(); have != want {
+		t.Errorf("vote percent mismatch: have %d, want %d", have, want)
+	}
+	if err := cfg.CheckConfig(); err != nil {
+		t.Errorf("valid config rejected: %v", err)
+	}
+	for _, bad := range []*EmptyBlockConfig{
+		{Timeout: newUint64(10), EarlyTimeout: newUint64(10)},
+		{Timeout: newUint64(0)},
+		{Difficulty: newUint64(0)},
+		{VotePercent: newUint64(100)},
+		{VoteReward: newUint64(256)},
+	} {
+		if err := bad.CheckConfig(); err == nil {
+			t.Errorf("invalid config %+v accepted", bad)
+		}
+	}
+	invalid := &IstanbulConfig{EmptyBlock: &EmptyBlockConfig{VotePercent: newUint64(120)}}
+	if err := invalid.CheckConfig(); err == nil {
+		t.Error("invalid empty block config accepted by istanbul config check")
+	}
+}
+
+func TestEmptyBlockAt(t *testing.T) {
+	istanbul := &IstanbulConfig{
+		EmptyBlockParamsBlock: big.NewInt(10),
+		EmptyBlock:            &EmptyBlockConfig{Difficulty: newUint64(12)},
+	}
+	if have := istanbul.EmptyBlockAt(big.NewInt(9)).GetDifficulty(); have != DefaultEmptyBlockConfig.GetDifficulty() {
+		t.Errorf("difficulty before fork mismatch: have %d, want %d", have, DefaultEmptyBlockConfig.GetDifficulty())
+	}
+	if have := istanbul.EmptyBlockAt(big.NewInt(10)).GetDifficulty(); have != 12 {
+		t.Errorf("difficulty at fork mismatch: have %d, want 12", have)
+	}
+	if have := istanbul.GetEmptyBlock().GetDifficulty(); have != 12 {
+		t.Errorf("configured difficulty mismatch: have %d, want 12", have)
+	}
+}
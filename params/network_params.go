@@ -32,6 +32,19 @@ const (
 	// considered probably final and its rotated bits are calculated.
 	BloomConfirms = 256
 
+	// ValidatorStatsBlocks is the number of blocks a single validator stats
+	// section aggregates.
+	ValidatorStatsBlocks uint64 = 1024
+
+	// ValidatorStatsConfirms is the number of confirmation blocks before a
+	// validator stats section is considered final and aggregated.
+	ValidatorStatsConfirms = 16
+
+	// ValidatorCheckpointInterval is the block frequency at which the headers
+	// carry the full validator checkpoint after the istanbul committee fork,
+	// the other headers only carry its hash.
+	ValidatorCheckpointInterval uint64 = 1024
+
 	// CHTFrequency is the block frequency for creating CHTs
 	CHTFrequency = 32768
 
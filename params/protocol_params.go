@@ -65,6 +65,16 @@ const (
 	WormholesTx28 uint64 = 126000
 	WormholesTx30 uint64 = 52500
 	WormholesTx31 uint64 = 73500
+	WormholesTx32 uint64 = 52500
+	WormholesTx33 uint64 = 42000
+	WormholesTx34 uint64 = 52500
+	WormholesTx35 uint64 = 52500
+	WormholesTx36 uint64 = 52500
+	WormholesTx37 uint64 = 42000
+	WormholesTx38 uint64 = 73500
+	WormholesTx39 uint64 = 73500
+	WormholesTx40 uint64 = 42000
+	WormholesTx41 uint64 = 52500
 
 	Sha3Gas     uint64 = 30 // Once per SHA3 operation.
 	Sha3WordGas uint64 = 6  // Once per word of the SHA3 operation's data.